@@ -1,19 +1,41 @@
 package api
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/spf13/viper"
 	"github.com/zalando/go-keyring"
 
+	"github.com/ankitpokhrel/jira-cli/internal/version"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira/filter"
 	"github.com/ankitpokhrel/jira-cli/pkg/netrc"
 )
 
-const defaultClientTimeout = 15 * time.Second
+const (
+	defaultClientTimeout = 15 * time.Second
 
-var jiraClient *jira.Client
+	// searchPageSize is the maximum number of issues Jira returns in a single search request.
+	searchPageSize = 100
+)
+
+var (
+	jiraClient     *jira.Client
+	clientCtx      = context.Background()
+	traceRecorders []jira.TraceRecorder
+)
+
+// SetContext sets the context used by the jira client.
+// Cancelling the context cancels all in-flight requests.
+func SetContext(ctx context.Context) {
+	clientCtx = ctx
+}
 
 // getClientTimeout returns the configured timeout or default.
 func getClientTimeout() time.Duration {
@@ -37,17 +59,7 @@ func Client(config jira.Config) *jira.Client {
 		config.Login = viper.GetString("login")
 	}
 	if config.APIToken == "" {
-		config.APIToken = viper.GetString("api_token")
-	}
-	if config.APIToken == "" {
-		netrcConfig, _ := netrc.Read(config.Server, config.Login)
-		if netrcConfig != nil {
-			config.APIToken = netrcConfig.Password
-		}
-	}
-	if config.APIToken == "" {
-		secret, _ := keyring.Get("jira-cli", config.Login)
-		config.APIToken = secret
+		config.APIToken = APIToken(config.Server, config.Login)
 	}
 	if config.AuthType == nil {
 		authType := jira.AuthType(viper.GetString("auth_type"))
@@ -70,15 +82,105 @@ func Client(config jira.Config) *jira.Client {
 		config.MTLSConfig.ClientKey = viper.GetString("mtls.client_key")
 	}
 
-	jiraClient = jira.NewClient(
-		config,
+	opts := []jira.ClientFunc{
+		jira.WithContext(clientCtx),
 		jira.WithTimeout(getClientTimeout()),
 		jira.WithInsecureTLS(*config.Insecure),
-	)
+	}
+	if rps := viper.GetFloat64("rate_limit.requests_per_second"); rps > 0 {
+		opts = append(opts, jira.WithRateLimit(rps, viper.GetInt("rate_limit.burst")))
+	}
+	opts = append(opts, jira.WithRetryPolicy(retryPolicy()))
+	opts = append(opts, jira.WithTraceRecorder(recorders()...))
+	if !viper.GetBool("no_cache") {
+		if dir, err := CacheDir(); err == nil {
+			opts = append(opts, jira.WithCache(jira.NewDiskCache(dir), CacheRules()))
+		}
+	}
+
+	jiraClient = jira.NewClient(config, opts...)
 
 	return jiraClient
 }
 
+// recorders returns the trace recorders set in the config. Recorders are shared
+// by all clients so that requests of a command end up in the same trace.
+func recorders() []jira.TraceRecorder {
+	if traceRecorders != nil {
+		return traceRecorders
+	}
+
+	traceRecorders = []jira.TraceRecorder{}
+	if file := viper.GetString("trace.file"); file != "" {
+		traceRecorders = append(traceRecorders, jira.NewHARFile(file, version.Version))
+	}
+	if endpoint := viper.GetString("trace.otlp_endpoint"); endpoint != "" {
+		traceRecorders = append(traceRecorders, jira.NewOTLPExporter(endpoint, version.Version))
+	}
+	return traceRecorders
+}
+
+// FlushTraces writes out requests buffered by the trace recorders.
+// It must be called before the program exits.
+func FlushTraces() {
+	for _, r := range traceRecorders {
+		if f, ok := r.(jira.TraceFlusher); ok {
+			if err := f.Flush(); err != nil {
+				fmt.Fprintf(os.Stderr, "Unable to save trace: %s\n", err)
+			}
+		}
+	}
+}
+
+// retryPolicy returns the default retry policy overridden from the config, eg: `retry.max_retries: 5`.
+func retryPolicy() jira.RetryPolicy {
+	p := jira.DefaultRetryPolicy()
+	if viper.IsSet("retry.max_retries") {
+		p.MaxRetries = viper.GetInt("retry.max_retries")
+	}
+	if d := viper.GetDuration("retry.max_delay"); d > 0 {
+		p.MaxDelay = d
+	}
+	if d := viper.GetDuration("retry.max_retry_after"); d > 0 {
+		p.MaxRetryAfter = d
+	}
+	return p
+}
+
+// CacheDir returns the directory http responses are cached in.
+func CacheDir() (string, error) {
+	home, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, "jira-cli"), nil
+}
+
+// CacheRules returns cache rules with TTLs overridden from the config, eg: `cache.ttl.fields: 12h`.
+// A TTL of 0 disables caching for the endpoint.
+func CacheRules() []jira.CacheRule {
+	rules := jira.DefaultCacheRules()
+	for i, r := range rules {
+		key := "cache.ttl." + r.Name
+		if viper.IsSet(key) {
+			rules[i].TTL = viper.GetDuration(key)
+		}
+	}
+	return rules
+}
+
+// APIToken resolves the api token from the config, netrc or the keyring in that order.
+func APIToken(server, login string) string {
+	if token := viper.GetString("api_token"); token != "" {
+		return token
+	}
+	if netrcConfig, _ := netrc.Read(server, login); netrcConfig != nil {
+		return netrcConfig.Password
+	}
+	secret, _ := keyring.Get("jira-cli", login)
+	return secret
+}
+
 // DefaultClient returns default jira client.
 func DefaultClient(debug bool) *jira.Client {
 	return Client(jira.Config{Debug: debug})
@@ -153,6 +255,63 @@ func ProxySearch(c *jira.Client, jql string, from, limit uint) (*jira.SearchResu
 	return issues, err
 }
 
+// ProxySearchAll is like ProxySearch but fetches issues page by page until
+// limit issues are fetched or there are no more issues to fetch. IsLast of
+// the result is false if more than limit issues match the query. A zero
+// limit fetches all matching issues.
+func ProxySearchAll(c *jira.Client, jql string, limit uint) (*jira.SearchResult, error) {
+	var (
+		out   jira.SearchResult
+		token string
+	)
+
+	local := viper.GetString("installation") == jira.InstallationTypeLocal
+
+	for fetched := uint(0); limit == 0 || fetched < limit; fetched = uint(len(out.Issues)) {
+		var (
+			res *jira.SearchResult
+			err error
+		)
+
+		size := uint(searchPageSize)
+		if limit > 0 {
+			size = min(size, limit-fetched)
+		}
+		if local {
+			res, err = c.SearchV2(jql, fetched, size)
+		} else {
+			res, err = c.SearchNext(jql, token, size)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		out.Issues = append(out.Issues, res.Issues...)
+		out.Total = res.Total
+
+		if local {
+			out.IsLast = len(out.Issues) >= res.Total
+		} else {
+			out.IsLast, token = res.IsLast || res.NextPageToken == "", res.NextPageToken
+		}
+		if out.IsLast || len(res.Issues) == 0 {
+			out.IsLast = true
+			break
+		}
+	}
+	return &out, nil
+}
+
+// ProxySearchFieldValues uses either a v2 or v3 version of the Jira GET /search endpoint
+// to fetch a single field of issues based on configured installation type.
+// Defaults to v3 if installation type is not defined in the config.
+func ProxySearchFieldValues(c *jira.Client, jql, field string, limit uint) (map[string]json.RawMessage, error) {
+	if viper.GetString("installation") == jira.InstallationTypeLocal {
+		return c.SearchFieldValuesV2(jql, field, 0, limit)
+	}
+	return c.SearchFieldValues(jql, field, limit)
+}
+
 // ProxyAssignIssue uses either a v2 or v3 version of the PUT /issue/{key}/assignee
 // endpoint to assign an issue to the user.
 // Defaults to v3 if installation type is not defined in the config.
@@ -215,6 +374,14 @@ func ProxyTransitions(c *jira.Client, key string) ([]*jira.Transition, error) {
 	return transitions, err
 }
 
+// ProxyTransitionsWithFields is like ProxyTransitions but also fetches fields of the transition screens.
+func ProxyTransitionsWithFields(c *jira.Client, key string) ([]*jira.Transition, error) {
+	if viper.GetString("installation") == jira.InstallationTypeLocal {
+		return c.TransitionsV2WithFields(key)
+	}
+	return c.TransitionsWithFields(key)
+}
+
 // ProxyWatchIssue uses either a v2 or v3 version of the PUT /issue/{key}/watchers
 // endpoint to assign an issue to the user. Defaults to v3 if installation type is
 // not defined in the config.
@@ -267,3 +434,27 @@ func ProxyUnwatchIssue(c *jira.Client, key string, user *jira.User) error {
 func ProxyMe(c *jira.Client) (*jira.Me, error) {
 	return c.Me()
 }
+
+// ProxyParseJQL uses the POST /jql/parse endpoint to validate JQL queries and falls back
+// to strict validation of GET /search endpoint for Jira servers that don't support it.
+// Defaults to /jql/parse if installation type is not defined in the config.
+func ProxyParseJQL(c *jira.Client, queries ...string) ([]*jira.ParsedJQL, error) {
+	if viper.GetString("installation") != jira.InstallationTypeLocal {
+		out, err := c.ParseJQL(queries...)
+
+		var nf *jira.ErrNotFound
+		if !errors.As(err, &nf) {
+			return out, err
+		}
+	}
+
+	out := make([]*jira.ParsedJQL, 0, len(queries))
+	for _, q := range queries {
+		p, err := c.ValidateJQLV2(q)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, p)
+	}
+	return out, nil
+}
@@ -1,14 +1,35 @@
 package main
 
 import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/ankitpokhrel/jira-cli/api"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/root"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 )
 
 func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Restore default signal handling once the context is cancelled
+	// so that a second interrupt kills the process right away.
+	go func() {
+		<-ctx.Done()
+		stop()
+	}()
+
 	rootCmd := root.NewCmdRoot()
-	if _, err := rootCmd.ExecuteC(); err != nil {
+	args := root.ExpandAlias(rootCmd, os.Args[1:])
+	root.AddPluginCommand(rootCmd, args)
+	rootCmd.SetArgs(args)
+
+	if _, err := rootCmd.ExecuteContextC(ctx); err != nil {
 		// Use enhanced error handling that provides suggestions
 		cmdutil.ExitIfError(err)
 	}
+	api.FlushTraces()
 }
@@ -0,0 +1,117 @@
+// Package alias expands user-defined command aliases.
+package alias
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/google/shlex"
+)
+
+// ShellPrefix marks aliases that are run by the shell instead of jira.
+const ShellPrefix = "!"
+
+var (
+	// ErrInvalidName is returned if an alias name contains unsupported characters.
+	ErrInvalidName = errors.New("alias name can only contain lowercase letters, digits, dashes and underscores")
+	// ErrEmptyExpansion is returned if an alias doesn't expand to a command.
+	ErrEmptyExpansion = errors.New("alias expansion is empty")
+	// ErrShellSyntax is returned if an alias that isn't run by the shell uses shell substitutions.
+	ErrShellSyntax = errors.New("command substitution and ${} need the shell, prefix the expansion with " + ShellPrefix)
+
+	validName   = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+	placeholder = regexp.MustCompile(`\$(\d+)`)
+
+	// shellSyntax are substitutions only the shell expands.
+	shellSyntax = []string{"$(", "${", "`"}
+)
+
+// ValidateName checks if the name can be used as an alias.
+//
+// Names are lowercase since config keys are case-insensitive.
+func ValidateName(name string) error {
+	if !validName.MatchString(name) {
+		return ErrInvalidName
+	}
+	return nil
+}
+
+// IsShell checks if the expansion is a shell alias.
+func IsShell(expansion string) bool {
+	return strings.HasPrefix(expansion, ShellPrefix)
+}
+
+// Split splits an alias expansion into arguments using shell quoting rules.
+// Shell substitutions, eg: $(jira me), are rejected as they would be passed as is.
+func Split(expansion string) ([]string, error) {
+	for _, s := range shellSyntax {
+		if strings.Contains(expansion, s) {
+			return nil, ErrShellSyntax
+		}
+	}
+
+	words, err := shlex.Split(expansion)
+	if err != nil {
+		return nil, err
+	}
+	if len(words) == 0 {
+		return nil, ErrEmptyExpansion
+	}
+	return words, nil
+}
+
+// Expand replaces `$1`, `$2`, ... in the expansion with the positional arguments
+// and `$@` with all arguments. Arguments that are not referenced are appended
+// to the expanded command.
+func Expand(expansion string, args []string) ([]string, error) {
+	words, err := Split(expansion)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		out     []string
+		used    int
+		starred bool
+	)
+	for _, w := range words {
+		if w == "$@" {
+			out = append(out, args...)
+			starred = true
+			continue
+		}
+
+		var missing int
+		w = placeholder.ReplaceAllStringFunc(w, func(m string) string {
+			n, _ := strconv.Atoi(m[1:])
+			if n < 1 {
+				return m
+			}
+			if n > len(args) {
+				missing = max(missing, n)
+				return m
+			}
+			used = max(used, n)
+			return args[n-1]
+		})
+		if missing > 0 {
+			return nil, fmt.Errorf("alias expects at least %d argument(s), got %d", missing, len(args))
+		}
+		out = append(out, w)
+	}
+
+	if !starred {
+		out = append(out, args[used:]...)
+	}
+	return out, nil
+}
+
+// ShellCommand returns the command that runs a shell alias. Arguments are passed
+// as positional parameters so that the script can use `$1` and `$@` directly.
+func ShellCommand(name, expansion string, args []string) []string {
+	script := strings.TrimPrefix(expansion, ShellPrefix)
+	return append([]string{"sh", "-c", script, name}, args...)
+}
@@ -0,0 +1,107 @@
+package alias
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateName(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, ValidateName("standup"))
+	assert.NoError(t, ValidateName("in-review_2"))
+	assert.ErrorIs(t, ValidateName("Standup"), ErrInvalidName)
+	assert.ErrorIs(t, ValidateName("my.alias"), ErrInvalidName)
+	assert.ErrorIs(t, ValidateName("-x"), ErrInvalidName)
+	assert.ErrorIs(t, ValidateName(""), ErrInvalidName)
+}
+
+func TestExpand(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name      string
+		expansion string
+		args      []string
+		expected  []string
+		err       string
+	}{
+		{
+			name:      "appends arguments",
+			expansion: `issue list -s"In Progress" --plain`,
+			args:      []string{"-yHigh"},
+			expected:  []string{"issue", "list", "-sIn Progress", "--plain", "-yHigh"},
+		},
+		{
+			name:      "positional arguments",
+			expansion: `issue move $1 "In Review" --comment "$2 done"`,
+			args:      []string{"ISS-1", "Review"},
+			expected:  []string{"issue", "move", "ISS-1", "In Review", "--comment", "Review done"},
+		},
+		{
+			name:      "appends unused arguments",
+			expansion: "issue view $1",
+			args:      []string{"ISS-1", "--plain"},
+			expected:  []string{"issue", "view", "ISS-1", "--plain"},
+		},
+		{
+			name:      "all arguments",
+			expansion: "issue list $@ --plain",
+			args:      []string{"-a", "me"},
+			expected:  []string{"issue", "list", "-a", "me", "--plain"},
+		},
+		{
+			name:      "missing arguments",
+			expansion: "issue move $1 $2",
+			args:      []string{"ISS-1"},
+			err:       "alias expects at least 2 argument(s), got 1",
+		},
+		{
+			name:      "empty expansion",
+			expansion: "  ",
+			err:       ErrEmptyExpansion.Error(),
+		},
+		{
+			name:      "command substitution",
+			expansion: `issue list -a$(jira me) -s"In Progress" --plain`,
+			err:       ErrShellSyntax.Error(),
+		},
+		{
+			name:      "backticks",
+			expansion: "issue list -a`jira me`",
+			err:       ErrShellSyntax.Error(),
+		},
+		{
+			name:      "variable expansion",
+			expansion: "issue list -a${USER}",
+			err:       ErrShellSyntax.Error(),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Expand(tc.expansion, tc.args)
+			if tc.err != "" {
+				assert.EqualError(t, err, tc.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestShellCommand(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, IsShell(`!jira issue list -a$(jira me)`))
+	assert.False(t, IsShell("issue list"))
+
+	assert.Equal(t,
+		[]string{"sh", "-c", `jira issue list -a$(jira me) "$@"`, "mine", "--plain"},
+		ShellCommand("mine", `!jira issue list -a$(jira me) "$@"`, []string{"--plain"}),
+	)
+}
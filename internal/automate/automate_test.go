@@ -0,0 +1,390 @@
+package automate
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/internal/workflow"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const rulesYAML = `
+rules:
+  - name: stale
+    jql: sprint in openSprints() AND status = "In Progress"
+    conditions:
+      - field: updated
+        op: older_than
+        value: 3d
+    actions:
+      - comment: "{{mention .Assignee}} {{.Key}} has no update for 3 days."
+  - name: close-parent
+    jql: issuetype = Story AND status != Done
+    cooldown: 1d
+    conditions:
+      - field: subtasks.status
+        op: all
+        value: [Done, Closed]
+    actions:
+      - transition: done
+      - labels: [auto-closed, -blocked]
+  - name: off
+    jql: project = ABC
+    disabled: true
+    actions:
+      - assign: none
+`
+
+type fakeClient struct {
+	issues      map[string][]*jira.Issue
+	transitions []*jira.Transition
+	searchErr   error
+	commentErr  error
+	// limit truncates search results if set.
+	limit int
+	calls []string
+}
+
+func (c *fakeClient) Search(jql string, _ uint) ([]*jira.Issue, bool, error) {
+	if c.searchErr != nil {
+		return nil, false, c.searchErr
+	}
+	issues := c.issues[jql]
+	if c.limit > 0 && len(issues) > c.limit {
+		return issues[:c.limit], false, nil
+	}
+	return issues, true, nil
+}
+
+func (c *fakeClient) Transitions(string) ([]*jira.Transition, error) {
+	return c.transitions, nil
+}
+
+func (c *fakeClient) Transition(key string, tr *jira.Transition) error {
+	c.calls = append(c.calls, fmt.Sprintf("transition %s %s", key, tr.Name))
+	return nil
+}
+
+func (c *fakeClient) Comment(key, body string) error {
+	if c.commentErr != nil {
+		return c.commentErr
+	}
+	c.calls = append(c.calls, fmt.Sprintf("comment %s %s", key, body))
+	return nil
+}
+
+func (c *fakeClient) Assign(key, user string) error {
+	c.calls = append(c.calls, fmt.Sprintf("assign %s %s", key, user))
+	return nil
+}
+
+func (c *fakeClient) Edit(key string, req *jira.EditRequest) error {
+	c.calls = append(c.calls, fmt.Sprintf("edit %s %s", key, strings.Join(req.Labels, ",")))
+	return nil
+}
+
+func (c *fakeClient) Mention(_, user string) (string, error) {
+	return "[~" + strings.ToLower(user) + "]", nil
+}
+
+func issue(key, status, updated string) *jira.Issue {
+	iss := jira.Issue{Key: key}
+	iss.Fields.Status.Name = status
+	iss.Fields.Updated = updated
+	iss.Fields.Assignee.Name = "Bob"
+	return &iss
+}
+
+func withSubtasks(iss *jira.Issue, statuses ...string) *jira.Issue {
+	for i, s := range statuses {
+		iss.Fields.Subtasks = append(iss.Fields.Subtasks, *issue(fmt.Sprintf("%s-%d", iss.Key, i), s, ""))
+	}
+	return iss
+}
+
+func TestParse(t *testing.T) {
+	rules, err := Parse(strings.NewReader(rulesYAML))
+	assert.NoError(t, err)
+	assert.Len(t, rules, 3)
+
+	assert.Equal(t, "stale", rules[0].Name)
+	assert.Equal(t, Values{"3d"}, rules[0].Conditions[0].Value)
+	assert.Equal(t, Values{"Done", "Closed"}, rules[1].Conditions[0].Value)
+	assert.Equal(t, 24*time.Hour, rules[1].CooldownPeriod())
+	assert.Equal(t, uint(DefaultLimit), rules[1].limit())
+	assert.True(t, rules[2].Disabled)
+}
+
+func TestParseInvalid(t *testing.T) {
+	cases := []struct {
+		name string
+		yaml string
+		err  string
+	}{
+		{
+			name: "unknown key",
+			yaml: "rules:\n  - name: a\n    jql: x\n    when: y\n",
+			err:  "field when not found",
+		},
+		{
+			name: "missing jql",
+			yaml: "rules:\n  - name: a\n    actions:\n      - assign: none\n",
+			err:  `invalid rule "a": missing jql`,
+		},
+		{
+			name: "duplicate name",
+			yaml: "rules:\n  - {name: a, jql: x, actions: [{assign: none}]}\n  - {name: a, jql: y, actions: [{assign: none}]}\n",
+			err:  `duplicate rule name "a"`,
+		},
+		{
+			name: "unknown field",
+			yaml: "rules:\n  - {name: a, jql: x, conditions: [{field: colour, op: eq, value: red}], actions: [{assign: none}]}\n",
+			err:  `unknown field "colour"`,
+		},
+		{
+			name: "age of non date field",
+			yaml: "rules:\n  - {name: a, jql: x, conditions: [{field: status, op: older_than, value: 3d}], actions: [{assign: none}]}\n",
+			err:  `op "older_than" only works with date fields`,
+		},
+		{
+			name: "invalid period",
+			yaml: "rules:\n  - {name: a, jql: x, conditions: [{field: updated, op: older_than, value: soon}], actions: [{assign: none}]}\n",
+			err:  `invalid period "soon"`,
+		},
+		{
+			name: "multiple changes in an action",
+			yaml: "rules:\n  - {name: a, jql: x, actions: [{assign: none, transition: done}]}\n",
+			err:  "action #1: exactly one of",
+		},
+		{
+			name: "unknown template field",
+			yaml: "rules:\n  - {name: a, jql: x, actions: [{comment: '{{.Owner}}'}]}\n",
+			err:  "invalid comment template",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Parse(strings.NewReader(tc.yaml))
+			assert.ErrorContains(t, err, tc.err)
+		})
+	}
+}
+
+func TestConditionMatches(t *testing.T) {
+	now := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+
+	iss := issue("ABC-1", "In Progress", "2024-05-06T12:00:00.000+0000")
+	iss.Fields.Labels = []string{"backend", "urgent"}
+	iss.Fields.Summary = "Fix login redirect"
+	iss.Fields.Comment.Total = 4
+	withSubtasks(iss, "Done", "Closed")
+
+	cases := []struct {
+		cond Condition
+		want bool
+	}{
+		{Condition{Field: "status", Op: "eq", Value: Values{"in progress"}}, true},
+		{Condition{Field: "status", Op: "in", Value: Values{"To Do", "Done"}}, false},
+		{Condition{Field: "project", Op: "eq", Value: Values{"ABC"}}, true},
+		{Condition{Field: "labels", Op: "eq", Value: Values{"urgent"}}, true},
+		{Condition{Field: "labels", Op: "not_in", Value: Values{"urgent"}}, false},
+		{Condition{Field: "labels", Op: "all", Value: Values{"backend"}}, false},
+		{Condition{Field: "summary", Op: "contains", Value: Values{"LOGIN"}}, true},
+		{Condition{Field: "resolution", Op: "empty"}, true},
+		{Condition{Field: "components", Op: "empty"}, true},
+		{Condition{Field: "assignee", Op: "not_empty"}, true},
+		{Condition{Field: "updated", Op: "older_than", Value: Values{"3d"}}, true},
+		{Condition{Field: "updated", Op: "older_than", Value: Values{"1w"}}, false},
+		{Condition{Field: "updated", Op: "newer_than", Value: Values{"1w"}}, true},
+		{Condition{Field: "comments", Op: "gt", Value: Values{"3"}}, true},
+		{Condition{Field: "comments", Op: "lt", Value: Values{"3"}}, false},
+		{Condition{Field: "subtasks.status", Op: "all", Value: Values{"Done", "Closed"}}, true},
+		{Condition{Field: "subtasks.status", Op: "all", Value: Values{"Done"}}, false},
+		{Condition{Field: "parent", Op: "empty"}, true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.cond.Field+" "+tc.cond.Op, func(t *testing.T) {
+			assert.NoError(t, tc.cond.Validate())
+			assert.Equal(t, tc.want, tc.cond.Matches(iss, now))
+		})
+	}
+
+	noSubtasks := issue("ABC-2", "To Do", "")
+	assert.False(t, Condition{Field: "subtasks.status", Op: "all", Value: Values{"Done"}}.Matches(noSubtasks, now))
+}
+
+func TestParsePeriod(t *testing.T) {
+	for in, want := range map[string]time.Duration{
+		"30m": 30 * time.Minute,
+		"12h": 12 * time.Hour,
+		"3d":  72 * time.Hour,
+		"2w":  14 * 24 * time.Hour,
+	} {
+		got, err := ParsePeriod(in)
+		assert.NoError(t, err)
+		assert.Equal(t, want, got)
+	}
+
+	_, err := ParsePeriod("3 days")
+	assert.Error(t, err)
+}
+
+func TestEngineRun(t *testing.T) {
+	rules, err := Parse(strings.NewReader(rulesYAML))
+	assert.NoError(t, err)
+
+	now := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+	client := &fakeClient{
+		issues: map[string][]*jira.Issue{
+			rules[0].JQL: {
+				issue("ABC-1", "In Progress", "2024-05-01T10:00:00.000+0000"),
+				issue("ABC-2", "In Progress", "2024-05-10T10:00:00.000+0000"),
+			},
+			rules[1].JQL: {
+				withSubtasks(issue("ABC-3", "In Progress", ""), "Done", "Closed"),
+				withSubtasks(issue("ABC-4", "In Progress", ""), "Done", "To Do"),
+			},
+			rules[2].JQL: {issue("ABC-5", "To Do", "")},
+		},
+		transitions: []*jira.Transition{
+			{ID: "11", Name: "Start", To: &jira.TransitionStatus{Name: "In Progress"}},
+			{ID: "31", Name: "Resolve", To: &jira.TransitionStatus{Name: "Done"}},
+		},
+	}
+
+	e := Engine{Client: client, Workflow: workflow.Default(), Now: func() time.Time { return now }}
+
+	results := e.Run(rules)
+	assert.Equal(t, []Result{
+		{Rule: "stale", Key: "ABC-1", Action: "comment"},
+		{Rule: "close-parent", Key: "ABC-3", Action: "transition to done"},
+		{Rule: "close-parent", Key: "ABC-3", Action: "update labels auto-closed,-blocked"},
+	}, results)
+	assert.Equal(t, []string{
+		"comment ABC-1 [~bob] ABC-1 has no update for 3 days.",
+		"transition ABC-3 Resolve",
+		"edit ABC-3 auto-closed,-blocked",
+	}, client.calls)
+	assert.Equal(t, Fired{
+		"stale":        {"ABC-1": now},
+		"close-parent": {"ABC-3": now},
+	}, e.Fired)
+
+	// Issues that keep matching don't fire again.
+	client.calls = nil
+	assert.Empty(t, e.Run(rules))
+	assert.Empty(t, client.calls)
+
+	// Rules are re-armed once issues stop matching.
+	client.issues[rules[0].JQL] = client.issues[rules[0].JQL][1:]
+	assert.Empty(t, e.Run(rules))
+	assert.NotContains(t, e.Fired, "stale")
+
+	// Rules with a cooldown fire again once it is over.
+	now = now.Add(25 * time.Hour)
+	results = e.Run(rules)
+	assert.Len(t, results, 2)
+	assert.Equal(t, "ABC-3", results[0].Key)
+	assert.Equal(t, now, e.Fired["close-parent"]["ABC-3"])
+}
+
+func TestEngineRunSkipsAndFailures(t *testing.T) {
+	rules := []Rule{
+		{Name: "done", JQL: "a", Actions: []Action{{Transition: "Done"}, {Assign: "bob"}}},
+		{Name: "missing", JQL: "b", Actions: []Action{{Transition: "Archived"}, {Comment: "never"}}},
+		{Name: "broken", JQL: "c", Actions: []Action{{Assign: "none"}}},
+	}
+
+	client := &fakeClient{
+		issues: map[string][]*jira.Issue{
+			"a": {issue("ABC-1", "Done", "")},
+			"b": {issue("ABC-2", "To Do", "")},
+		},
+	}
+	e := Engine{Client: client}
+
+	results := e.Run(rules[:2])
+	assert.Equal(t, []Result{
+		{Rule: "done", Key: "ABC-1", Action: "transition to Done", Skipped: true},
+		{Rule: "done", Key: "ABC-1", Action: "assign to bob", Skipped: true},
+		{Rule: "missing", Key: "ABC-2", Action: "transition to Archived", Err: errors.New(`no transition to "Archived" available for issue ABC-2`)},
+	}, results)
+	assert.Empty(t, client.calls)
+
+	client.searchErr = errors.New("boom")
+	results = e.Run(rules[2:])
+	assert.Equal(t, []Result{{Rule: "broken", Action: "search", Err: client.searchErr}}, results)
+}
+
+func TestEngineRunRetriesFailedActions(t *testing.T) {
+	rules := []Rule{{Name: "nudge", JQL: "a", Actions: []Action{{Comment: "ping"}, {Assign: "none"}}}}
+	client := &fakeClient{
+		issues:     map[string][]*jira.Issue{"a": {issue("ABC-1", "To Do", "")}},
+		commentErr: errors.New("boom"),
+	}
+	e := Engine{Client: client}
+
+	results := e.Run(rules)
+	assert.Equal(t, []Result{{Rule: "nudge", Key: "ABC-1", Action: "comment", Err: client.commentErr}}, results)
+	assert.Empty(t, client.calls)
+	assert.NotContains(t, e.Fired, "nudge")
+
+	// The rule fires again on the next run and is marked fired once all actions succeed.
+	client.commentErr = nil
+	results = e.Run(rules)
+	assert.Len(t, results, 2)
+	assert.Equal(t, []string{"comment ABC-1 ping", "assign ABC-1 none"}, client.calls)
+	assert.Contains(t, e.Fired["nudge"], "ABC-1")
+
+	client.calls = nil
+	assert.Empty(t, e.Run(rules))
+	assert.Empty(t, client.calls)
+}
+
+func TestEngineRunKeepsIssuesBeyondTruncatedSearch(t *testing.T) {
+	rules := []Rule{{Name: "nudge", JQL: "a", Conditions: []Condition{{Field: "status", Op: OpEq, Value: Values{"To Do"}}}, Actions: []Action{{Comment: "ping"}}}}
+	client := &fakeClient{
+		issues: map[string][]*jira.Issue{"a": {
+			issue("ABC-1", "To Do", ""),
+			issue("ABC-2", "To Do", ""),
+			issue("ABC-3", "To Do", ""),
+		}},
+	}
+	e := Engine{Client: client}
+
+	assert.Len(t, e.Run(rules), 3)
+	assert.Len(t, e.Fired["nudge"], 3)
+
+	// ABC-3 is beyond the limit and ABC-1 no longer meets the conditions.
+	client.calls = nil
+	client.limit = 2
+	client.issues["a"][0].Fields.Status.Name = "Done"
+	assert.Empty(t, e.Run(rules))
+	assert.NotContains(t, e.Fired["nudge"], "ABC-1")
+	assert.Contains(t, e.Fired["nudge"], "ABC-2")
+	assert.Contains(t, e.Fired["nudge"], "ABC-3")
+
+	// ABC-3 doesn't fire again once it is back in the results.
+	client.limit = 0
+	assert.Empty(t, e.Run(rules))
+	assert.Empty(t, client.calls)
+}
+
+func TestEngineDryRun(t *testing.T) {
+	rules := []Rule{{Name: "unassign", JQL: "a", Actions: []Action{{Assign: "none"}}}}
+	client := &fakeClient{issues: map[string][]*jira.Issue{"a": {issue("ABC-1", "To Do", "")}}}
+
+	e := Engine{Client: client, DryRun: true}
+
+	assert.Equal(t, []Result{{Rule: "unassign", Key: "ABC-1", Action: "assign to none"}}, e.Run(rules))
+	assert.Equal(t, []Result{{Rule: "unassign", Key: "ABC-1", Action: "assign to none"}}, e.Run(rules))
+	assert.Empty(t, client.calls)
+	assert.Empty(t, e.Fired)
+}
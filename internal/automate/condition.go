@@ -0,0 +1,272 @@
+package automate
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// Condition is a predicate on a field of an issue, eg:
+//
+//	field: subtasks.status
+//	op: all
+//	value: [Done, Closed]
+type Condition struct {
+	Field string `yaml:"field"`
+	Op    string `yaml:"op"`
+	Value Values `yaml:"value"`
+}
+
+// Values is a list of values that can be written as a single value in YAML.
+type Values []string
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (v *Values) UnmarshalYAML(n *yaml.Node) error {
+	if n.Kind == yaml.SequenceNode {
+		var list []string
+		if err := n.Decode(&list); err != nil {
+			return err
+		}
+		*v = list
+		return nil
+	}
+	var s string
+	if err := n.Decode(&s); err != nil {
+		return err
+	}
+	*v = Values{s}
+	return nil
+}
+
+// Operators supported by conditions.
+const (
+	OpEq          = "eq"
+	OpNe          = "ne"
+	OpIn          = "in"
+	OpNotIn       = "not_in"
+	OpContains    = "contains"
+	OpNotContains = "not_contains"
+	OpEmpty       = "empty"
+	OpNotEmpty    = "not_empty"
+	OpAll         = "all"
+	OpOlderThan   = "older_than"
+	OpNewerThan   = "newer_than"
+	OpGt          = "gt"
+	OpLt          = "lt"
+)
+
+var (
+	// Fields are the issue fields conditions can use.
+	Fields = []string{
+		"key", "project", "summary", "type", "status", "priority", "resolution", "assignee",
+		"reporter", "labels", "components", "fixVersions", "parent", "created", "updated",
+		"comments", "subtasks", "subtasks.status",
+	}
+
+	// Operators are the operators conditions can use.
+	Operators = []string{
+		OpEq, OpNe, OpIn, OpNotIn, OpContains, OpNotContains, OpEmpty, OpNotEmpty,
+		OpAll, OpOlderThan, OpNewerThan, OpGt, OpLt,
+	}
+
+	dateFields = []string{"created", "updated"}
+)
+
+// Validate checks if the field and operator are known and the value fits the operator.
+func (c Condition) Validate() error {
+	if !containsFold(Fields, c.Field) {
+		return fmt.Errorf("unknown field %q, valid fields are: %s", c.Field, strings.Join(Fields, ", "))
+	}
+	if !containsFold(Operators, c.Op) {
+		return fmt.Errorf("unknown op %q for field %q, valid ops are: %s", c.Op, c.Field, strings.Join(Operators, ", "))
+	}
+
+	switch op := strings.ToLower(c.Op); op {
+	case OpEmpty, OpNotEmpty:
+		return nil
+	case OpOlderThan, OpNewerThan:
+		if !containsFold(dateFields, c.Field) {
+			return fmt.Errorf("op %q only works with date fields: %s", c.Op, strings.Join(dateFields, ", "))
+		}
+		if len(c.Value) != 1 {
+			return fmt.Errorf("op %q for field %q needs a single period, eg: 3d", c.Op, c.Field)
+		}
+		_, err := ParsePeriod(c.Value[0])
+		return err
+	case OpGt, OpLt:
+		if len(c.Value) != 1 {
+			return fmt.Errorf("op %q for field %q needs a single number", c.Op, c.Field)
+		}
+		if _, err := strconv.Atoi(c.Value[0]); err != nil {
+			return fmt.Errorf("op %q for field %q needs a number, got %q", c.Op, c.Field, c.Value[0])
+		}
+		return nil
+	}
+	if len(c.Value) == 0 {
+		return fmt.Errorf("missing value for field %q", c.Field)
+	}
+	return nil
+}
+
+// Matches tells if the issue satisfies the condition at the given time.
+//
+// Fields with multiple values, eg: labels, satisfy eq and contains if any of their values do,
+// and `all` if each of their values is one of the condition values.
+func (c Condition) Matches(iss *jira.Issue, now time.Time) bool {
+	values := fieldValues(iss, strings.ToLower(c.Field))
+
+	switch strings.ToLower(c.Op) {
+	case OpEq, OpIn:
+		return anyOf(values, func(v string) bool { return containsFold(c.Value, v) })
+	case OpNe, OpNotIn:
+		return !anyOf(values, func(v string) bool { return containsFold(c.Value, v) })
+	case OpContains:
+		return anyOf(values, func(v string) bool { return containsSubstr(c.Value, v) })
+	case OpNotContains:
+		return !anyOf(values, func(v string) bool { return containsSubstr(c.Value, v) })
+	case OpEmpty:
+		return !anyOf(values, func(v string) bool { return v != "" })
+	case OpNotEmpty:
+		return anyOf(values, func(v string) bool { return v != "" })
+	case OpAll:
+		return len(values) > 0 && !anyOf(values, func(v string) bool { return !containsFold(c.Value, v) })
+	case OpOlderThan, OpNewerThan:
+		return c.matchAge(values, now)
+	case OpGt, OpLt:
+		return c.matchNumber(values)
+	}
+	return false
+}
+
+func (c Condition) matchAge(values []string, now time.Time) bool {
+	if len(values) == 0 || len(c.Value) == 0 {
+		return false
+	}
+	t, err := time.Parse(jira.RFC3339, values[0])
+	if err != nil {
+		return false
+	}
+	d, err := ParsePeriod(c.Value[0])
+	if err != nil {
+		return false
+	}
+	if strings.EqualFold(c.Op, OpOlderThan) {
+		return now.Sub(t) > d
+	}
+	return now.Sub(t) < d
+}
+
+func (c Condition) matchNumber(values []string) bool {
+	if len(values) == 0 || len(c.Value) == 0 {
+		return false
+	}
+	got, err := strconv.Atoi(values[0])
+	if err != nil {
+		return false
+	}
+	want, err := strconv.Atoi(c.Value[0])
+	if err != nil {
+		return false
+	}
+	if strings.EqualFold(c.Op, OpGt) {
+		return got > want
+	}
+	return got < want
+}
+
+// fieldValues returns values of a field of the issue. Unset single value fields return
+// an empty string and unset multi value fields, eg: labels, return no values.
+func fieldValues(iss *jira.Issue, field string) []string {
+	f := iss.Fields
+
+	switch field {
+	case "key":
+		return []string{iss.Key}
+	case "project":
+		project, _, _ := strings.Cut(iss.Key, "-")
+		return []string{project}
+	case "summary":
+		return []string{f.Summary}
+	case "type":
+		return []string{f.IssueType.Name}
+	case "status":
+		return []string{f.Status.Name}
+	case "priority":
+		return []string{f.Priority.Name}
+	case "resolution":
+		return []string{f.Resolution.Name}
+	case "assignee":
+		return []string{f.Assignee.Name}
+	case "reporter":
+		return []string{f.Reporter.Name}
+	case "labels":
+		return f.Labels
+	case "components":
+		out := make([]string, 0, len(f.Components))
+		for _, c := range f.Components {
+			out = append(out, c.Name)
+		}
+		return out
+	case "fixversions":
+		out := make([]string, 0, len(f.FixVersions))
+		for _, v := range f.FixVersions {
+			out = append(out, v.Name)
+		}
+		return out
+	case "parent":
+		if f.Parent == nil {
+			return []string{""}
+		}
+		return []string{f.Parent.Key}
+	case "created":
+		return []string{f.Created}
+	case "updated":
+		return []string{f.Updated}
+	case "comments":
+		return []string{strconv.Itoa(f.Comment.Total)}
+	case "subtasks", "subtasks.status":
+		out := make([]string, 0, len(f.Subtasks))
+		for _, st := range f.Subtasks {
+			if field == "subtasks" {
+				out = append(out, st.Key)
+			} else {
+				out = append(out, st.Fields.Status.Name)
+			}
+		}
+		return out
+	}
+	return nil
+}
+
+func anyOf(values []string, fn func(string) bool) bool {
+	for _, v := range values {
+		if fn(v) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsFold(list []string, s string) bool {
+	for _, v := range list {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsSubstr(list []string, s string) bool {
+	s = strings.ToLower(s)
+	for _, v := range list {
+		if strings.Contains(s, strings.ToLower(v)) {
+			return true
+		}
+	}
+	return false
+}
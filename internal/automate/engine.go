@@ -0,0 +1,290 @@
+package automate
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ankitpokhrel/jira-cli/internal/workflow"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// Client is the part of the jira api rules use.
+type Client interface {
+	// Search returns up to limit issues matching the jql. complete tells
+	// if there are no more matching issues beyond the returned ones.
+	Search(jql string, limit uint) (issues []*jira.Issue, complete bool, err error)
+	Transitions(key string) ([]*jira.Transition, error)
+	Transition(key string, tr *jira.Transition) error
+	Comment(key, body string) error
+	// Assign assigns the issue to a user, `none` or `default`.
+	Assign(key, user string) error
+	Edit(key string, req *jira.EditRequest) error
+	// Mention returns the markup that mentions a user on the issue, eg: [~accountid:123].
+	Mention(key, user string) (string, error)
+}
+
+// Fired keeps track of when rules last ran actions for issues, keyed by rule name and issue key.
+type Fired map[string]map[string]time.Time
+
+// Result is the outcome of an action of a rule for an issue.
+type Result struct {
+	Rule   string
+	Key    string
+	Action string
+	// Skipped is set if the action wasn't needed, eg: the issue is already in the target status.
+	Skipped bool
+	Err     error
+}
+
+// Engine evaluates rules and runs their actions.
+type Engine struct {
+	Client   Client
+	Workflow workflow.Workflow
+	// Fired is updated as rules run actions. Set it to the previous
+	// value to keep rules from running again for the same issues.
+	Fired Fired
+	// Server is used to build issue links for comment templates.
+	Server string
+	// DryRun reports actions without running them.
+	DryRun bool
+	// Now returns the current time, defaults to time.Now.
+	Now func() time.Time
+}
+
+// issueData is passed to comment templates.
+type issueData struct {
+	Rule     string
+	Key      string
+	URL      string
+	Summary  string
+	Type     string
+	Status   string
+	Priority string
+	Assignee string
+	Reporter string
+	Parent   string
+	Labels   []string
+}
+
+// Run evaluates the rules once and runs actions for issues that started matching.
+func (e *Engine) Run(rules []Rule) []Result {
+	if e.Fired == nil {
+		e.Fired = make(Fired)
+	}
+	now := time.Now()
+	if e.Now != nil {
+		now = e.Now()
+	}
+
+	var results []Result
+	for _, r := range rules {
+		if r.Disabled {
+			continue
+		}
+		results = append(results, e.runRule(r, now)...)
+	}
+	return results
+}
+
+func (e *Engine) runRule(r Rule, now time.Time) []Result {
+	issues, complete, err := e.Client.Search(r.JQL, r.limit())
+	if err != nil {
+		return []Result{{Rule: r.Name, Action: "search", Err: err}}
+	}
+
+	matched := make(map[string]*jira.Issue)
+	for _, iss := range issues {
+		if r.matches(iss, now) {
+			matched[iss.Key] = iss
+		}
+	}
+
+	fired := e.Fired[r.Name]
+	if !e.DryRun {
+		// Re-arm the rule for issues that no longer match. If the search was
+		// truncated, issues beyond the limit may still match and are kept.
+		fetched := make(map[string]bool, len(issues))
+		for _, iss := range issues {
+			fetched[iss.Key] = true
+		}
+		for key := range fired {
+			if _, ok := matched[key]; ok {
+				continue
+			}
+			if complete || fetched[key] {
+				delete(fired, key)
+			}
+		}
+	}
+
+	var results []Result
+	for _, iss := range issues {
+		if _, ok := matched[iss.Key]; !ok {
+			continue
+		}
+		if at, ok := fired[iss.Key]; ok {
+			cooldown := r.CooldownPeriod()
+			if cooldown == 0 || now.Sub(at) < cooldown {
+				continue
+			}
+		}
+		res := e.runActions(r, iss)
+		results = append(results, res...)
+
+		// Failed actions are retried on the next run.
+		if !e.DryRun && succeeded(res) {
+			if e.Fired[r.Name] == nil {
+				e.Fired[r.Name] = make(map[string]time.Time)
+			}
+			e.Fired[r.Name][iss.Key] = now.UTC()
+		}
+	}
+	if !e.DryRun && len(e.Fired[r.Name]) == 0 {
+		delete(e.Fired, r.Name)
+	}
+	return results
+}
+
+func succeeded(results []Result) bool {
+	for _, res := range results {
+		if res.Err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// runActions runs actions of the rule in order and stops at the first failure.
+func (e *Engine) runActions(r Rule, iss *jira.Issue) []Result {
+	results := make([]Result, 0, len(r.Actions))
+	for _, a := range r.Actions {
+		res := Result{Rule: r.Name, Key: iss.Key, Action: a.String()}
+		if !e.DryRun {
+			res.Skipped, res.Err = e.run(r, a, iss)
+		}
+		results = append(results, res)
+		if res.Err != nil {
+			break
+		}
+	}
+	return results
+}
+
+func (e *Engine) run(r Rule, a Action, iss *jira.Issue) (bool, error) {
+	switch {
+	case a.Transition != "":
+		return e.transition(iss, a.Transition)
+	case a.Comment != "":
+		mention := func(user string) (string, error) {
+			if user == "" {
+				return "", nil
+			}
+			return e.Client.Mention(iss.Key, user)
+		}
+		body, err := render(a.Comment, e.data(r, iss), mention)
+		if err != nil {
+			return false, fmt.Errorf("unable to render comment: %w", err)
+		}
+		return false, e.Client.Comment(iss.Key, body)
+	case a.Assign != "":
+		if strings.EqualFold(iss.Fields.Assignee.Name, a.Assign) {
+			return true, nil
+		}
+		return false, e.Client.Assign(iss.Key, a.Assign)
+	case len(a.Labels) > 0:
+		return false, e.Client.Edit(iss.Key, &jira.EditRequest{Labels: a.Labels})
+	}
+	return false, e.Client.Edit(iss.Key, &jira.EditRequest{
+		Summary:      a.Edit.Summary,
+		Priority:     a.Edit.Priority,
+		Components:   a.Edit.Components,
+		CustomFields: a.Edit.CustomFields,
+	})
+}
+
+// transition moves the issue using a workflow state, a transition name or a target status.
+// It is skipped if the issue is already in the target status.
+func (e *Engine) transition(iss *jira.Issue, to string) (bool, error) {
+	status := iss.Fields.Status.Name
+	if strings.EqualFold(status, to) {
+		return true, nil
+	}
+
+	state, isState := e.state(to)
+	if isState {
+		if s, ok := e.Workflow.StateOf(status); ok && s == state {
+			return true, nil
+		}
+	}
+
+	transitions, err := e.Client.Transitions(iss.Key)
+	if err != nil {
+		return false, err
+	}
+
+	var tr *jira.Transition
+	if isState {
+		tr, err = e.Workflow.Transition(state, transitions)
+		if err != nil && !errors.Is(err, workflow.ErrNoTransition) {
+			return false, fmt.Errorf("issue %s: %w", iss.Key, err)
+		}
+	}
+	if tr == nil {
+		for _, t := range transitions {
+			if strings.EqualFold(t.Name, to) || (t.To != nil && strings.EqualFold(t.To.Name, to)) {
+				tr = t
+				break
+			}
+		}
+	}
+	if tr == nil {
+		return false, fmt.Errorf("no transition to %q available for issue %s", to, iss.Key)
+	}
+	return false, e.Client.Transition(iss.Key, tr)
+}
+
+func (e *Engine) state(name string) (workflow.State, bool) {
+	if e.Workflow == nil {
+		return "", false
+	}
+	for _, s := range workflow.States {
+		if strings.EqualFold(string(s), name) {
+			return s, true
+		}
+	}
+	return "", false
+}
+
+func (e *Engine) data(r Rule, iss *jira.Issue) issueData {
+	f := iss.Fields
+
+	d := issueData{
+		Rule:     r.Name,
+		Key:      iss.Key,
+		Summary:  f.Summary,
+		Type:     f.IssueType.Name,
+		Status:   f.Status.Name,
+		Priority: f.Priority.Name,
+		Assignee: f.Assignee.Name,
+		Reporter: f.Reporter.Name,
+		Labels:   f.Labels,
+	}
+	if e.Server != "" {
+		d.URL = fmt.Sprintf("%s/browse/%s", strings.TrimRight(e.Server, "/"), iss.Key)
+	}
+	if f.Parent != nil {
+		d.Parent = f.Parent.Key
+	}
+	return d
+}
+
+func (r Rule) matches(iss *jira.Issue, now time.Time) bool {
+	for _, c := range r.Conditions {
+		if !c.Matches(iss, now) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,241 @@
+// Package automate evaluates locally defined automation rules. Rules find issues
+// with a JQL, filter them with field predicates and act on them using the jira api.
+package automate
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultLimit is the maximum number of issues a rule looks into if not set.
+const DefaultLimit = 50
+
+const (
+	hoursInDay = 24
+	daysInWeek = 7
+)
+
+var period = regexp.MustCompile(`^(\d+)([wd])$`)
+
+// File is a rules file, eg:
+//
+//	rules:
+//	  - name: stale-in-progress
+//	    jql: sprint in openSprints() AND status = "In Progress"
+//	    conditions:
+//	      - field: updated
+//	        op: older_than
+//	        value: 3d
+//	    actions:
+//	      - comment: "{{mention .Assignee}} any update on this?"
+type File struct {
+	Rules []Rule `yaml:"rules"`
+}
+
+// Rule acts on issues that match its JQL and conditions.
+//
+// Rules are edge triggered: actions run once for an issue when it starts matching
+// and the rule is re-armed once the issue stops matching. Set Cooldown to run the
+// actions again, after the given period, for issues that keep matching.
+type Rule struct {
+	Name       string      `yaml:"name"`
+	JQL        string      `yaml:"jql"`
+	Conditions []Condition `yaml:"conditions"`
+	Actions    []Action    `yaml:"actions"`
+	Cooldown   string      `yaml:"cooldown"`
+	Limit      uint        `yaml:"limit"`
+	Disabled   bool        `yaml:"disabled"`
+}
+
+// Action is a single change to an issue. Exactly one of the fields must be set.
+type Action struct {
+	// Transition moves the issue using a transition name, a target status
+	// or a workflow state, eg: done.
+	Transition string `yaml:"transition"`
+	// Comment adds a comment. It is a text/template executed with the issue.
+	Comment string `yaml:"comment"`
+	// Assign assigns the issue to a user, `none` unassigns it and `default`
+	// assigns it to the default assignee.
+	Assign string `yaml:"assign"`
+	// Labels adds labels, labels prefixed with `-` are removed.
+	Labels []string `yaml:"labels"`
+	// Edit updates fields of the issue.
+	Edit *Edit `yaml:"edit"`
+}
+
+// Edit is a set of fields to update.
+type Edit struct {
+	Summary    string   `yaml:"summary"`
+	Priority   string   `yaml:"priority"`
+	Components []string `yaml:"components"`
+	// CustomFields maps custom field names, as in the config, to values.
+	CustomFields map[string]string `yaml:"custom"`
+}
+
+// Load reads and validates rules from a YAML file.
+func Load(path string) ([]Rule, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+
+	return Parse(f)
+}
+
+// Parse reads and validates rules from YAML.
+func Parse(r io.Reader) ([]Rule, error) {
+	dec := yaml.NewDecoder(r)
+	dec.KnownFields(true)
+
+	var file File
+	if err := dec.Decode(&file); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("invalid rules file: %w", err)
+	}
+
+	names := make(map[string]struct{}, len(file.Rules))
+	for i, r := range file.Rules {
+		if err := r.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid rule %s: %w", r.label(i), err)
+		}
+		if _, ok := names[r.Name]; ok {
+			return nil, fmt.Errorf("duplicate rule name %q", r.Name)
+		}
+		names[r.Name] = struct{}{}
+	}
+	return file.Rules, nil
+}
+
+// Validate checks if the rule can be evaluated.
+func (r Rule) Validate() error {
+	if r.Name == "" {
+		return fmt.Errorf("missing name")
+	}
+	if strings.TrimSpace(r.JQL) == "" {
+		return fmt.Errorf("missing jql")
+	}
+	if r.Cooldown != "" {
+		if _, err := ParsePeriod(r.Cooldown); err != nil {
+			return err
+		}
+	}
+	for _, c := range r.Conditions {
+		if err := c.Validate(); err != nil {
+			return err
+		}
+	}
+	if len(r.Actions) == 0 {
+		return fmt.Errorf("missing actions")
+	}
+	for i, a := range r.Actions {
+		if err := a.Validate(); err != nil {
+			return fmt.Errorf("action #%d: %w", i+1, err)
+		}
+	}
+	return nil
+}
+
+// CooldownPeriod returns the cooldown of the rule, zero if the rule is edge triggered only.
+func (r Rule) CooldownPeriod() time.Duration {
+	d, _ := ParsePeriod(r.Cooldown)
+	return d
+}
+
+func (r Rule) limit() uint {
+	if r.Limit == 0 {
+		return DefaultLimit
+	}
+	return r.Limit
+}
+
+func (r Rule) label(i int) string {
+	if r.Name != "" {
+		return strconv.Quote(r.Name)
+	}
+	return "#" + strconv.Itoa(i+1)
+}
+
+// Validate checks if exactly one change is set and the comment template is valid.
+func (a Action) Validate() error {
+	set := 0
+	for _, ok := range []bool{a.Transition != "", a.Comment != "", a.Assign != "", len(a.Labels) > 0, a.Edit != nil} {
+		if ok {
+			set++
+		}
+	}
+	if set != 1 {
+		return fmt.Errorf("exactly one of transition, comment, assign, labels or edit must be set")
+	}
+	if a.Comment != "" {
+		if _, err := render(a.Comment, issueData{}, nil); err != nil {
+			return fmt.Errorf("invalid comment template: %w", err)
+		}
+	}
+	return nil
+}
+
+// String describes the action, eg: `transition to Done`.
+func (a Action) String() string {
+	switch {
+	case a.Transition != "":
+		return "transition to " + a.Transition
+	case a.Comment != "":
+		return "comment"
+	case a.Assign != "":
+		return "assign to " + a.Assign
+	case len(a.Labels) > 0:
+		return "update labels " + strings.Join(a.Labels, ",")
+	}
+	return "edit"
+}
+
+// ParsePeriod parses a duration like 3d, 2w or any duration supported by time.ParseDuration.
+func ParsePeriod(s string) (time.Duration, error) {
+	if m := period.FindStringSubmatch(s); m != nil {
+		n, err := strconv.Atoi(m[1])
+		if err != nil {
+			return 0, err
+		}
+		d := time.Duration(n) * hoursInDay * time.Hour
+		if m[2] == "w" {
+			d *= daysInWeek
+		}
+		return d, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid period %q, eg: 30m, 12h, 3d or 2w", s)
+	}
+	return d, nil
+}
+
+func newTemplate(text string, mention func(string) (string, error)) (*template.Template, error) {
+	if mention == nil {
+		mention = func(name string) (string, error) { return name, nil }
+	}
+	return template.New("comment").Option("missingkey=error").Funcs(template.FuncMap{
+		"mention": mention,
+	}).Parse(text)
+}
+
+func render(text string, data any, mention func(string) (string, error)) (string, error) {
+	tmpl, err := newTemplate(text, mention)
+	if err != nil {
+		return "", err
+	}
+	var b bytes.Buffer
+	if err := tmpl.Execute(&b, data); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,49 @@
+package alias
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/alias/delete"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/alias/list"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/alias/set"
+)
+
+const helpText = `Alias manages command shortcuts. See available commands below.
+
+Aliases are stored in the config file and expanded before the command is run:
+  aliases:
+    standup: issue list -s"In Progress" --plain
+    review: issue move $1 "In Review"
+
+Positional arguments are substituted for $1, $2, ... and $@ expands to all
+arguments. Arguments that are not referenced are appended to the command.
+
+Aliases prefixed with ! are run by sh, use them for pipes and command
+substitution, eg: '!jira issue list -a$(jira me) "$@"'.
+
+Built-in commands always take precedence over aliases.`
+
+// NewCmdAlias is an alias command.
+func NewCmdAlias() *cobra.Command {
+	cmd := cobra.Command{
+		Use:         "alias",
+		Short:       "Alias manages command shortcuts",
+		Long:        helpText,
+		Annotations: map[string]string{"cmd:main": "true"},
+		RunE:        alias,
+		// Aliases are local to the config file and don't need a Jira token.
+		PersistentPreRun: func(*cobra.Command, []string) {},
+	}
+
+	cmd.AddCommand(
+		set.NewCmdSet(),
+		list.NewCmdList(),
+		delete.NewCmdDelete(),
+	)
+
+	return &cmd
+}
+
+func alias(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
@@ -0,0 +1,42 @@
+package delete
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+)
+
+// NewCmdDelete is a delete command.
+func NewCmdDelete() *cobra.Command {
+	return &cobra.Command{
+		Use:     "delete NAME",
+		Short:   "Delete removes an alias",
+		Long:    "Delete removes an alias from the config file.",
+		Example: "$ jira alias delete standup",
+		Aliases: []string{"remove", "rm"},
+		Args:    cobra.ExactArgs(1),
+		Run:     Delete,
+	}
+}
+
+// Delete removes an alias.
+func Delete(_ *cobra.Command, args []string) {
+	name := args[0]
+
+	configFile := viper.ConfigFileUsed()
+	if !jiraConfig.Exists(configFile) {
+		cmdutil.Failed("Missing configuration file.\nRun 'jira init' to configure the tool.")
+	}
+
+	err := jiraConfig.DeleteAlias(configFile, name)
+	if errors.Is(err, jiraConfig.ErrAliasNotFound) {
+		cmdutil.Failed("Alias %q not found", name)
+	}
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success("Alias %q deleted", name)
+}
@@ -0,0 +1,47 @@
+package list
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+)
+
+// NewCmdList is a list command.
+func NewCmdList() *cobra.Command {
+	return &cobra.Command{
+		Use:     "list",
+		Short:   "List configured aliases",
+		Long:    "List aliases configured in the config file.",
+		Example: "$ jira alias list",
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		Run:     List,
+	}
+}
+
+// List displays configured aliases.
+func List(*cobra.Command, []string) {
+	aliases := viper.GetStringMapString("aliases")
+	if len(aliases) == 0 {
+		cmdutil.Failed("No aliases found.\nUse 'jira alias set' to add one.")
+	}
+
+	names := make([]string, 0, len(aliases))
+	for name := range aliases {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 1, '\t', 0)
+	_, _ = fmt.Fprintln(w, "NAME\tEXPANSION")
+	for _, name := range names {
+		_, _ = fmt.Fprintf(w, "%s\t%s\n", name, aliases[name])
+	}
+	cmdutil.ExitIfError(w.Flush())
+}
@@ -0,0 +1,70 @@
+package set
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/internal/alias"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+	"github.com/ankitpokhrel/jira-cli/internal/plugin"
+)
+
+const examples = `$ jira alias set standup 'issue list -s"In Progress" --plain'
+
+# Use positional arguments, eg: jira review ISS-1
+$ jira alias set review 'issue move $1 "In Review"'
+
+# Run through the shell to use pipes or command substitution
+$ jira alias set mine '!jira issue list -a$(jira me) "$@"'`
+
+// NewCmdSet is a set command.
+func NewCmdSet() *cobra.Command {
+	return &cobra.Command{
+		Use:     "set NAME EXPANSION",
+		Short:   "Set creates or updates an alias",
+		Long:    "Set creates or updates an alias in the config file.",
+		Example: examples,
+		Aliases: []string{"add"},
+		Args:    cobra.ExactArgs(2),
+		Run:     Set,
+	}
+}
+
+// Set creates or updates an alias.
+func Set(cmd *cobra.Command, args []string) {
+	name, expansion := args[0], args[1]
+
+	if err := alias.ValidateName(name); err != nil {
+		cmdutil.Failed("Invalid alias %q: %s", name, err)
+	}
+	if _, _, err := cmd.Root().Find([]string{name}); err == nil {
+		cmdutil.Failed("Invalid alias %q: it is a built-in command", name)
+	}
+
+	if !alias.IsShell(expansion) {
+		words, err := alias.Split(expansion)
+		if err != nil {
+			cmdutil.Failed("Invalid expansion %q: %s", expansion, err)
+		}
+		if _, _, err := cmd.Root().Find(words); err != nil {
+			if _, ok := plugin.Lookup(words[0], plugin.Dirs()); !ok {
+				cmdutil.Failed("Invalid expansion %q: %q is not a jira command\nPrefix the expansion with ! to run it with the shell.", expansion, words[0])
+			}
+		}
+	}
+
+	configFile := viper.ConfigFileUsed()
+	if !jiraConfig.Exists(configFile) {
+		cmdutil.Failed("Missing configuration file.\nRun 'jira init' to configure the tool.")
+	}
+
+	_, exists := viper.GetStringMapString("aliases")[name]
+	cmdutil.ExitIfError(jiraConfig.SetAlias(configFile, name, expansion))
+
+	if exists {
+		cmdutil.Success("Alias %q changed to %q", name, expansion)
+	} else {
+		cmdutil.Success("Alias %q now expands to %q", name, expansion)
+	}
+}
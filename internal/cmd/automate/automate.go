@@ -0,0 +1,29 @@
+package automate
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/automate/run"
+)
+
+const helpText = `Automate runs locally defined automation rules against Jira issues. See available commands below.`
+
+// NewCmdAutomate is an automate command.
+func NewCmdAutomate() *cobra.Command {
+	cmd := cobra.Command{
+		Use:         "automate",
+		Short:       "Automate runs local automation rules",
+		Long:        helpText,
+		Annotations: map[string]string{"cmd:main": "true"},
+		Aliases:     []string{"automation", "rules"},
+		RunE:        automate,
+	}
+
+	cmd.AddCommand(run.NewCmdRun())
+
+	return &cmd
+}
+
+func automate(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
@@ -0,0 +1,122 @@
+package run
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const maxUserResults = 100
+
+// client implements automate.Client using the jira api.
+type client struct {
+	client *jira.Client
+	users  map[string]*jira.User
+}
+
+func newClient(c *jira.Client) *client {
+	return &client{client: c, users: make(map[string]*jira.User)}
+}
+
+func (c *client) Search(jql string, limit uint) ([]*jira.Issue, bool, error) {
+	resp, err := api.ProxySearchAll(c.client, jql, limit)
+	if err != nil {
+		return nil, false, err
+	}
+	return resp.Issues, resp.IsLast, nil
+}
+
+func (c *client) Transitions(key string) ([]*jira.Transition, error) {
+	return api.ProxyTransitions(c.client, key)
+}
+
+func (c *client) Transition(key string, tr *jira.Transition) error {
+	_, err := c.client.Transition(key, &jira.TransitionRequest{
+		Transition: &jira.TransitionRequestData{
+			ID:   tr.ID.String(),
+			Name: tr.Name,
+		},
+	})
+	return err
+}
+
+func (c *client) Comment(key, body string) error {
+	return c.client.AddIssueComment(key, body, false)
+}
+
+func (c *client) Assign(key, user string) error {
+	switch strings.ToLower(user) {
+	case jira.AssigneeNone:
+		return api.ProxyAssignIssue(c.client, key, nil, jira.AssigneeNone)
+	case jira.AssigneeDefault:
+		return api.ProxyAssignIssue(c.client, key, nil, jira.AssigneeDefault)
+	}
+
+	u, err := c.user(key, user)
+	if err != nil {
+		return err
+	}
+	return api.ProxyAssignIssue(c.client, key, u, "")
+}
+
+func (c *client) Edit(key string, req *jira.EditRequest) error {
+	if len(req.CustomFields) > 0 {
+		configured, err := cmdcommon.GetConfiguredCustomFields()
+		if err != nil {
+			return err
+		}
+		if err := cmdcommon.ValidateCustomFields(req.CustomFields, configured); err != nil {
+			return err
+		}
+		req.WithCustomFields(configured)
+	}
+	return c.client.Edit(key, req)
+}
+
+func (c *client) Mention(key, user string) (string, error) {
+	u, err := c.user(key, user)
+	if err != nil {
+		return "", err
+	}
+	if viper.GetString("installation") == jira.InstallationTypeLocal {
+		return fmt.Sprintf("[~%s]", u.Name), nil
+	}
+	return fmt.Sprintf("[~accountid:%s]", u.AccountID), nil
+}
+
+// user finds a user assignable to the issue by name, display name or email.
+// Users are cached as rules usually refer to the same few people.
+func (c *client) user(key, query string) (*jira.User, error) {
+	project, _, _ := strings.Cut(key, "-")
+	id := project + "/" + strings.ToLower(query)
+	if u, ok := c.users[id]; ok {
+		return u, nil
+	}
+
+	users, err := api.ProxyUserSearch(c.client, &jira.UserSearchOptions{
+		Query:      query,
+		Project:    project,
+		MaxResults: maxUserResults,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to search for user: %w", err)
+	}
+	if len(users) == 0 {
+		return nil, fmt.Errorf("user %q not found", query)
+	}
+
+	u := users[0]
+	for _, usr := range users {
+		if strings.EqualFold(usr.Name, query) || strings.EqualFold(usr.DisplayName, query) || strings.EqualFold(usr.Email, query) {
+			u = usr
+			break
+		}
+	}
+	c.users[id] = u
+	return u, nil
+}
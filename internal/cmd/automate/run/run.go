@@ -0,0 +1,231 @@
+package run
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/automate"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+	"github.com/ankitpokhrel/jira-cli/internal/logger"
+	"github.com/ankitpokhrel/jira-cli/internal/workflow"
+)
+
+const (
+	helpText = `Run evaluates automation rules and acts on issues that match them.
+
+Rules are read from automation.yml in the config directory, the automation.rules
+config or the --rules flag. Each rule finds issues with a JQL, filters them with
+conditions on their fields and runs actions on issues that match:
+
+  rules:
+    - name: stale-in-progress
+      jql: sprint in openSprints() AND status = "In Progress"
+      conditions:
+        - field: updated
+          op: older_than
+          value: 3d
+      actions:
+        - comment: "{{mention .Assignee}} no update for 3 days, is this still in progress?"
+    - name: close-story
+      jql: issuetype = Story AND statusCategory != Done
+      conditions:
+        - field: subtasks.status
+          op: all
+          value: [Done, Closed]
+      actions:
+        - transition: done
+        - labels: [auto-closed]
+
+Fields: key, project, summary, type, status, priority, resolution, assignee, reporter,
+labels, components, fixVersions, parent, created, updated, comments, subtasks and
+subtasks.status. Ops: eq, ne, in, not_in, contains, not_contains, empty, not_empty,
+all, older_than, newer_than, gt and lt.
+
+Actions: transition (a transition name, target status or workflow state), comment
+(a template with .Key, .Summary, .Status, .Assignee, .URL and the mention function),
+assign (a user, none or default), labels (prefix with - to remove) and edit (summary,
+priority, components and custom fields).
+
+Actions run once for an issue when it starts matching a rule. The rule runs again for
+the issue once it stops matching, or after the cooldown of the rule if it is set.`
+
+	examples = `# Evaluate rules every 5 minutes
+$ jira automate run
+
+# Evaluate rules once, eg: from cron
+$ jira automate run --once
+
+# See what the rules would do without changing anything
+$ jira automate run --once --dry-run
+
+# Use a different rules file and interval
+$ jira automate run --rules ./rules.yml --interval 15m`
+
+	rulesFile       = "automation.yml"
+	defaultInterval = 5 * time.Minute
+)
+
+// NewCmdRun is a run command.
+func NewCmdRun() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "run",
+		Short:   "Run evaluates automation rules and acts on matching issues",
+		Long:    helpText,
+		Example: examples,
+		Args:    cobra.NoArgs,
+		RunE:    run,
+	}
+
+	cmd.Flags().String("rules", "", "Rules file to use\n"+
+		"Defaults to the automation.rules config or automation.yml in the config directory")
+	cmd.Flags().Bool("once", false, "Evaluate rules once and exit")
+	cmd.Flags().Duration("interval", defaultInterval, "Time between rule evaluations")
+	cmd.Flags().Bool("dry-run", false, "Show actions without running them")
+
+	cmd.MarkFlagsMutuallyExclusive("once", "interval")
+
+	return &cmd
+}
+
+func run(cmd *cobra.Command, _ []string) error {
+	debug, _ := cmd.Flags().GetBool("debug")
+	once, _ := cmd.Flags().GetBool("once")
+	interval, _ := cmd.Flags().GetDuration("interval")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+	if interval <= 0 {
+		return fmt.Errorf("interval must be positive")
+	}
+
+	path, err := rulesPath(cmd)
+	if err != nil {
+		return err
+	}
+	rules, err := automate.Load(path)
+	if err != nil {
+		return err
+	}
+	if len(rules) == 0 {
+		cmdutil.Warn("No rules found in %s", path)
+		return nil
+	}
+
+	wf, err := workflow.FromConfig()
+	if err != nil {
+		return err
+	}
+
+	st, err := loadState()
+	if err != nil {
+		return err
+	}
+
+	server := viper.GetString("server")
+	fired := st.fired(server)
+	prune(fired, rules)
+
+	log := logger.New(debug)
+	e := automate.Engine{
+		Client:   newClient(api.DefaultClient(debug)),
+		Workflow: wf,
+		Fired:    fired,
+		Server:   server,
+		DryRun:   dryRun,
+	}
+
+	pass := func() int {
+		failed := report(log, e.Run(rules), dryRun)
+		if !dryRun {
+			if err := st.save(); err != nil {
+				log.Error("Unable to save automation state: %s", err)
+			}
+		}
+		return failed
+	}
+
+	if once {
+		if failed := pass(); failed > 0 {
+			cmdutil.Failed("%d action(s) failed", failed)
+		}
+		return nil
+	}
+
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	log.Info("Evaluating %d rule(s) from %s every %s", len(rules), path, interval)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		pass()
+
+		select {
+		case <-ctx.Done():
+			log.Info("Stopped")
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// rulesPath returns the rules file from the flag, the config or the config directory.
+func rulesPath(cmd *cobra.Command) (string, error) {
+	if path, _ := cmd.Flags().GetString("rules"); path != "" {
+		return path, nil
+	}
+	if path := viper.GetString("automation.rules"); path != "" {
+		return path, nil
+	}
+	home, err := cmdutil.GetConfigHome()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, jiraConfig.Dir, rulesFile), nil
+}
+
+// prune forgets issues of rules that no longer exist.
+func prune(fired automate.Fired, rules []automate.Rule) {
+	names := make(map[string]struct{}, len(rules))
+	for _, r := range rules {
+		names[r.Name] = struct{}{}
+	}
+	for name := range fired {
+		if _, ok := names[name]; !ok {
+			delete(fired, name)
+		}
+	}
+}
+
+// report logs results and returns the number of failed actions.
+func report(log *logger.Logger, results []automate.Result, dryRun bool) int {
+	failed := 0
+	for _, res := range results {
+		switch {
+		case res.Err != nil:
+			failed++
+			if res.Key == "" {
+				log.Error("Rule %q: %s failed: %s", res.Rule, res.Action, res.Err)
+			} else {
+				log.Error("Rule %q: %s on %s failed: %s", res.Rule, res.Action, res.Key, res.Err)
+			}
+		case dryRun:
+			log.Info("Rule %q: would %s on %s", res.Rule, res.Action, res.Key)
+		case res.Skipped:
+			log.Debug("Rule %q: %s on %s skipped, nothing to change", res.Rule, res.Action, res.Key)
+		default:
+			log.Info("Rule %q: %s on %s", res.Rule, res.Action, res.Key)
+		}
+	}
+	return failed
+}
@@ -0,0 +1,69 @@
+package run
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+
+	"github.com/ankitpokhrel/jira-cli/internal/automate"
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+)
+
+const (
+	stateFile = "automate.json"
+
+	dirPerm  = 0o700
+	filePerm = 0o600
+)
+
+// state keeps track of issues rules already acted on per jira server.
+type state struct {
+	path  string
+	Fired map[string]automate.Fired `json:"fired"`
+}
+
+func loadState() (*state, error) {
+	path, err := jiraConfig.StatePath(stateFile)
+	if err != nil {
+		return nil, err
+	}
+
+	st := state{
+		path:  path,
+		Fired: make(map[string]automate.Fired),
+	}
+
+	b, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return &st, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(b, &st); err != nil {
+		return nil, err
+	}
+	if st.Fired == nil {
+		st.Fired = make(map[string]automate.Fired)
+	}
+	return &st, nil
+}
+
+func (s *state) fired(server string) automate.Fired {
+	if _, ok := s.Fired[server]; !ok {
+		s.Fired[server] = make(automate.Fired)
+	}
+	return s.Fired[server]
+}
+
+func (s *state) save() error {
+	b, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(s.path), dirPerm); err != nil {
+		return err
+	}
+	return os.WriteFile(s.path, b, filePerm)
+}
@@ -0,0 +1,43 @@
+package cache
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/cache/clear"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/cache/stats"
+)
+
+const helpText = `Cache manages the http response cache. See available commands below.
+
+Responses of metadata endpoints like fields, projects, boards, issue link types,
+transitions, create metadata, server info and the current user are cached on disk.
+Stale entries are revalidated with the server using ETag or Last-Modified headers.
+
+Use --no-cache with any command to bypass the cache. TTLs can be overridden
+per endpoint in the config file, a TTL of 0 disables caching for the endpoint:
+  cache:
+    ttl:
+      fields: 12h
+      transitions: 0`
+
+// NewCmdCache is a cache command.
+func NewCmdCache() *cobra.Command {
+	cmd := cobra.Command{
+		Use:         "cache",
+		Short:       "Cache manages the http response cache",
+		Long:        helpText,
+		Annotations: map[string]string{"cmd:main": "true"},
+		RunE:        cache,
+	}
+
+	cmd.AddCommand(
+		clear.NewCmdClear(),
+		stats.NewCmdStats(),
+	)
+
+	return &cmd
+}
+
+func cache(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
@@ -0,0 +1,63 @@
+package clear
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const examples = `$ jira cache clear
+
+# Only clear cached transitions
+$ jira cache clear --endpoint transitions`
+
+// NewCmdClear is a clear command.
+func NewCmdClear() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "clear",
+		Short:   "Clear removes cached http responses",
+		Long:    "Clear removes cached http responses.",
+		Example: examples,
+		Args:    cobra.NoArgs,
+		Run:     Clear,
+	}
+
+	cmd.Flags().StringArray("endpoint", []string{}, "Only clear responses of the given endpoint, eg: fields, transitions")
+
+	return &cmd
+}
+
+// Clear clears the cache.
+func Clear(cmd *cobra.Command, _ []string) {
+	endpoints, err := cmd.Flags().GetStringArray("endpoint")
+	cmdutil.ExitIfError(err)
+
+	dir, err := api.CacheDir()
+	cmdutil.ExitIfError(err)
+
+	store := jira.NewDiskCache(dir)
+	if len(endpoints) == 0 {
+		cmdutil.ExitIfError(store.Clear())
+		cmdutil.Success("Cache cleared")
+		return
+	}
+
+	valid := make(map[string]bool)
+	for _, r := range jira.DefaultCacheRules() {
+		valid[r.Name] = true
+	}
+	remove := make(map[string]bool, len(endpoints))
+	for _, e := range endpoints {
+		if !valid[e] {
+			cmdutil.Failed("Unknown endpoint %q", e)
+		}
+		remove[e] = true
+	}
+
+	cmdutil.ExitIfError(store.Delete(func(e *jira.CacheEntry) bool {
+		return remove[e.Rule]
+	}))
+	cmdutil.Success("Cache cleared")
+}
@@ -0,0 +1,46 @@
+package stats
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const examples = `$ jira cache stats
+
+# Get the raw JSON data
+$ jira cache stats --output json`
+
+// NewCmdStats is a stats command.
+func NewCmdStats() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "stats",
+		Short:   "Stats displays a summary of cached http responses",
+		Long:    "Stats displays number and size of cached http responses per endpoint.",
+		Example: examples,
+		Args:    cobra.NoArgs,
+		Run:     Stats,
+	}
+
+	cmd.Flags().String("output", "", "Output format: json (default: table)")
+
+	return &cmd
+}
+
+// Stats displays cache stats.
+func Stats(cmd *cobra.Command, _ []string) {
+	output, err := cmd.Flags().GetString("output")
+	cmdutil.ExitIfError(err)
+
+	dir, err := api.CacheDir()
+	cmdutil.ExitIfError(err)
+
+	stats, err := jira.NewDiskCache(dir).Stats(api.CacheRules())
+	cmdutil.ExitIfError(err)
+
+	v := view.NewCacheStats(dir, stats, view.WithCacheStatsJSON(output == "json"))
+	cmdutil.ExitIfError(v.Render())
+}
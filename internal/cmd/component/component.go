@@ -0,0 +1,39 @@
+package component
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/component/create"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/component/delete"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/component/issues"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/component/list"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/component/update"
+)
+
+const helpText = `Component manages Jira project components. See available commands below.`
+
+// NewCmdComponent is a component command.
+func NewCmdComponent() *cobra.Command {
+	cmd := cobra.Command{
+		Use:         "component",
+		Short:       "Component manages Jira project components",
+		Long:        helpText,
+		Annotations: map[string]string{"cmd:main": "true"},
+		Aliases:     []string{"components"},
+		RunE:        components,
+	}
+
+	cmd.AddCommand(
+		list.NewCmdList(),
+		create.NewCmdCreate(),
+		update.NewCmdUpdate(),
+		delete.NewCmdDelete(),
+		issues.NewCmdIssues(),
+	)
+
+	return &cmd
+}
+
+func components(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
@@ -0,0 +1,80 @@
+package create
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Create creates a new project component.`
+	examples = `$ jira component create Backend
+
+# Create a component with a lead who gets new issues by default
+$ jira component create Backend --lead "Jane Doe" --assignee-type component-lead -d"Server side services"`
+)
+
+// NewCmdCreate is a create command.
+func NewCmdCreate() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "create NAME",
+		Short:   "Create creates a new project component",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"add"},
+		Annotations: map[string]string{
+			"help:args": "NAME\tComponent name, eg: Backend",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  Create,
+	}
+
+	cmd.Flags().StringP("description", "d", "", "Component description")
+	cmd.Flags().StringP("lead", "l", "", "Component lead")
+	cmd.Flags().String("assignee-type", "", "Default assignee type: project-default, component-lead, project-lead, unassigned")
+
+	return &cmd
+}
+
+// Create creates a new component.
+func Create(cmd *cobra.Command, args []string) {
+	project := viper.GetString("project.key")
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	description, err := cmd.Flags().GetString("description")
+	cmdutil.ExitIfError(err)
+
+	lead, err := cmd.Flags().GetString("lead")
+	cmdutil.ExitIfError(err)
+
+	assigneeType, err := cmd.Flags().GetString("assignee-type")
+	cmdutil.ExitIfError(err)
+
+	assigneeType, err = cmdcommon.ValidateAssigneeType(assigneeType)
+	cmdutil.ExitIfError(err)
+
+	client := api.DefaultClient(debug)
+
+	req := jira.ComponentRequest{
+		Name:         args[0],
+		Description:  description,
+		Project:      project,
+		AssigneeType: assigneeType,
+	}
+	cmdcommon.SetComponentLead(client, project, lead, &req)
+
+	component, err := func() (*jira.Component, error) {
+		s := cmdutil.Info("Creating component...")
+		defer s.Stop()
+
+		return client.CreateComponent(&req)
+	}()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success("Component created: %s (ID: %s)", component.Name, component.ID)
+}
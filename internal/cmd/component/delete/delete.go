@@ -0,0 +1,83 @@
+package delete
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+)
+
+const (
+	helpText = `Delete deletes a project component.
+
+Issues in the component are moved to the component set with --move-issues-to,
+otherwise the component is simply removed from them.`
+	examples = `$ jira component delete Legacy
+$ jira component delete 10002 --move-issues-to Backend`
+)
+
+// NewCmdDelete is a delete command.
+func NewCmdDelete() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "delete COMPONENT",
+		Short:   "Delete deletes a project component",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"remove", "rm"},
+		Annotations: map[string]string{
+			"help:args": "COMPONENT\tComponent id or name, eg: Backend",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  Delete,
+	}
+
+	cmd.Flags().String("move-issues-to", "", "Move issues to this component id or name")
+
+	return &cmd
+}
+
+// Delete deletes a component.
+func Delete(cmd *cobra.Command, args []string) {
+	project := viper.GetString("project.key")
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	moveIssuesTo, err := cmd.Flags().GetString("move-issues-to")
+	cmdutil.ExitIfError(err)
+
+	client := api.DefaultClient(debug)
+
+	total, err := func() (int, error) {
+		s := cmdutil.Info("Deleting component...")
+		defer s.Stop()
+
+		c, err := cmdcommon.ResolveComponent(client, project, args[0])
+		if err != nil {
+			return 0, err
+		}
+
+		var target string
+		if moveIssuesTo != "" {
+			t, err := cmdcommon.ResolveComponent(client, project, moveIssuesTo)
+			if err != nil {
+				return 0, err
+			}
+			target = t.ID
+		}
+
+		total, err := client.ComponentIssueCount(c.ID)
+		if err != nil {
+			return 0, err
+		}
+		return total, client.DeleteComponent(c.ID, target)
+	}()
+	cmdutil.ExitIfError(err)
+
+	if moveIssuesTo != "" && total > 0 {
+		cmdutil.Success("Component %s deleted, %d issues moved to %s", args[0], total, moveIssuesTo)
+		return
+	}
+	cmdutil.Success("Component %s deleted successfully", args[0])
+}
@@ -0,0 +1,39 @@
+package issues
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/list"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+)
+
+const examples = `$ jira component issues Backend
+
+# Unresolved issues in the component assigned to you
+$ jira component issues Backend -a$(jira me) -s~Done --plain`
+
+// NewCmdIssues is an issues command.
+func NewCmdIssues() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "issues COMPONENT",
+		Short:   "Issues lists issues in a project component",
+		Long:    "Issues lists issues in a project component. It accepts all filters supported by the issue list command.",
+		Example: examples,
+		Annotations: map[string]string{
+			"help:args": "COMPONENT\tComponent name, eg: Backend",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  issues,
+	}
+
+	listCmd := list.NewCmdList()
+	list.SetFlags(listCmd)
+	cmd.Flags().AddFlagSet(listCmd.Flags())
+
+	return &cmd
+}
+
+func issues(cmd *cobra.Command, args []string) {
+	cmdutil.ExitIfError(cmd.Flags().Set("component", args[0]))
+	cmdutil.ExitIfError(list.LoadList(cmd, nil))
+}
@@ -0,0 +1,70 @@
+package list
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const examples = `$ jira component list
+
+# List components of another project in plain mode
+$ jira component list -p PROJ --plain
+
+# Get the raw JSON data
+$ jira component list --output json`
+
+// NewCmdList is a list command.
+func NewCmdList() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "list",
+		Short:   "List lists Jira project components",
+		Long:    "List lists components of a Jira project.",
+		Example: examples,
+		Aliases: []string{"lists", "ls"},
+		Run:     List,
+	}
+
+	cmd.Flags().Bool("plain", false, "Display output in plain mode")
+	cmd.Flags().String("output", "", "Output format: json (default: table)")
+
+	return &cmd
+}
+
+// List displays a list view.
+func List(cmd *cobra.Command, _ []string) {
+	project := viper.GetString("project.key")
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	plain, err := cmd.Flags().GetBool("plain")
+	cmdutil.ExitIfError(err)
+
+	output, err := cmd.Flags().GetString("output")
+	cmdutil.ExitIfError(err)
+
+	components, err := func() ([]*jira.Component, error) {
+		s := cmdutil.Info("Fetching project components...")
+		defer s.Stop()
+
+		return api.DefaultClient(debug).Components(project)
+	}()
+	cmdutil.ExitIfError(err)
+
+	if len(components) == 0 && output != "json" {
+		cmdutil.Failed("No components found in project %q.", project)
+		return
+	}
+
+	v := view.NewComponent(
+		components,
+		view.WithComponentPlain(plain),
+		view.WithComponentJSON(output == "json"),
+	)
+
+	cmdutil.ExitIfError(v.Render())
+}
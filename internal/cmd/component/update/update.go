@@ -0,0 +1,90 @@
+package update
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Update updates an existing project component.`
+	examples = `$ jira component update Backend --name "Backend services"
+$ jira component update 10000 --lead "Jane Doe" --assignee-type component-lead`
+)
+
+// NewCmdUpdate is an update command.
+func NewCmdUpdate() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "update COMPONENT",
+		Short:   "Update updates an existing project component",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"edit"},
+		Annotations: map[string]string{
+			"help:args": "COMPONENT\tComponent id or name, eg: Backend",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  Update,
+	}
+
+	cmd.Flags().StringP("name", "n", "", "Component name")
+	cmd.Flags().StringP("description", "d", "", "Component description")
+	cmd.Flags().StringP("lead", "l", "", "Component lead")
+	cmd.Flags().String("assignee-type", "", "Default assignee type: project-default, component-lead, project-lead, unassigned")
+
+	return &cmd
+}
+
+// Update updates a component.
+func Update(cmd *cobra.Command, args []string) {
+	project := viper.GetString("project.key")
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	name, err := cmd.Flags().GetString("name")
+	cmdutil.ExitIfError(err)
+
+	description, err := cmd.Flags().GetString("description")
+	cmdutil.ExitIfError(err)
+
+	lead, err := cmd.Flags().GetString("lead")
+	cmdutil.ExitIfError(err)
+
+	assigneeType, err := cmd.Flags().GetString("assignee-type")
+	cmdutil.ExitIfError(err)
+
+	if name == "" && description == "" && lead == "" && assigneeType == "" {
+		cmdutil.Failed("At least one field must be provided to update")
+		return
+	}
+
+	assigneeType, err = cmdcommon.ValidateAssigneeType(assigneeType)
+	cmdutil.ExitIfError(err)
+
+	client := api.DefaultClient(debug)
+
+	req := jira.ComponentRequest{
+		Name:         name,
+		Description:  description,
+		AssigneeType: assigneeType,
+	}
+	cmdcommon.SetComponentLead(client, project, lead, &req)
+
+	component, err := func() (*jira.Component, error) {
+		s := cmdutil.Info("Updating component...")
+		defer s.Stop()
+
+		c, err := cmdcommon.ResolveComponent(client, project, args[0])
+		if err != nil {
+			return nil, err
+		}
+		return client.UpdateComponent(c.ID, &req)
+	}()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success("Component updated: %s (ID: %s)", component.Name, component.ID)
+}
@@ -0,0 +1,202 @@
+package feed
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/feed"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Feed shows recent activity on issues relevant to you.
+
+It collects status changes, field updates and comments on issues you are assigned to,
+reported, are watching or are mentioned in, and lists them chronologically. Your own
+activity is left out.
+
+The time the feed was last read is stored locally, so that the next run shows only
+new activity. The first run looks back a day. Use --since to look back further.`
+
+	examples = `$ jira feed
+
+# Show activity of the last week
+$ jira feed --since 1w
+
+# Show activity since a date without marking it as read
+$ jira feed --since 2024-05-01 --keep-unread
+
+# Get the raw JSON data
+$ jira feed --output json`
+
+	defaultLimit    = 50
+	defaultParallel = 4
+)
+
+// NewCmdFeed is a feed command.
+func NewCmdFeed() *cobra.Command {
+	cmd := cobra.Command{
+		Use:         "feed",
+		Short:       "Show activity on issues relevant to you",
+		Long:        helpText,
+		Example:     examples,
+		Aliases:     []string{"activity"},
+		Annotations: map[string]string{"cmd:main": "true"},
+		Args:        cobra.NoArgs,
+		RunE:        feedActivity,
+	}
+
+	cmd.Flags().String("since", "", "Show activity since a period or date, eg: 30m, 12h, 1d, 2w or yyyy-mm-dd\n"+
+		"Defaults to the time the feed was last read")
+	cmd.Flags().Uint("limit", defaultLimit, "Maximum number of recently updated issues to look into, 0 looks into all")
+	cmd.Flags().Bool("keep-unread", false, "Don't mark the activity as read")
+	cmd.Flags().String("output", "", "Output format: json (default: table)")
+
+	return &cmd
+}
+
+func feedActivity(cmd *cobra.Command, _ []string) error {
+	debug, _ := cmd.Flags().GetBool("debug")
+	sinceFlag, _ := cmd.Flags().GetString("since")
+	limit, _ := cmd.Flags().GetUint("limit")
+	keepUnread, _ := cmd.Flags().GetBool("keep-unread")
+	output, _ := cmd.Flags().GetString("output")
+
+	server := viper.GetString("server")
+	now := time.Now()
+
+	st, err := loadState()
+	if err != nil {
+		return err
+	}
+
+	since := now.Add(-feed.DefaultSince)
+	if sinceFlag != "" {
+		if since, err = feed.ParseSince(sinceFlag, now); err != nil {
+			return err
+		}
+	} else if t, ok := st.lastRead(server); ok {
+		since = t
+	}
+
+	client := api.DefaultClient(debug)
+	readUntil := now
+
+	var failed []string
+
+	events, err := func() ([]feed.Event, error) {
+		s := cmdutil.Info("Fetching activity...")
+		defer s.Stop()
+
+		me, err := client.Me()
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := api.ProxySearchAll(client, feed.JQL(since, now), limit)
+		if err != nil {
+			return nil, err
+		}
+		readUntil = feed.ReadUntil(resp.Issues, !resp.IsLast, now)
+
+		var events []feed.Event
+		events, failed, err = collect(client, resp.Issues, feed.User{AccountID: me.AccountID, Login: me.Login, Email: me.Email}, since)
+		return events, err
+	}()
+	if err != nil {
+		return err
+	}
+	if readUntil.Before(now) {
+		cmdutil.Warn(
+			"Only the %d most recently updated issues were looked into, activity before %s is kept unread. Use --limit to look into more",
+			limit, readUntil.Local().Format("2006-01-02 15:04"),
+		)
+	}
+
+	if len(events) == 0 && output != "json" {
+		fmt.Printf("No new activity since %s\n", since.Local().Format("2006-01-02 15:04"))
+	} else {
+		v := view.NewFeed(events, view.WithFeedTimezone(viper.GetString("timezone")), view.WithFeedJSON(output == "json"))
+		if err := v.Render(); err != nil {
+			return err
+		}
+	}
+
+	// Activity of issues that failed to load would be lost if the feed is marked read.
+	if len(failed) > 0 {
+		cmd.SilenceUsage = true
+		return fmt.Errorf("failed to fetch activity of %d issue(s), the feed is kept unread: %s", len(failed), strings.Join(failed, ", "))
+	}
+	if keepUnread {
+		return nil
+	}
+	st.markRead(server, readUntil)
+	return st.save()
+}
+
+// collect fetches changelog and comments of issues and builds their events.
+// Issues that fail to load are skipped and their keys are returned.
+func collect(client *jira.Client, issues []*jira.Issue, me feed.User, since time.Time) ([]feed.Event, []string, error) {
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		events []feed.Event
+		failed []string
+		sem    = make(chan struct{}, defaultParallel)
+		errs   []error
+	)
+
+	fail := func(key string, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		failed = append(failed, key)
+		errs = append(errs, err)
+	}
+
+	for _, iss := range issues {
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func(iss *jira.Issue) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			history, err := client.GetIssueHistory(iss.Key)
+			if err != nil {
+				fail(iss.Key, err)
+				return
+			}
+			comments, err := client.GetComments(iss.Key)
+			if err != nil {
+				fail(iss.Key, err)
+				return
+			}
+
+			evs := feed.Events(iss, history, comments, me, since)
+
+			mu.Lock()
+			events = append(events, evs...)
+			mu.Unlock()
+		}(iss)
+	}
+	wg.Wait()
+
+	if len(failed) > 0 && len(failed) == len(issues) {
+		return nil, nil, fmt.Errorf("failed to fetch activity: %w", errs[0])
+	}
+	sort.Strings(failed)
+
+	feed.Sort(events)
+	return events, failed, nil
+}
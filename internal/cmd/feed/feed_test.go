@@ -0,0 +1,42 @@
+package feed
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/internal/feed"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestCollectReportsFailedIssues(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/api/2/issue/ABC-2", "/rest/api/2/issue/ABC-3":
+			w.WriteHeader(http.StatusForbidden)
+		case "/rest/api/2/issue/ABC-1":
+			_, _ = w.Write([]byte(`{"changelog":{"histories":[]}}`))
+		case "/rest/api/2/issue/ABC-1/comment":
+			_, _ = w.Write([]byte(`{"comments":[{"id":"1","author":{"displayName":"Bob"},"body":"hi","created":"2024-05-02T10:00:00.000+0000"}]}`))
+		default:
+			t.Errorf("unexpected request: %s", r.URL)
+		}
+	}))
+	defer server.Close()
+
+	client := jira.NewClient(jira.Config{Server: server.URL}, jira.WithTimeout(3*time.Second))
+	since := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	issues := []*jira.Issue{{Key: "ABC-3"}, {Key: "ABC-1"}, {Key: "ABC-2"}}
+
+	events, failed, err := collect(client, issues, feed.User{AccountID: "me"}, since)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ABC-2", "ABC-3"}, failed)
+	assert.Len(t, events, 1)
+	assert.Equal(t, "ABC-1", events[0].Key)
+
+	_, _, err = collect(client, issues[2:], feed.User{AccountID: "me"}, since)
+	assert.Error(t, err)
+}
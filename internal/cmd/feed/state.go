@@ -0,0 +1,75 @@
+package feed
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+)
+
+const (
+	stateFile = "feed.json"
+
+	dirPerm  = 0o700
+	filePerm = 0o600
+)
+
+// state keeps track of when the feed was last read per jira server.
+type state struct {
+	path     string
+	LastRead map[string]time.Time `json:"lastRead"`
+}
+
+func loadState() (*state, error) {
+	path, err := jiraConfig.StatePath(stateFile)
+	if err != nil {
+		return nil, err
+	}
+
+	st := state{
+		path:     path,
+		LastRead: make(map[string]time.Time),
+	}
+
+	b, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return &st, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(b, &st); err != nil {
+		return nil, err
+	}
+	if st.LastRead == nil {
+		st.LastRead = make(map[string]time.Time)
+	}
+	return &st, nil
+}
+
+func (s *state) lastRead(server string) (time.Time, bool) {
+	t, ok := s.LastRead[server]
+	return t, ok && !t.IsZero()
+}
+
+// markRead moves the last read time of the server forward to t, it never moves it back.
+func (s *state) markRead(server string, t time.Time) {
+	if last, ok := s.lastRead(server); ok && !t.After(last) {
+		return
+	}
+	s.LastRead[server] = t.UTC()
+}
+
+func (s *state) save() error {
+	b, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(s.path), dirPerm); err != nil {
+		return err
+	}
+	return os.WriteFile(s.path, b, filePerm)
+}
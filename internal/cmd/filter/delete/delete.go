@@ -0,0 +1,76 @@
+package delete
+
+import (
+	"fmt"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const examples = `$ jira filter delete 10000
+
+# Delete without confirmation
+$ jira filter delete 10000 --no-input`
+
+// NewCmdDelete is a delete command.
+func NewCmdDelete() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "delete FILTER_ID",
+		Short:   "Delete deletes a saved filter",
+		Long:    "Delete deletes a saved filter. Only the owner of a filter can delete it.",
+		Example: examples,
+		Aliases: []string{"remove", "rm", "del"},
+		Annotations: map[string]string{
+			"help:args": "FILTER_ID\tId of the filter, eg: 10000",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  Delete,
+	}
+
+	cmd.Flags().Bool("no-input", false, "Don't ask for confirmation")
+
+	return &cmd
+}
+
+// Delete deletes a filter.
+func Delete(cmd *cobra.Command, args []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	noInput, err := cmd.Flags().GetBool("no-input")
+	cmdutil.ExitIfError(err)
+
+	client := api.DefaultClient(debug)
+
+	if !noInput {
+		f, err := func() (*jira.SavedFilter, error) {
+			s := cmdutil.Info("Fetching filter...")
+			defer s.Stop()
+
+			return client.GetFilter(args[0])
+		}()
+		cmdutil.ExitIfError(err)
+
+		var ok bool
+		msg := fmt.Sprintf("Delete filter %s %q owned by %s?", f.ID, f.Name, f.Owner.DisplayName)
+		cmdutil.ExitIfError(survey.AskOne(&survey.Confirm{Message: msg}, &ok))
+		if !ok {
+			cmdutil.Fail("Action aborted")
+			return
+		}
+	}
+
+	err = func() error {
+		s := cmdutil.Info("Deleting filter...")
+		defer s.Stop()
+
+		return client.DeleteFilter(args[0])
+	}()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success("Filter %s deleted successfully", args[0])
+}
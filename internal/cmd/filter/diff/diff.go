@@ -0,0 +1,105 @@
+package diff
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Diff compares results of two saved filters.
+
+It lists issues that are only in the results of the first filter and issues that
+are only in the results of the second filter. Use --common to also list issues
+that are in both.
+
+All results of both filters are fetched. If --limit is set and a filter matches more
+issues, the diff is refused as it would be incomplete.`
+
+	examples = `$ jira filter diff 10000 10001
+
+# Include issues matched by both filters
+$ jira filter diff 10000 10001 --common
+
+# Get issue keys of each set as JSON
+$ jira filter diff 10000 10001 --output json`
+)
+
+// NewCmdDiff is a diff command.
+func NewCmdDiff() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "diff FILTER_ID FILTER_ID",
+		Short:   "Diff compares results of two saved filters",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"compare"},
+		Annotations: map[string]string{
+			"help:args": "FILTER_ID\tId of the filters to compare, eg: 10000",
+		},
+		Args: cobra.ExactArgs(2),
+		Run:  Diff,
+	}
+
+	cmd.Flags().Uint("limit", 0, "Maximum number of issues to fetch per filter, 0 fetches all")
+	cmd.Flags().Bool("common", false, "Also list issues in the results of both filters")
+	cmd.Flags().Bool("plain", false, "Display output in plain mode")
+	cmd.Flags().String("output", "", "Output format: json (default: table)")
+
+	return &cmd
+}
+
+// Diff compares results of two filters.
+func Diff(cmd *cobra.Command, args []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	limit, _ := cmd.Flags().GetUint("limit")
+	common, _ := cmd.Flags().GetBool("common")
+	plain, _ := cmd.Flags().GetBool("plain")
+	output, _ := cmd.Flags().GetString("output")
+
+	if output != "" && output != "json" {
+		cmdutil.Failed("Invalid output format %q, valid format is: json", output)
+	}
+
+	client := api.DefaultClient(debug)
+
+	var (
+		filters [2]*jira.SavedFilter
+		issues  [2][]*jira.Issue
+	)
+	err = func() error {
+		s := cmdutil.Info("Comparing filters...")
+		defer s.Stop()
+
+		for i, id := range args {
+			f, err := client.GetFilter(id)
+			if err != nil {
+				return fmt.Errorf("unable to fetch filter %s: %w", id, err)
+			}
+			resp, err := api.ProxySearchAll(client, f.JQL, limit)
+			if err != nil {
+				return fmt.Errorf("unable to run filter %s: %w", id, err)
+			}
+			if !resp.IsLast {
+				return fmt.Errorf("filter %s matches more than %d issues, raise --limit to compare complete results", id, limit)
+			}
+			filters[i], issues[i] = f, resp.Issues
+		}
+		return nil
+	}()
+	cmdutil.ExitIfError(err)
+
+	v := view.NewFilterDiff(
+		filters[0], filters[1], issues[0], issues[1],
+		view.WithFilterDiffCommon(common),
+		view.WithFilterDiffPlain(plain),
+		view.WithFilterDiffJSON(output == "json"),
+	)
+	cmdutil.ExitIfError(v.Render())
+}
@@ -0,0 +1,121 @@
+package edit
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/surveyext"
+	"github.com/ankitpokhrel/jira-cli/pkg/tui"
+)
+
+const helpText = `Edit opens the JQL of a saved filter in your editor.
+
+The editor is picked from JIRA_EDITOR, VISUAL or EDITOR env vars. The JQL is validated
+against Jira before the filter is saved, and the editor is opened again if it is invalid.`
+
+// NewCmdEdit is an edit command.
+func NewCmdEdit() *cobra.Command {
+	return &cobra.Command{
+		Use:     "edit FILTER_ID",
+		Short:   "Edit edits the JQL of a saved filter in your editor",
+		Long:    helpText,
+		Example: "$ JIRA_EDITOR=vim jira filter edit 10000",
+		Annotations: map[string]string{
+			"help:args": "FILTER_ID\tId of the filter, eg: 10000",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  Edit,
+	}
+}
+
+// Edit edits the JQL of a filter.
+func Edit(cmd *cobra.Command, args []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	if tui.IsNotTTY() {
+		cmdutil.Failed("Edit needs an interactive terminal, use `jira filter update %s --jql` instead", args[0])
+	}
+
+	client := api.DefaultClient(debug)
+
+	filter, err := func() (*jira.SavedFilter, error) {
+		s := cmdutil.Info("Fetching filter...")
+		defer s.Stop()
+
+		return client.GetFilter(args[0])
+	}()
+	cmdutil.ExitIfError(err)
+
+	jql := filter.JQL
+	for {
+		jql, err = prompt(jql)
+		cmdutil.ExitIfError(err)
+
+		invalid, err := validate(client, jql)
+		cmdutil.ExitIfError(err)
+		if invalid == "" {
+			break
+		}
+		cmdutil.Fail("Invalid JQL: %s", invalid)
+	}
+
+	if jql == strings.TrimSpace(filter.JQL) {
+		fmt.Println("No changes to the filter")
+		return
+	}
+
+	filter, err = func() (*jira.SavedFilter, error) {
+		s := cmdutil.Info("Updating filter...")
+		defer s.Stop()
+
+		return client.UpdateFilter(filter.ID, &jira.UpdateFilterRequest{Name: filter.Name, JQL: jql})
+	}()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success("Filter updated successfully: %s (ID: %s)", filter.Name, filter.ID)
+}
+
+func prompt(jql string) (string, error) {
+	var ans string
+	err := survey.AskOne(&surveyext.JiraEditor{
+		Editor: &survey.Editor{
+			Message:       "Filter JQL",
+			Default:       jql,
+			HideDefault:   true,
+			AppendDefault: true,
+			FileName:      "filter*.jql",
+		},
+		BlankAllowed: false,
+	}, &ans)
+	return strings.TrimSpace(ans), err
+}
+
+// validate checks the JQL locally and against Jira. It returns why the JQL is
+// invalid, or an error if Jira couldn't be asked.
+func validate(client *jira.Client, jql string) (string, error) {
+	if jql == "" {
+		return "JQL can't be empty", nil
+	}
+	if err := cmdutil.ValidateJQL(jql); err != nil {
+		return err.Error(), nil
+	}
+
+	s := cmdutil.Info("Validating JQL...")
+	defer s.Stop()
+
+	parsed, err := api.ProxyParseJQL(client, jql)
+	if err != nil {
+		return "", fmt.Errorf("failed to validate JQL: %w", err)
+	}
+	if len(parsed) > 0 && !parsed[0].Valid() {
+		return strings.Join(parsed[0].Errors, " "), nil
+	}
+	return "", nil
+}
@@ -2,32 +2,67 @@ package execute
 
 import (
 	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 
 	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/list"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/internal/view"
-	"github.com/ankitpokhrel/jira-cli/pkg/tui"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
 )
 
-var limit uint
+const (
+	helpText = `Execute executes a saved filter and displays the matching issues.
+
+It accepts all flags supported by the issue list command. Filters given as flags
+are applied on top of the filter JQL. Results are ordered by --order-by rather
+than the ordering saved in the filter.`
+
+	examples = `$ jira filter execute 10000
+
+# Only show high priority issues from the filter in plain mode
+$ jira filter execute 10000 -yHigh --plain
+
+# Show selected columns of the first 20 results
+$ jira filter execute 10000 --paginate 20 --plain --columns key,status,summary`
+)
+
+// orderBy matches the ORDER BY clause at the end of a JQL.
+var orderBy = regexp.MustCompile(`(?is)\s*\border\s+by\b.*$`)
 
 // NewCmdExecute is an execute command.
 func NewCmdExecute() *cobra.Command {
-	cmd := &cobra.Command{
-		Use:     "execute <filter-id>",
+	cmd := cobra.Command{
+		Use:     "execute FILTER_ID",
 		Short:   "Execute executes a saved filter and shows matching issues",
-		Long:    "Execute executes a saved filter and displays the matching issues.",
+		Long:    helpText,
+		Example: examples,
 		Aliases: []string{"run", "search"},
-		Args:    cobra.ExactArgs(1),
-		Run:     Execute,
+		Annotations: map[string]string{
+			"help:args": "FILTER_ID\tId of the filter, eg: 10000",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  Execute,
 	}
 
-	cmd.Flags().UintVarP(&limit, "limit", "l", 50, "Maximum number of issues to return")
+	listCmd := list.NewCmdList()
+	list.SetFlags(listCmd)
+	cmd.Flags().AddFlagSet(listCmd.Flags())
+
+	// Column flags are only set by the list for commands with a parent.
+	cmd.Flags().String("columns", "", "Comma separated list of columns to display in the plain mode.\n"+
+		fmt.Sprintf("Accepts: %s", strings.Join(view.ValidIssueColumns(), ", ")))
+	cmd.Flags().Uint("fixed-columns", 1, "Number of fixed columns in the interactive mode")
 
-	return cmd
+	// The shorthand -l is taken by the label filter of the list.
+	cmd.Flags().Uint("limit", 0, "Maximum number of issues to return")
+	cmdutil.ExitIfError(cmd.Flags().MarkDeprecated("limit", "use --paginate instead"))
+
+	return &cmd
 }
 
 // Execute executes a filter and shows the results.
@@ -35,50 +70,35 @@ func Execute(cmd *cobra.Command, args []string) {
 	debug, err := cmd.Flags().GetBool("debug")
 	cmdutil.ExitIfError(err)
 
-	filterID := args[0]
-	limit, err := cmd.Flags().GetUint("limit")
-	cmdutil.ExitIfError(err)
-
-	server := viper.GetString("server")
-	project := viper.GetString("project.key")
+	if cmd.Flags().Changed("limit") {
+		limit, _ := cmd.Flags().GetUint("limit")
+		cmdutil.ExitIfError(cmd.Flags().Set("paginate", strconv.FormatUint(uint64(limit), 10)))
+	}
 
-	s := cmdutil.Info("Executing filter...")
-	defer s.Stop()
+	filter, err := func() (*jira.SavedFilter, error) {
+		s := cmdutil.Info("Fetching filter...")
+		defer s.Stop()
 
-	result, err := api.DefaultClient(debug).ExecuteFilter(filterID, limit)
+		return api.DefaultClient(debug).GetFilter(args[0])
+	}()
 	cmdutil.ExitIfError(err)
 
-	if len(result.Issues) == 0 {
-		cmdutil.Failed("No issues found matching the filter.")
-		return
-	}
+	jqlFlag, err := cmd.Flags().GetString("jql")
+	cmdutil.ExitIfError(err)
+	cmdutil.ExitIfError(cmd.Flags().Set("jql", filterJQL(filter.JQL, jqlFlag)))
 
-	// Use the existing issue list view to display results
-	display := view.DisplayFormat{
-		Plain:        false,
-		Delimiter:    "\t",
-		CSV:          false,
-		NoHeaders:    false,
-		NoTruncate:   false,
-		FixedColumns: 0,
-		Comments:     0,
-		TableStyle:   cmdutil.GetTUIStyleConfig(),
-		Timezone:     viper.GetString("timezone"),
-	}
+	cmdutil.ExitIfError(list.LoadList(cmd, nil))
+}
 
-	issueList := view.IssueList{
-		Project: project,
-		Server:  server,
-		Data:    result.Issues,
-		Display: display,
-		FooterText: fmt.Sprintf("Showing %d results from filter %s", len(result.Issues), filterID),
+// filterJQL builds a raw query for the issue list from the filter JQL. The
+// project clause stops the list from limiting results to the default project.
+func filterJQL(jql, extra string) string {
+	q := "project IS NOT EMPTY"
+	if jql = orderBy.ReplaceAllString(jql, ""); jql != "" {
+		q = fmt.Sprintf("%s AND (%s)", q, jql)
 	}
-
-	if tui.IsDumbTerminal() || tui.IsNotTTY() {
-		display.Plain = true
-		issueList.Display = display
+	if extra != "" {
+		q = fmt.Sprintf("%s AND (%s)", q, extra)
 	}
-
-	cmdutil.ExitIfError(issueList.Render())
+	return q
 }
-
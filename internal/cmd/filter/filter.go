@@ -4,9 +4,14 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/filter/create"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/filter/delete"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/filter/diff"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/filter/edit"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/filter/execute"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/filter/favorite"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/filter/list"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/filter/share"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/filter/update"
 )
 
 const helpText = `Filter manages saved Jira filters. See available commands below.`
@@ -27,7 +32,14 @@ func NewCmdFilter() *cobra.Command {
 	ec := execute.NewCmdExecute()
 	fc := favorite.NewCmdFavorite()
 
-	cmd.AddCommand(lc, cc, ec, fc)
+	cmd.AddCommand(
+		lc, cc, ec, fc,
+		update.NewCmdUpdate(),
+		delete.NewCmdDelete(),
+		share.NewCmdShare(),
+		edit.NewCmdEdit(),
+		diff.NewCmdDiff(),
+	)
 
 	list.SetFlags(lc)
 
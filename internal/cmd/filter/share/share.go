@@ -0,0 +1,177 @@
+package share
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Share shares a saved filter with groups, projects or users.
+
+Without any flags it lists who the filter is currently shared with. Use --remove
+with the permission id from the list to stop sharing the filter.`
+
+	examples = `$ jira filter share 10000
+
+# Share a filter with a group and a project
+$ jira filter share 10000 --group jira-developers --project ABC
+
+# Share a filter with a user
+$ jira filter share 10000 --user "Jane Doe"
+
+# Stop sharing a filter
+$ jira filter share 10000 --remove 10010`
+
+	maxUserResults = 100
+)
+
+// NewCmdShare is a share command.
+func NewCmdShare() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "share FILTER_ID",
+		Short:   "Share shares a saved filter with groups, projects or users",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"permissions"},
+		Annotations: map[string]string{
+			"help:args": "FILTER_ID\tId of the filter, eg: 10000",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  Share,
+	}
+
+	cmd.Flags().StringArray("group", []string{}, "Share the filter with a group")
+	cmd.Flags().StringArray("project", []string{}, "Share the filter with a project, eg: ABC")
+	cmd.Flags().StringArray("user", []string{}, "Share the filter with a user (email or display name)")
+	cmd.Flags().IntSlice("remove", []int{}, "Remove share permissions with given ids")
+
+	return &cmd
+}
+
+// Share shares a filter or lists its share permissions.
+func Share(cmd *cobra.Command, args []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	groups, _ := cmd.Flags().GetStringArray("group")
+	projects, _ := cmd.Flags().GetStringArray("project")
+	users, _ := cmd.Flags().GetStringArray("user")
+	remove, _ := cmd.Flags().GetIntSlice("remove")
+
+	filterID := args[0]
+	client := api.DefaultClient(debug)
+
+	if len(groups)+len(projects)+len(users)+len(remove) == 0 {
+		filter, err := func() (*jira.SavedFilter, error) {
+			s := cmdutil.Info("Fetching share permissions...")
+			defer s.Stop()
+
+			return client.GetFilter(filterID)
+		}()
+		cmdutil.ExitIfError(err)
+
+		if len(filter.SharePermissions) == 0 {
+			fmt.Printf("Filter %s (%s) is not shared\n", filter.ID, filter.Name)
+			return
+		}
+		cmdutil.ExitIfError(view.NewFilterPermission(filter.SharePermissions).Render())
+		return
+	}
+
+	err = func() error {
+		s := cmdutil.Info("Updating share permissions...")
+		defer s.Stop()
+
+		reqs, err := shareRequests(client, groups, projects, users)
+		if err != nil {
+			return err
+		}
+		for _, req := range reqs {
+			if _, err := client.AddFilterPermission(filterID, req); err != nil {
+				return err
+			}
+		}
+		for _, id := range remove {
+			if err := client.DeleteFilterPermission(filterID, id); err != nil {
+				return err
+			}
+		}
+		return nil
+	}()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success("Share permissions of filter %s updated successfully", filterID)
+}
+
+// shareRequests resolves projects and users and builds requests to share a filter with them.
+func shareRequests(client *jira.Client, groups, projects, users []string) ([]*jira.FilterShareRequest, error) {
+	reqs := make([]*jira.FilterShareRequest, 0, len(groups)+len(projects)+len(users))
+
+	for _, g := range groups {
+		reqs = append(reqs, &jira.FilterShareRequest{Type: jira.FilterShareGroup, GroupName: g})
+	}
+
+	if len(projects) > 0 {
+		all, err := client.Project()
+		if err != nil {
+			return nil, err
+		}
+		for _, key := range projects {
+			id := ""
+			for _, p := range all {
+				if strings.EqualFold(p.Key, key) {
+					id = p.ID
+					break
+				}
+			}
+			if id == "" {
+				return nil, fmt.Errorf("project %q not found", key)
+			}
+			reqs = append(reqs, &jira.FilterShareRequest{Type: jira.FilterShareProject, ProjectID: id})
+		}
+	}
+
+	for _, name := range users {
+		u, err := findUser(client, name)
+		if err != nil {
+			return nil, err
+		}
+		req := jira.FilterShareRequest{Type: jira.FilterShareUser}
+		if viper.GetString("installation") == jira.InstallationTypeLocal {
+			req.UserKey = u.Name
+		} else {
+			req.AccountID = u.AccountID
+		}
+		reqs = append(reqs, &req)
+	}
+
+	return reqs, nil
+}
+
+func findUser(client *jira.Client, query string) (*jira.User, error) {
+	users, err := api.ProxyUserSearch(client, &jira.UserSearchOptions{
+		Query:      query,
+		Project:    viper.GetString("project.key"),
+		MaxResults: maxUserResults,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to search for user: %w", err)
+	}
+	if len(users) == 0 {
+		return nil, fmt.Errorf("user %q not found", query)
+	}
+	for _, u := range users {
+		if strings.EqualFold(u.Name, query) || strings.EqualFold(u.DisplayName, query) || strings.EqualFold(u.Email, query) {
+			return u, nil
+		}
+	}
+	return users[0], nil
+}
@@ -0,0 +1,86 @@
+package update
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const examples = `$ jira filter update 10000 --name "My open bugs"
+
+# Update the JQL of a filter
+$ jira filter update 10000 --jql "type = Bug AND status != Done"
+
+# Remove a filter from favorites
+$ jira filter update 10000 --favorite=false`
+
+// NewCmdUpdate is an update command.
+func NewCmdUpdate() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "update FILTER_ID",
+		Short:   "Update updates a saved filter",
+		Long:    "Update updates name, description, JQL or favorite status of a saved filter.",
+		Example: examples,
+		Aliases: []string{"modify"},
+		Annotations: map[string]string{
+			"help:args": "FILTER_ID\tId of the filter, eg: 10000",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  Update,
+	}
+
+	cmd.Flags().StringP("name", "n", "", "New filter name")
+	cmd.Flags().StringP("description", "d", "", "New filter description")
+	cmd.Flags().StringP("jql", "j", "", "New JQL query")
+	cmd.Flags().BoolP("favorite", "f", false, "Mark or unmark the filter as favorite")
+
+	return &cmd
+}
+
+// Update updates a filter.
+func Update(cmd *cobra.Command, args []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	name, _ := cmd.Flags().GetString("name")
+	description, _ := cmd.Flags().GetString("description")
+	jql, _ := cmd.Flags().GetString("jql")
+
+	if !cmd.Flags().Changed("name") && !cmd.Flags().Changed("description") &&
+		!cmd.Flags().Changed("jql") && !cmd.Flags().Changed("favorite") {
+		cmdutil.Failed("Nothing to update, use --name, --description, --jql or --favorite")
+	}
+	cmdutil.ExitIfError(cmdutil.ValidateJQL(jql))
+
+	client := api.DefaultClient(debug)
+
+	filter, err := func() (*jira.SavedFilter, error) {
+		s := cmdutil.Info("Updating filter...")
+		defer s.Stop()
+
+		// The filter name is required by the api even if it doesn't change.
+		current, err := client.GetFilter(args[0])
+		if err != nil {
+			return nil, err
+		}
+
+		req := jira.UpdateFilterRequest{
+			Name:        current.Name,
+			Description: description,
+			JQL:         jql,
+		}
+		if name != "" {
+			req.Name = name
+		}
+		if cmd.Flags().Changed("favorite") {
+			fav, _ := cmd.Flags().GetBool("favorite")
+			req.Favourite = &fav
+		}
+		return client.UpdateFilter(args[0], &req)
+	}()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success("Filter updated successfully: %s (ID: %s)", filter.Name, filter.ID)
+}
@@ -0,0 +1,82 @@
+package branch
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/quick/start"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/git"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira/filter/issue"
+)
+
+const (
+	helpText = `Branch creates and checks out a git branch for an issue.
+
+The branch name is generated from the pattern defined in the "git.branch_pattern" config
+and defaults to "{key}-{summary}". Supported placeholders are {key}, {summary} and {type},
+eg: "{type}/{key}-{summary}". The branch is checked out if it already exists.`
+	examples = `$ jira git branch ISSUE-1
+
+# Create the branch from main and start working on the issue
+$ jira git branch ISSUE-1 --base main --start`
+)
+
+// NewCmdBranch is a branch command.
+func NewCmdBranch() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "branch ISSUE-KEY",
+		Short:   "Branch creates and checks out a git branch for an issue",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"checkout", "co"},
+		Annotations: map[string]string{
+			"help:args": "ISSUE-KEY\tIssue key, eg: ISSUE-1",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  Branch,
+	}
+
+	cmd.Flags().String("base", "", "Base branch to create the branch from (defaults to HEAD)")
+	cmd.Flags().Bool("start", false, "Assign the issue to yourself and move it to In Progress")
+
+	return &cmd
+}
+
+// Branch creates a branch for an issue.
+func Branch(cmd *cobra.Command, args []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	base, err := cmd.Flags().GetString("base")
+	cmdutil.ExitIfError(err)
+
+	startIssue, err := cmd.Flags().GetBool("start")
+	cmdutil.ExitIfError(err)
+
+	key := cmdutil.GetJiraIssueKey(viper.GetString("project.key"), args[0])
+
+	iss, err := func() (*jira.Issue, error) {
+		s := cmdutil.Info("Fetching issue details...")
+		defer s.Stop()
+
+		return api.ProxyGetIssue(api.DefaultClient(debug), key, issue.NewNumCommentsFilter(0))
+	}()
+	cmdutil.ExitIfError(err)
+
+	name := git.FormatBranch(viper.GetString("git.branch_pattern"), iss.Key, iss.Fields.Summary, iss.Fields.IssueType.Name)
+
+	if git.BranchExists(name) {
+		cmdutil.ExitIfError(git.Checkout(name))
+		cmdutil.Success("Switched to existing branch %q", name)
+	} else {
+		cmdutil.ExitIfError(git.CreateBranch(name, base))
+		cmdutil.Success("Switched to a new branch %q", name)
+	}
+
+	if startIssue {
+		start.Start(cmd, []string{iss.Key})
+	}
+}
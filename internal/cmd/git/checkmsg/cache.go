@@ -0,0 +1,80 @@
+package checkmsg
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+)
+
+const (
+	cacheFile = "git-hook-cache.json"
+
+	cacheDirPerm  = 0o700
+	cacheFilePerm = 0o600
+)
+
+type cachedIssue struct {
+	Status    string    `json:"status"`
+	CheckedAt time.Time `json:"checkedAt"`
+}
+
+// cache keeps track of issues verified against a jira server.
+type cache struct {
+	path   string
+	Issues map[string]map[string]cachedIssue `json:"issues"`
+}
+
+func loadCache() (*cache, error) {
+	path, err := jiraConfig.StatePath(cacheFile)
+	if err != nil {
+		return nil, err
+	}
+
+	c := cache{
+		path:   path,
+		Issues: make(map[string]map[string]cachedIssue),
+	}
+
+	b, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return &c, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	// A corrupt cache is not fatal, the issues are simply verified again.
+	if err := json.Unmarshal(b, &c); err != nil || c.Issues == nil {
+		c.Issues = make(map[string]map[string]cachedIssue)
+	}
+	return &c, nil
+}
+
+func (c *cache) get(server, key string, ttl time.Duration) (string, bool) {
+	iss, ok := c.Issues[server][key]
+	if !ok || time.Since(iss.CheckedAt) > ttl {
+		return "", false
+	}
+	return iss.Status, true
+}
+
+func (c *cache) set(server, key, status string) {
+	if _, ok := c.Issues[server]; !ok {
+		c.Issues[server] = make(map[string]cachedIssue)
+	}
+	c.Issues[server][key] = cachedIssue{Status: status, CheckedAt: time.Now().UTC()}
+}
+
+func (c *cache) save() error {
+	b, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), cacheDirPerm); err != nil {
+		return err
+	}
+	return os.WriteFile(c.path, b, cacheFilePerm)
+}
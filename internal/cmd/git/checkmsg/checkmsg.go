@@ -0,0 +1,251 @@
+package checkmsg
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/git"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Check-msg verifies that a commit message references an existing Jira issue.
+
+It is meant to be run from a git commit-msg hook, see "jira git install-hook".
+Merge, revert, fixup and squash commits are ignored.
+
+Only keys of the allowed projects, or of the configured project if allowed projects
+are not set, are considered. Allowed projects and statuses can be restricted in the
+config file:
+  git:
+    hook:
+      projects: [PROJ, OPS]
+      statuses: [To Do, In Progress]
+      auto_prefix: true
+      cache_ttl: 1h
+
+Issues found on the server are cached in the jira-cli config directory so that
+subsequent commits don't hit the server every time.`
+	examples = `$ jira git check-msg .git/COMMIT_EDITMSG
+
+# Prefix issue key from the branch name if the message doesn't have one
+$ jira git check-msg --prefix-from-branch .git/COMMIT_EDITMSG`
+
+	defaultCacheTTL = time.Hour
+	filePerm        = 0o644
+)
+
+// NewCmdCheckMsg is a check-msg command.
+func NewCmdCheckMsg() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "check-msg MESSAGE_FILE",
+		Short:   "Check-msg verifies issue keys in a commit message",
+		Long:    helpText,
+		Example: examples,
+		Annotations: map[string]string{
+			"help:args": "MESSAGE_FILE\tPath to the file that contains the commit message",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  CheckMsg,
+	}
+
+	cmd.Flags().Bool("prefix-from-branch", false, "Prefix issue key from the branch name if the message doesn't have one")
+	cmd.Flags().Duration("cache-ttl", 0, "How long issues found on the server are cached (default 1h)")
+	cmd.Flags().Bool("no-cache", false, "Always verify issues against the server")
+
+	return &cmd
+}
+
+// CheckMsg checks the commit message.
+func CheckMsg(cmd *cobra.Command, args []string) {
+	params := parseFlags(cmd.Flags())
+
+	raw, err := os.ReadFile(args[0])
+	cmdutil.ExitIfError(err)
+
+	msg := git.CleanMessage(string(raw))
+	if msg == "" || git.IsGeneratedMessage(msg) {
+		return
+	}
+
+	projects := viper.GetStringSlice("git.hook.projects")
+	if len(projects) == 0 && viper.GetString("project.key") != "" {
+		projects = []string{viper.GetString("project.key")}
+	}
+
+	keys := issueKeys(msg, projects)
+	if len(keys) == 0 && params.prefix {
+		keys = prefixFromBranch(args[0], string(raw))
+	}
+	if len(keys) == 0 {
+		if len(projects) > 0 {
+			cmdutil.Failed("Commit message doesn't reference any issue of %s, eg: \"%s-123 fix login crash\"", strings.Join(projects, ", "), projects[0])
+		}
+		cmdutil.Failed("Commit message doesn't reference any Jira issue, eg: \"PROJ-123 fix login crash\"")
+	}
+
+	cc := checkCmd{
+		debug:    params.debug,
+		server:   viper.GetString("server"),
+		projects: projects,
+		statuses: viper.GetStringSlice("git.hook.statuses"),
+		ttl:      params.ttl,
+	}
+	if !params.noCache {
+		cc.cache, err = loadCache()
+		cmdutil.ExitIfError(err)
+	}
+
+	for _, k := range keys {
+		if err := cc.check(k); err != nil {
+			cmdutil.Failed("Commit message check failed: %s", err)
+		}
+	}
+
+	if cc.cache != nil {
+		if err := cc.cache.save(); err != nil {
+			cmdutil.Warn("Unable to save issue cache: %s", err)
+		}
+	}
+}
+
+type checkCmd struct {
+	client   *jira.Client
+	debug    bool
+	server   string
+	projects []string
+	statuses []string
+	ttl      time.Duration
+	cache    *cache
+}
+
+func (cc *checkCmd) check(key string) error {
+	if err := cmdutil.ValidateIssueKey(key); err != nil {
+		return err
+	}
+
+	project := key[:strings.LastIndex(key, "-")]
+	if len(cc.projects) > 0 && !containsFold(cc.projects, project) {
+		return fmt.Errorf("issue %s doesn't belong to allowed projects: %s", key, strings.Join(cc.projects, ", "))
+	}
+
+	status, err := cc.status(key)
+	if err != nil {
+		return fmt.Errorf("unable to find issue %s: %w", key, err)
+	}
+	if len(cc.statuses) > 0 && !containsFold(cc.statuses, status) {
+		return fmt.Errorf("issue %s is in %q, allowed statuses are: %s", key, status, strings.Join(cc.statuses, ", "))
+	}
+	return nil
+}
+
+func (cc *checkCmd) status(key string) (string, error) {
+	if cc.cache != nil {
+		if status, ok := cc.cache.get(cc.server, key, cc.ttl); ok {
+			return status, nil
+		}
+	}
+
+	if cc.client == nil {
+		cc.client = api.DefaultClient(cc.debug)
+	}
+	iss, err := api.ProxyGetIssue(cc.client, key)
+	if err != nil {
+		return "", err
+	}
+
+	status := iss.Fields.Status.Name
+	if cc.cache != nil {
+		cc.cache.set(cc.server, key, status)
+	}
+	return status, nil
+}
+
+// issueKeys extracts keys of the given projects referenced in a commit
+// message. Keys of any project are extracted if projects are empty.
+func issueKeys(msg string, projects []string) []string {
+	if len(projects) == 0 {
+		return git.IssueKeys(msg)
+	}
+
+	var keys []string
+	for _, p := range projects {
+		keys = append(keys, git.ProjectIssueKeys(msg, strings.TrimSpace(p))...)
+	}
+	return keys
+}
+
+// prefixFromBranch prefixes the commit message with the issue key
+// from the current branch and returns the key.
+func prefixFromBranch(file, msg string) []string {
+	branch, err := git.CurrentBranch()
+	if err != nil {
+		return nil
+	}
+	key := git.IssueKeyFromBranch(branch, viper.GetString("project.key"))
+	if key == "" {
+		return nil
+	}
+	cmdutil.ExitIfError(os.WriteFile(file, []byte(git.PrefixSubject(msg, key)), filePerm))
+
+	return []string{key}
+}
+
+func containsFold(list []string, s string) bool {
+	for _, v := range list {
+		if strings.EqualFold(strings.TrimSpace(v), s) {
+			return true
+		}
+	}
+	return false
+}
+
+type flagParser interface {
+	GetBool(string) (bool, error)
+	GetDuration(string) (time.Duration, error)
+}
+
+type checkParams struct {
+	prefix  bool
+	noCache bool
+	ttl     time.Duration
+	debug   bool
+}
+
+func parseFlags(flags flagParser) *checkParams {
+	prefix, err := flags.GetBool("prefix-from-branch")
+	cmdutil.ExitIfError(err)
+
+	noCache, err := flags.GetBool("no-cache")
+	cmdutil.ExitIfError(err)
+
+	ttl, err := flags.GetDuration("cache-ttl")
+	cmdutil.ExitIfError(err)
+
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	if !prefix {
+		prefix = viper.GetBool("git.hook.auto_prefix")
+	}
+	if ttl == 0 {
+		ttl = viper.GetDuration("git.hook.cache_ttl")
+	}
+	if ttl == 0 {
+		ttl = defaultCacheTTL
+	}
+
+	return &checkParams{
+		prefix:  prefix,
+		noCache: noCache,
+		ttl:     ttl,
+		debug:   debug,
+	}
+}
@@ -0,0 +1,76 @@
+package current
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/git"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira/filter/issue"
+)
+
+const (
+	helpText = `Current detects the issue key from the current git branch name.
+
+Keys of the configured project are preferred if the branch name references multiple keys.`
+	examples = `$ jira git current
+
+# Show summary and status of the issue
+$ jira git current --details
+
+# Use it in other commands
+$ jira issue assign $(jira git current) $(jira me)`
+)
+
+// NewCmdCurrent is a current command.
+func NewCmdCurrent() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "current",
+		Short:   "Current displays the issue key of the current git branch",
+		Long:    helpText,
+		Example: examples,
+		Args:    cobra.NoArgs,
+		Run:     Current,
+	}
+
+	cmd.Flags().Bool("details", false, "Fetch and display issue summary and status")
+
+	return &cmd
+}
+
+// Current displays the issue key of the current branch.
+func Current(cmd *cobra.Command, _ []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	details, err := cmd.Flags().GetBool("details")
+	cmdutil.ExitIfError(err)
+
+	branch, err := git.CurrentBranch()
+	cmdutil.ExitIfError(err)
+
+	key := git.IssueKeyFromBranch(branch, viper.GetString("project.key"))
+	if key == "" {
+		cmdutil.Failed("Unable to detect issue key from branch %q", branch)
+	}
+
+	if !details {
+		fmt.Println(key)
+		return
+	}
+
+	iss, err := func() (*jira.Issue, error) {
+		s := cmdutil.Info("Fetching issue details...")
+		defer s.Stop()
+
+		return api.ProxyGetIssue(api.DefaultClient(debug), key, issue.NewNumCommentsFilter(0))
+	}()
+	cmdutil.ExitIfError(err)
+
+	fmt.Printf("%s\t%s\t%s\n", iss.Key, iss.Fields.Status.Name, iss.Fields.Summary)
+	fmt.Println(cmdutil.GenerateServerBrowseURL(viper.GetString("server"), iss.Key))
+}
@@ -0,0 +1,38 @@
+package git
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/git/branch"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/git/checkmsg"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/git/current"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/git/installhook"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/git/sync"
+)
+
+const helpText = `Git connects your local git workflow with Jira issues. See available commands below.`
+
+// NewCmdGit is a git command.
+func NewCmdGit() *cobra.Command {
+	cmd := cobra.Command{
+		Use:         "git",
+		Short:       "Git connects your local git workflow with Jira issues",
+		Long:        helpText,
+		Annotations: map[string]string{"cmd:main": "true"},
+		RunE:        git,
+	}
+
+	cmd.AddCommand(
+		branch.NewCmdBranch(),
+		current.NewCmdCurrent(),
+		sync.NewCmdSync(),
+		installhook.NewCmdInstallHook(),
+		checkmsg.NewCmdCheckMsg(),
+	)
+
+	return &cmd
+}
+
+func git(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
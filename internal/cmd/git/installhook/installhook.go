@@ -0,0 +1,76 @@
+package installhook
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/git"
+)
+
+const (
+	helpText = `Install-hook installs a git commit-msg hook that validates issue keys in commit messages.
+
+The hook runs "jira git check-msg" for every commit. See "jira git check-msg --help"
+to learn how to restrict allowed projects and statuses.`
+	examples = `$ jira git install-hook
+
+# Prefix issue key from the branch name if the message doesn't reference any issue
+$ jira git install-hook --auto-prefix
+
+# Overwrite an existing commit-msg hook
+$ jira git install-hook --force`
+
+	hookName = "commit-msg"
+
+	// hookMarker identifies hooks installed by jira-cli so that they can be safely overwritten.
+	hookMarker = "# Installed by jira-cli."
+)
+
+// NewCmdInstallHook is an install-hook command.
+func NewCmdInstallHook() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "install-hook",
+		Short:   "Install a commit-msg hook that validates issue keys",
+		Long:    helpText,
+		Example: examples,
+		Args:    cobra.NoArgs,
+		Run:     InstallHook,
+	}
+
+	cmd.Flags().Bool("auto-prefix", false, "Prefix issue key from the branch name if the message doesn't have one")
+	cmd.Flags().Bool("force", false, "Overwrite existing commit-msg hook")
+
+	return &cmd
+}
+
+// InstallHook installs commit-msg hook.
+func InstallHook(cmd *cobra.Command, _ []string) {
+	autoPrefix, err := cmd.Flags().GetBool("auto-prefix")
+	cmdutil.ExitIfError(err)
+
+	force, err := cmd.Flags().GetBool("force")
+	cmdutil.ExitIfError(err)
+
+	bin, err := os.Executable()
+	cmdutil.ExitIfError(err)
+
+	path, err := git.InstallHook(hookName, script(bin, autoPrefix), hookMarker, force)
+	if errors.Is(err, git.ErrHookExists) {
+		cmdutil.Failed("A commit-msg hook already exists at %s\nUse --force to overwrite it", path)
+	}
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success("Installed commit-msg hook at %s", path)
+}
+
+func script(bin string, autoPrefix bool) string {
+	args := ""
+	if autoPrefix {
+		args = " --prefix-from-branch"
+	}
+	return fmt.Sprintf("#!/bin/sh\n%s\nexec %q git check-msg%s \"$1\"\n", hookMarker, bin, args)
+}
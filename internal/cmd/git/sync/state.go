@@ -0,0 +1,74 @@
+package sync
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+)
+
+const (
+	stateFile = "git-sync.json"
+
+	dirPerm  = 0o700
+	filePerm = 0o600
+)
+
+// state keeps track of commits that are already synced per jira server.
+type state struct {
+	path      string
+	Processed map[string]map[string]time.Time `json:"processed"`
+}
+
+func loadState() (*state, error) {
+	path, err := jiraConfig.StatePath(stateFile)
+	if err != nil {
+		return nil, err
+	}
+
+	st := state{
+		path:      path,
+		Processed: make(map[string]map[string]time.Time),
+	}
+
+	b, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return &st, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(b, &st); err != nil {
+		return nil, err
+	}
+	if st.Processed == nil {
+		st.Processed = make(map[string]map[string]time.Time)
+	}
+	return &st, nil
+}
+
+func (s *state) isProcessed(server, sha string) bool {
+	_, ok := s.Processed[server][sha]
+	return ok
+}
+
+func (s *state) markProcessed(server, sha string) {
+	if _, ok := s.Processed[server]; !ok {
+		s.Processed[server] = make(map[string]time.Time)
+	}
+	s.Processed[server][sha] = time.Now().UTC()
+}
+
+func (s *state) save() error {
+	b, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(s.path), dirPerm); err != nil {
+		return err
+	}
+	return os.WriteFile(s.path, b, filePerm)
+}
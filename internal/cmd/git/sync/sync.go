@@ -0,0 +1,224 @@
+package sync
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/git"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Sync applies smart commit directives found in git commit messages to Jira issues.
+
+Supported directives are:
+  #comment <text>              Add a comment to the issue
+  #time <1w 2d 4h 30m> [text]  Log work with an optional worklog comment
+  #<transition> [text]         Transition the issue, use dashes for spaces, eg: #in-progress
+
+Directives apply to the issue keys referenced on the same line, or on the subject line
+if the line doesn't reference any issue, eg: "TEST-1 fix crash #time 2h #done".
+
+Synced commits are recorded in the jira-cli config directory so that running
+the command again doesn't apply the same directives twice. Commits with failed
+directives are not recorded and are synced again on the next run.`
+	examples = `$ jira git sync
+
+# Sync commits in a given range
+$ jira git sync --range origin/main..HEAD
+
+# Preview directives without applying them
+$ jira git sync --dry-run`
+)
+
+// NewCmdSync is a sync command.
+func NewCmdSync() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "sync",
+		Short:   "Sync applies smart commit directives from git log to Jira",
+		Long:    helpText,
+		Example: examples,
+		Args:    cobra.NoArgs,
+		Run:     Sync,
+	}
+
+	cmd.Flags().String("range", "@{upstream}..HEAD", "Git revision range to look for commits in")
+	cmd.Flags().Bool("dry-run", false, "Print directives without applying them")
+	cmd.Flags().Bool("force", false, "Process commits even if they were already synced")
+
+	return &cmd
+}
+
+// Sync applies smart commits to jira.
+func Sync(cmd *cobra.Command, _ []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	rng, err := cmd.Flags().GetString("range")
+	cmdutil.ExitIfError(err)
+
+	dryRun, err := cmd.Flags().GetBool("dry-run")
+	cmdutil.ExitIfError(err)
+
+	force, err := cmd.Flags().GetBool("force")
+	cmdutil.ExitIfError(err)
+
+	commits, err := git.Log(rng)
+	cmdutil.ExitIfError(err)
+
+	st, err := loadState()
+	cmdutil.ExitIfError(err)
+
+	server := viper.GetString("server")
+	sc := syncCmd{
+		client: api.DefaultClient(debug),
+		dryRun: dryRun,
+	}
+
+	var synced, failed int
+	for _, c := range commits {
+		if !force && st.isProcessed(server, c.SHA) {
+			continue
+		}
+		directives := git.ParseSmartCommits(c.Message)
+		if len(directives) == 0 {
+			continue
+		}
+
+		fmt.Printf("%s %s\n", shortSHA(c.SHA), subject(c.Message))
+
+		errs := sc.apply(c, directives)
+		failed += len(errs)
+		synced++
+
+		// Commits with failed directives are retried on the next run. State is
+		// saved right away so that an interrupted sync doesn't apply them twice.
+		if !dryRun && len(errs) == 0 {
+			st.markProcessed(server, c.SHA)
+			cmdutil.ExitIfError(st.save())
+		}
+	}
+
+	if dryRun {
+		cmdutil.Success("Found %d commits with smart commit directives", synced)
+		return
+	}
+
+	if failed > 0 {
+		cmdutil.Failed("Synced %d commits, %d directives failed", synced, failed)
+	}
+	cmdutil.Success("Synced %d commits", synced)
+}
+
+type syncCmd struct {
+	client *jira.Client
+	dryRun bool
+}
+
+func (sc *syncCmd) apply(c git.Commit, directives []git.SmartCommit) []error {
+	var errs []error
+
+	for _, d := range directives {
+		for _, key := range d.Keys {
+			desc := describe(key, d)
+			if sc.dryRun {
+				fmt.Printf("  - %s\n", desc)
+				continue
+			}
+
+			if err := sc.applyOne(c, key, d); err != nil {
+				errs = append(errs, err)
+				cmdutil.Warn("  ✗ %s: %s", desc, err)
+				continue
+			}
+			fmt.Printf("  ✓ %s\n", desc)
+		}
+	}
+	return errs
+}
+
+func (sc *syncCmd) applyOne(c git.Commit, key string, d git.SmartCommit) error {
+	switch d.Command {
+	case git.SmartCommandComment:
+		return sc.client.AddIssueComment(key, d.Comment, false)
+	case git.SmartCommandTime:
+		if d.TimeSpent == "" {
+			return fmt.Errorf("time spent is missing")
+		}
+		comment := d.Comment
+		if comment == "" {
+			comment = subject(c.Message)
+		}
+		return sc.client.AddIssueWorklog(key, c.Date.Format(jira.RFC3339MilliLayout), d.TimeSpent, comment, "")
+	case git.SmartCommandTransition:
+		return sc.transition(key, d)
+	}
+	return fmt.Errorf("unknown command %q", d.Command)
+}
+
+func (sc *syncCmd) transition(key string, d git.SmartCommit) error {
+	transitions, err := api.ProxyTransitions(sc.client, key)
+	if err != nil {
+		return err
+	}
+
+	var tr *jira.Transition
+	for _, t := range transitions {
+		if strings.EqualFold(t.Name, d.Transition) {
+			tr = t
+			break
+		}
+	}
+	if tr == nil {
+		return fmt.Errorf("transition %q is not available", d.Transition)
+	}
+
+	req := jira.TransitionRequest{
+		Transition: &jira.TransitionRequestData{ID: tr.ID.String(), Name: tr.Name},
+	}
+	if d.Comment != "" {
+		req.Update = &jira.TransitionRequestUpdate{
+			Comment: []struct {
+				Add struct {
+					Body string `json:"body"`
+				} `json:"add"`
+			}{
+				{Add: struct {
+					Body string `json:"body"`
+				}{Body: d.Comment}},
+			},
+		}
+	}
+
+	_, err = sc.client.Transition(key, &req)
+	return err
+}
+
+func describe(key string, d git.SmartCommit) string {
+	switch d.Command {
+	case git.SmartCommandComment:
+		return fmt.Sprintf("%s: comment %q", key, d.Comment)
+	case git.SmartCommandTime:
+		return fmt.Sprintf("%s: log %s", key, d.TimeSpent)
+	default:
+		return fmt.Sprintf("%s: transition to %q", key, d.Transition)
+	}
+}
+
+func subject(msg string) string {
+	s, _, _ := strings.Cut(msg, "\n")
+	return s
+}
+
+func shortSHA(sha string) string {
+	const size = 7
+	if len(sha) > size {
+		return sha[:size]
+	}
+	return sha
+}
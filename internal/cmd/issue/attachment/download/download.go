@@ -1,36 +1,58 @@
 package download
 
 import (
+	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
 	"github.com/ankitpokhrel/jira-cli/api"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
 )
 
 const (
-	helpText = `Download downloads an attachment from an issue.`
+	helpText = `Download downloads attachments of an issue.
+
+Interrupted downloads are resumed on the next run and the size of every file is
+verified. Jira doesn't provide checksums of attachments, so the content itself is
+not verified. An existing file with the expected size is skipped and reported.`
 	examples = `# Download attachment
 $ jira issue attachment download PROJ-123 ATTACHMENT-ID
 
 # Download to specific file
-$ jira issue attachment download PROJ-123 ATTACHMENT-ID -o downloaded.pdf`
+$ jira issue attachment download PROJ-123 ATTACHMENT-ID -o downloaded.pdf
+
+# Download all attachments of an issue to a directory
+$ jira issue attachment download PROJ-123 --all --dir ./proj-123
+
+# Download all attachments, 8 at a time
+$ jira issue attachment download PROJ-123 --all --parallel 8`
+
+	defaultParallel = 4
 )
 
 // NewCmdDownload is a download command.
 func NewCmdDownload() *cobra.Command {
 	cmd := cobra.Command{
-		Use:     "download ISSUE-KEY ATTACHMENT-ID",
-		Short:   "Download an attachment from an issue",
+		Use:     "download ISSUE-KEY [ATTACHMENT-ID]",
+		Short:   "Download attachments from an issue",
 		Long:    helpText,
 		Example: examples,
-		Args:    cobra.ExactArgs(2),
+		Args:    cobra.RangeArgs(1, 2),
 		RunE:    download,
 	}
 
 	cmd.Flags().StringP("output", "o", "", "Output file path (defaults to attachment filename)")
+	cmd.Flags().Bool("all", false, "Download all attachments of the issue")
+	cmd.Flags().String("dir", ".", "Directory to download attachments to with --all")
+	cmd.Flags().Int("parallel", defaultParallel, "Number of concurrent downloads with --all")
 
 	return &cmd
 }
@@ -38,37 +60,53 @@ func NewCmdDownload() *cobra.Command {
 func download(cmd *cobra.Command, args []string) error {
 	project := viper.GetString("project.key")
 	issueKey := cmdutil.GetJiraIssueKey(project, args[0])
-	attachmentID := args[1]
 
-	output, _ := cmd.Flags().GetString("output")
-	if output == "" {
-		// Get attachment info to get filename
-		debug, _ := cmd.Flags().GetBool("debug")
-		client := api.DefaultClient(debug)
-		attachments, err := client.GetAttachments(issueKey)
-		if err != nil {
-			return fmt.Errorf("failed to get attachment info: %w", err)
-		}
+	all, _ := cmd.Flags().GetBool("all")
+	switch {
+	case all && len(args) > 1:
+		return fmt.Errorf("attachment id cannot be used with --all")
+	case !all && len(args) < 2:
+		return fmt.Errorf("attachment id is required unless --all is set")
+	}
 
-		for _, att := range attachments {
-			if att.ID == attachmentID {
-				output = att.Filename
-				break
-			}
-		}
+	debug, _ := cmd.Flags().GetBool("debug")
+	client := api.DefaultClient(debug)
 
-		if output == "" {
-			return fmt.Errorf("attachment %s not found", attachmentID)
+	attachments, err := client.GetAttachmentsContext(cmd.Context(), issueKey)
+	if err != nil {
+		return fmt.Errorf("failed to get attachment info: %w", err)
+	}
+
+	if all {
+		return downloadAll(cmd, client, issueKey, attachments)
+	}
+
+	attachmentID := args[1]
+
+	var attachment *jira.Attachment
+	for _, att := range attachments {
+		if att.ID == attachmentID {
+			attachment = att
+			break
 		}
 	}
+	if attachment == nil {
+		return fmt.Errorf("attachment %s not found", attachmentID)
+	}
 
-	debug, _ := cmd.Flags().GetBool("debug")
-	client := api.DefaultClient(debug)
+	output, _ := cmd.Flags().GetString("output")
+	if output == "" {
+		output = fileName(attachment)
+	}
 
-	s := cmdutil.Info(fmt.Sprintf("Downloading attachment %s...", attachmentID))
-	err := client.DownloadAttachment(attachmentID, output)
-	s.Stop()
+	progress := cmdutil.NewProgress(filepath.Base(output), attachment.Size)
+	err = client.DownloadAttachmentFileContext(cmd.Context(), attachment, output, progress.Add)
+	progress.Done()
 
+	if errors.Is(err, jira.ErrAttachmentExists) {
+		cmdutil.Warn("Skipped %s, the file already exists with the same size", output)
+		return nil
+	}
 	if err != nil {
 		return err
 	}
@@ -77,4 +115,112 @@ func download(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+func downloadAll(cmd *cobra.Command, client *jira.Client, key string, attachments []*jira.Attachment) error {
+	if len(attachments) == 0 {
+		fmt.Fprintf(os.Stderr, "No attachments found in issue %s\n", key)
+		return nil
+	}
+
+	dir, _ := cmd.Flags().GetString("dir")
+	parallel, _ := cmd.Flags().GetInt("parallel")
+	parallel = max(parallel, 1)
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	paths := filePaths(dir, attachments)
+
+	var total int64
+	for _, att := range attachments {
+		total += att.Size
+	}
+	progress := cmdutil.NewProgress(fmt.Sprintf("%d attachment(s)", len(attachments)), total)
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		failed  []string
+		skipped []string
+		sem     = make(chan struct{}, parallel)
+	)
+
+	for i, att := range attachments {
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func(att *jira.Attachment, path string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			err := client.DownloadAttachmentFileContext(cmd.Context(), att, path, progress.Add)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			switch {
+			case errors.Is(err, jira.ErrAttachmentExists):
+				skipped = append(skipped, filepath.Base(path))
+			case err != nil:
+				failed = append(failed, fmt.Sprintf("%s (%v)", att.Filename, err))
+			}
+		}(att, paths[i])
+	}
+	wg.Wait()
+	progress.Done()
+
+	if len(skipped) > 0 {
+		sort.Strings(skipped)
+		cmdutil.Warn("Skipped %d attachment(s) that already exist with the same size: %s",
+			len(skipped), strings.Join(skipped, ", "))
+	}
 
+	downloaded := len(attachments) - len(failed) - len(skipped)
+	if len(failed) > 0 {
+		// The failure is not a usage error.
+		cmd.SilenceUsage = true
+
+		sort.Strings(failed)
+		if downloaded == 0 && len(skipped) == 0 {
+			return fmt.Errorf("failed to download all attachments: %s", strings.Join(failed, ", "))
+		}
+		cmdutil.Warn("Downloaded %d attachment(s) to %s, %d failed", downloaded, dir, len(failed))
+		return fmt.Errorf("failed to download: %s", strings.Join(failed, ", "))
+	}
+
+	if downloaded > 0 || len(skipped) == 0 {
+		cmdutil.Success("Downloaded %d attachment(s) to %s", downloaded, dir)
+	}
+	return nil
+}
+
+// fileName returns a file name for the attachment that is safe to write to,
+// ie: it cannot point outside the target directory.
+func fileName(att *jira.Attachment) string {
+	name := filepath.Base(filepath.Clean("/" + att.Filename))
+	if name == "/" || name == "." {
+		return att.ID
+	}
+	return name
+}
+
+// filePaths returns target paths for attachments in dir. Attachments sharing
+// a name are prefixed with their id so that they don't overwrite each other.
+func filePaths(dir string, attachments []*jira.Attachment) []string {
+	count := make(map[string]int, len(attachments))
+	for _, att := range attachments {
+		count[fileName(att)]++
+	}
+
+	paths := make([]string, 0, len(attachments))
+	for _, att := range attachments {
+		name := fileName(att)
+		if count[name] > 1 {
+			name = att.ID + "-" + name
+		}
+		paths = append(paths, filepath.Join(dir, name))
+	}
+	return paths
+}
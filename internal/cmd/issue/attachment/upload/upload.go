@@ -1,23 +1,34 @@
 package upload
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
 	"github.com/ankitpokhrel/jira-cli/api"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
 )
 
 const (
-	helpText = `Upload uploads a file as an attachment to an issue.`
+	helpText = `Upload uploads files as attachments to an issue.
+
+Files are streamed to the server with a progress bar. Arguments containing glob
+patterns (*, ? or [) are expanded, so quoted patterns work the same on every shell.
+Files larger than the attachment size limit of the server are skipped.`
 	examples = `# Upload a file
 $ jira issue attachment upload PROJ-123 file.pdf
 
 # Upload multiple files
-$ jira issue attachment upload PROJ-123 file1.pdf file2.pdf file3.pdf`
+$ jira issue attachment upload PROJ-123 file1.pdf file2.pdf file3.pdf
+
+# Upload all screenshots
+$ jira issue attachment upload PROJ-123 "screenshots/*.png"`
 )
 
 // NewCmdUpload is an upload command.
@@ -35,24 +46,49 @@ func NewCmdUpload() *cobra.Command {
 func upload(cmd *cobra.Command, args []string) error {
 	project := viper.GetString("project.key")
 	issueKey := cmdutil.GetJiraIssueKey(project, args[0])
-	files := args[1:]
 
 	debug, _ := cmd.Flags().GetBool("debug")
 	client := api.DefaultClient(debug)
 
+	files, failed := expandFiles(args[1:])
+	if len(files) == 0 {
+		return fmt.Errorf("no files to upload: %s", strings.Join(failed, ", "))
+	}
+
+	var limit int64
+	meta, err := client.GetAttachmentMetaContext(cmd.Context())
+	switch {
+	case err != nil:
+		cmdutil.Warn("Unable to fetch attachment settings of the server: %s", err)
+	case !meta.Enabled:
+		return fmt.Errorf("attachments are disabled on the server")
+	case meta.UploadLimit > 0:
+		limit = meta.UploadLimit
+		fmt.Fprintf(os.Stderr, "Server upload limit: %s per file\n", cmdutil.FormatBytes(limit))
+	}
+
 	var uploaded []string
-	var failed []string
 
 	for _, filePath := range files {
-		if _, err := os.Stat(filePath); os.IsNotExist(err) {
+		info, err := os.Stat(filePath)
+		switch {
+		case os.IsNotExist(err):
 			failed = append(failed, fmt.Sprintf("%s (file not found)", filePath))
 			continue
+		case err != nil:
+			failed = append(failed, fmt.Sprintf("%s (%v)", filePath, err))
+			continue
+		case info.IsDir():
+			failed = append(failed, fmt.Sprintf("%s (is a directory)", filePath))
+			continue
+		case limit > 0 && info.Size() > limit:
+			failed = append(failed, fmt.Sprintf(
+				"%s (%s exceeds upload limit of %s)", filePath, cmdutil.FormatBytes(info.Size()), cmdutil.FormatBytes(limit),
+			))
+			continue
 		}
 
-		s := cmdutil.Info(fmt.Sprintf("Uploading %s...", filePath))
-		attachments, err := client.UploadAttachment(issueKey, filePath)
-		s.Stop()
-
+		attachments, err := uploadFile(cmd.Context(), client, issueKey, filePath, info.Size())
 		if err != nil {
 			failed = append(failed, fmt.Sprintf("%s (%v)", filePath, err))
 			continue
@@ -64,16 +100,66 @@ func upload(cmd *cobra.Command, args []string) error {
 	}
 
 	if len(failed) > 0 {
-		if len(uploaded) > 0 {
-			cmdutil.Warn("Uploaded %d file(s) successfully, %d failed", len(uploaded), len(failed))
-			fmt.Printf("Failed: %s\n", failed)
-		} else {
-			return fmt.Errorf("failed to upload all files: %v", failed)
+		// The failure is not a usage error.
+		cmd.SilenceUsage = true
+
+		if len(uploaded) == 0 {
+			return fmt.Errorf("failed to upload all files: %s", strings.Join(failed, ", "))
 		}
-	} else {
-		cmdutil.Success("Successfully uploaded %d file(s) to issue %s", len(uploaded), issueKey)
+		cmdutil.Warn("Uploaded %d file(s) successfully, %d failed", len(uploaded), len(failed))
+		return fmt.Errorf("failed to upload: %s", strings.Join(failed, ", "))
 	}
 
+	cmdutil.Success("Successfully uploaded %d file(s) to issue %s", len(uploaded), issueKey)
 	return nil
 }
 
+func uploadFile(ctx context.Context, client *jira.Client, key, filePath string, size int64) ([]*jira.Attachment, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = file.Close() }()
+
+	name := filepath.Base(filePath)
+	progress := cmdutil.NewProgress(name, size)
+	defer progress.Done()
+
+	return client.UploadAttachmentReaderContext(ctx, key, name, progress.Reader(file))
+}
+
+// expandFiles expands glob patterns in args. Duplicates are removed and
+// patterns without a match are returned as failures.
+func expandFiles(args []string) ([]string, []string) {
+	var (
+		files  []string
+		failed []string
+		seen   = make(map[string]bool)
+	)
+
+	add := func(f string) {
+		if !seen[f] {
+			seen[f] = true
+			files = append(files, f)
+		}
+	}
+
+	for _, arg := range args {
+		if !strings.ContainsAny(arg, "*?[") {
+			add(arg)
+			continue
+		}
+		matches, err := filepath.Glob(arg)
+		switch {
+		case err != nil:
+			failed = append(failed, fmt.Sprintf("%s (%v)", arg, err))
+		case len(matches) == 0:
+			failed = append(failed, fmt.Sprintf("%s (no match)", arg))
+		}
+		for _, m := range matches {
+			add(m)
+		}
+	}
+
+	return files, failed
+}
@@ -22,6 +22,9 @@ const (
 # Pass required parameters to skip prompt 
 $ jira issue comment add ISSUE-1 "My comment"
 
+# Comment on the issue referenced in the current git branch name
+$ jira issue comment add "My comment"
+
 # Multi-line comment
 $ jira issue comment add ISSUE-1 $'Supports\n\nNew line'
 
@@ -128,14 +131,11 @@ type addParams struct {
 }
 
 func parseArgsAndFlags(args []string, flags query.FlagParser) *addParams {
-	var issueKey, body string
+	var body string
 
-	nargs := len(args)
-	if nargs >= 1 {
-		issueKey = cmdutil.GetJiraIssueKey(viper.GetString("project.key"), args[0])
-	}
-	if nargs >= 2 {
-		body = args[1]
+	issueKey, rest := cmdutil.GetIssueKeyFromArgs(viper.GetString("project.key"), args)
+	if len(rest) >= 1 {
+		body = rest[0]
 	}
 
 	debug, err := flags.GetBool("debug")
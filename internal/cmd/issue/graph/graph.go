@@ -0,0 +1,132 @@
+package graph
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/graph"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/internal/workflow"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Graph displays the dependency graph of an issue.
+
+The graph is built by following issue links, subtasks, parents and epic children
+breadth-first from the given issue. Statuses are colored using the workflow mapping
+from the config and issues that block each other are reported as cycles.
+
+Use --types to follow only some relations. Link types can be given by their name or
+description, eg: blocks, relates or "is blocked by". Use subtask for subtasks and
+parents and epic for epic children.`
+	examples = `# Show the dependency tree of an issue
+$ jira issue graph ISSUE-1
+
+# Follow only blocking links up to 4 hops away
+$ jira issue graph ISSUE-1 --depth 4 --types blocks
+
+# Render the graph with graphviz
+$ jira issue graph ISSUE-1 --format dot | dot -Tsvg > graph.svg
+
+# Generate a mermaid diagram for markdown docs
+$ jira issue graph ISSUE-1 --types blocks,relates,subtask --format mermaid`
+
+	defaultDepth = 2
+	epicPageSize = 100
+)
+
+// NewCmdGraph is a graph command.
+func NewCmdGraph() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "graph ISSUE-KEY",
+		Short:   "Display the dependency graph of an issue",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"deps"},
+		Annotations: map[string]string{
+			"help:args": "ISSUE-KEY\tIssue key, eg: ISSUE-1",
+		},
+		Args: cobra.ExactArgs(1),
+		RunE: run,
+	}
+
+	cmd.Flags().Int("depth", defaultDepth, "Number of hops to follow from the issue")
+	cmd.Flags().StringSlice("types", nil, "Relations to follow, eg: blocks,relates,subtask,epic (default all)")
+	cmd.Flags().String("format", view.IssueGraphFormatASCII, "Output format: ascii, dot or mermaid")
+
+	return &cmd
+}
+
+func run(cmd *cobra.Command, args []string) error {
+	project := viper.GetString("project.key")
+	key := cmdutil.GetJiraIssueKey(project, args[0])
+
+	depth, _ := cmd.Flags().GetInt("depth")
+	types, _ := cmd.Flags().GetStringSlice("types")
+	format, _ := cmd.Flags().GetString("format")
+	debug, _ := cmd.Flags().GetBool("debug")
+
+	if depth < 1 {
+		return fmt.Errorf("depth must be at least 1")
+	}
+
+	wf, err := workflow.FromConfig()
+	if err != nil {
+		return err
+	}
+
+	f := fetcher{client: api.DefaultClient(debug)}
+
+	g, err := func() (*graph.Graph, error) {
+		s := cmdutil.Info(fmt.Sprintf("Building dependency graph of %s...", key))
+		defer s.Stop()
+
+		return graph.Build(f, key, graph.Options{Depth: depth, Types: types})
+	}()
+	if err != nil {
+		return err
+	}
+
+	v := view.NewIssueGraph(g, view.WithIssueGraphFormat(strings.ToLower(format)), view.WithIssueGraphWorkflow(wf))
+	if err := v.Render(); err != nil {
+		return err
+	}
+
+	if len(g.Unreachable) > 0 {
+		cmdutil.Warn("Unable to fetch: %s", strings.Join(g.Unreachable, ", "))
+	}
+	if cycles := v.Cycles(); len(cycles) > 0 {
+		cmdutil.Warn("Found %d blocking cycle(s), issues in a cycle can never be completed", len(cycles))
+	}
+
+	return nil
+}
+
+type fetcher struct {
+	client *jira.Client
+}
+
+func (f fetcher) Issue(key string) (*jira.Issue, error) {
+	return api.ProxyGetIssue(f.client, key)
+}
+
+func (f fetcher) EpicChildren(key string) ([]*jira.Issue, error) {
+	var issues []*jira.Issue
+
+	for {
+		res, err := f.client.EpicIssues(key, "", uint(len(issues)), epicPageSize)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, res.Issues...)
+		if len(res.Issues) == 0 || len(issues) >= res.Total {
+			return issues, nil
+		}
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/delete"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/edit"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/estimate"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/graph"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/history"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/label"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/link"
@@ -26,6 +27,7 @@ import (
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/voters"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/watch"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/worklog"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
 )
 
 const helpText = `Issue manage issues in a given project. See available commands below.`
@@ -50,6 +52,7 @@ func NewCmdIssue() *cobra.Command {
 		delete.NewCmdDelete(), watch.NewCmdWatch(), unwatch.NewCmdUnwatch(), vote.NewCmdVote(),
 		unvote.NewCmdUnvote(), voters.NewCmdVoters(), worklog.NewCmdWorklog(),
 		attachment.NewCmdAttachment(), history.NewCmdHistory(), label.NewCmdLabel(),
+		graph.NewCmdGraph(),
 		// Bulk operations
 		move.NewCmdMoveBulk(), assign.NewCmdAssignBulk(),
 		watch.NewCmdWatchBulk(), unwatch.NewCmdUnwatchBulk(),
@@ -58,6 +61,7 @@ func NewCmdIssue() *cobra.Command {
 	)
 
 	list.SetFlags(lc)
+	cmdcommon.SetWatchFlags(lc)
 	create.SetFlags(cc)
 
 	return &cmd
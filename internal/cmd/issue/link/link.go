@@ -10,6 +10,7 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/link/list"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/link/remote"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/internal/query"
@@ -17,9 +18,17 @@ import (
 )
 
 const (
-	helpText     = `Link connects two issues to a given link type.`
-	examples     = `$ jira issue link ISSUE-1 ISSUE-2 Duplicate`
+	helpText = `Link connects two issues to a given link type.`
+	examples = `$ jira issue link ISSUE-1 ISSUE-2 Duplicate
+
+# Link an issue to all issues returned by a JQL query
+$ jira issue link ISSUE-1 Blocks --to-jql "project = ISSUE AND labels = backend"
+
+# List links of an issue
+$ jira issue link list ISSUE-1`
 	optionCancel = "Cancel"
+
+	defaultJQLLimit = 50
 )
 
 // NewCmdLink is a link command.
@@ -33,19 +42,28 @@ func NewCmdLink() *cobra.Command {
 		Annotations: map[string]string{
 			"help:args": "INWARD_ISSUE_KEY\tIssue key of the source issue, eg: ISSUE-1\n" +
 				"OUTWARD_ISSUE_KEY\tIssue key of the target issue, eg: ISSUE-2\n" +
-				"ISSUE_LINK_TYPE\tRelationship between two issues, eg: Duplicates, Blocks etc.",
+				"ISSUE_LINK_TYPE\tRelationship between two issues, eg: Duplicates, Blocks etc.\n" +
+				"\tWith --to-jql, pass only INWARD_ISSUE_KEY and ISSUE_LINK_TYPE.",
 		},
 		Run: link,
 	}
 
-	cmd.AddCommand(remote.NewCmdRemoteLink())
+	cmd.AddCommand(list.NewCmdList(), remote.NewCmdRemoteLink())
 	cmd.PersistentFlags().Bool("web", false, "Open issue in web browser after successful linking")
+	cmd.Flags().String("to-jql", "", "Link the issue to every issue returned by the JQL query")
+	cmd.Flags().Uint("limit", defaultJQLLimit, "Maximum number of issues to link with --to-jql, 0 links all matching issues")
 
 	return &cmd
 }
 
 func link(cmd *cobra.Command, args []string) {
 	project := viper.GetString("project.key")
+
+	if jql, _ := cmd.Flags().GetString("to-jql"); jql != "" {
+		linkToJQL(cmd, args, project, jql)
+		return
+	}
+
 	params := parseArgsAndFlags(cmd.Flags(), args, project)
 	client := api.DefaultClient(params.debug)
 	lc := linkCmd{
@@ -90,6 +108,120 @@ func link(cmd *cobra.Command, args []string) {
 	}
 }
 
+func linkToJQL(cmd *cobra.Command, args []string, project, jql string) {
+	if len(args) > 2 {
+		cmdutil.Failed("Error: pass only the issue key and the link type with --to-jql")
+		return
+	}
+
+	var (
+		key      string
+		linkType string
+	)
+	if len(args) > 0 {
+		key = args[0]
+	}
+	if len(args) > 1 {
+		linkType = args[1]
+	}
+
+	params := parseArgsAndFlags(cmd.Flags(), []string{key, "", linkType}, project)
+
+	limit, err := cmd.Flags().GetUint("limit")
+	cmdutil.ExitIfError(err)
+
+	client := api.DefaultClient(params.debug)
+	lc := linkCmd{client: client, params: params}
+
+	cmdutil.ExitIfError(lc.setInwardIssueKey(project))
+	cmdutil.ExitIfError(lc.setLinkTypes())
+	cmdutil.ExitIfError(lc.setDesiredLinkType())
+
+	if lc.params.linkType == optionCancel {
+		cmdutil.Fail("Action aborted")
+		os.Exit(0)
+	}
+
+	lt, err := lc.verifyIssueLinkType()
+	if err != nil {
+		fmt.Println()
+		cmdutil.Failed("Error: %s", err.Error())
+		return
+	}
+
+	source := lc.params.inwardIssueKey
+
+	var truncated bool
+
+	targets, err := func() ([]string, error) {
+		s := cmdutil.Info("Searching issues to link...")
+		defer s.Stop()
+
+		res, err := api.ProxySearchAll(client, jql, limit)
+		if err != nil {
+			return nil, err
+		}
+		truncated = !res.IsLast
+		links, err := client.GetIssueLinks(source)
+		if err != nil {
+			return nil, err
+		}
+
+		linked := make(map[string]bool, len(links))
+		for _, l := range links {
+			if l.OutwardIssue != nil && strings.EqualFold(l.Type.Name, lt.Name) {
+				linked[l.OutwardIssue.Key] = true
+			}
+		}
+
+		keys := make([]string, 0, len(res.Issues))
+		for _, iss := range res.Issues {
+			if iss.Key == source || linked[iss.Key] {
+				continue
+			}
+			keys = append(keys, iss.Key)
+		}
+		return keys, nil
+	}()
+	cmdutil.ExitIfError(err)
+
+	if truncated {
+		cmdutil.Warn("Linking only the first %d matching issues, use --limit to include more", limit)
+	}
+	if len(targets) == 0 {
+		cmdutil.Warn("No issues to link, %s is already linked to all matching issues", source)
+		return
+	}
+
+	var failed int
+	for i, target := range targets {
+		err := func() error {
+			s := cmdutil.Info(fmt.Sprintf("Linking %s to %s (%d/%d)", source, target, i+1, len(targets)))
+			defer s.Stop()
+
+			return client.LinkIssue(source, target, lt.Name)
+		}()
+		if err != nil {
+			failed++
+			cmdutil.Warn("Unable to link %s to %s: %s", source, target, err)
+		}
+	}
+
+	server := viper.GetString("server")
+
+	if failed > 0 {
+		cmdutil.Failed("Linked %d of %d issues as %q", len(targets)-failed, len(targets), lt.Name)
+		return
+	}
+	cmdutil.Success("Linked %s to %d issues as %q", source, len(targets), lt.Name)
+	fmt.Printf("%s\n", cmdutil.GenerateServerBrowseURL(server, source))
+
+	if web, _ := cmd.Flags().GetBool("web"); web {
+		err := cmdutil.Navigate(server, source)
+		cmdutil.ExitIfError(err)
+	}
+}
+
 type linkParams struct {
 	inwardIssueKey  string
 	outwardIssueKey string
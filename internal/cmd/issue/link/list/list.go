@@ -0,0 +1,75 @@
+package list
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const examples = `$ jira issue link list ISSUE-1
+
+# List links in plain mode
+$ jira issue link list ISSUE-1 --plain
+
+# Get the raw JSON data
+$ jira issue link list ISSUE-1 --output json`
+
+// NewCmdList is a list command.
+func NewCmdList() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "list ISSUE_KEY",
+		Short:   "List lists links of an issue",
+		Long:    "List lists links of an issue to other issues.",
+		Example: examples,
+		Aliases: []string{"lists", "ls"},
+		Annotations: map[string]string{
+			"help:args": "ISSUE_KEY\tIssue key, eg: ISSUE-1",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  List,
+	}
+
+	cmd.Flags().Bool("plain", false, "Display output in plain mode")
+	cmd.Flags().String("output", "", "Output format: json (default: table)")
+
+	return &cmd
+}
+
+// List displays a list view.
+func List(cmd *cobra.Command, args []string) {
+	key := cmdutil.GetJiraIssueKey(viper.GetString("project.key"), args[0])
+
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	plain, err := cmd.Flags().GetBool("plain")
+	cmdutil.ExitIfError(err)
+
+	output, err := cmd.Flags().GetString("output")
+	cmdutil.ExitIfError(err)
+
+	links, err := func() ([]*jira.IssueLink, error) {
+		s := cmdutil.Info("Fetching issue links...")
+		defer s.Stop()
+
+		return api.DefaultClient(debug).GetIssueLinks(key)
+	}()
+	cmdutil.ExitIfError(err)
+
+	if len(links) == 0 && output != "json" {
+		cmdutil.Failed("No links found for issue %q.", key)
+		return
+	}
+
+	v := view.NewIssueLinks(
+		links,
+		view.WithLinkPlain(plain),
+		view.WithLinkJSON(output == "json"),
+	)
+
+	cmdutil.ExitIfError(v.Render())
+}
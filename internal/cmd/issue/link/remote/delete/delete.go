@@ -0,0 +1,59 @@
+package delete
+
+import (
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+)
+
+const (
+	helpText = `Delete deletes a remote link of an issue by its id or global id.`
+	examples = `$ jira issue link remote delete ISSUE-1 10000
+$ jira issue link remote delete ISSUE-1 "system=https://ci.example.com&id=42"`
+)
+
+// NewCmdDelete is a delete command.
+func NewCmdDelete() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "delete ISSUE_KEY LINK",
+		Short:   "Delete deletes a remote link of an issue",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"remove", "rm"},
+		Annotations: map[string]string{
+			"help:args": "ISSUE_KEY\tIssue key, eg: ISSUE-1\n" +
+				"LINK\tId or global id of the remote link",
+		},
+		Args: cobra.ExactArgs(2),
+		Run:  Delete,
+	}
+
+	return &cmd
+}
+
+// Delete deletes a remote link.
+func Delete(cmd *cobra.Command, args []string) {
+	key := cmdutil.GetJiraIssueKey(viper.GetString("project.key"), args[0])
+
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	client := api.DefaultClient(debug)
+
+	err = func() error {
+		s := cmdutil.Info("Deleting remote link...")
+		defer s.Stop()
+
+		if id, err := strconv.Atoi(args[1]); err == nil {
+			return client.DeleteRemoteLink(key, id)
+		}
+		return client.DeleteRemoteLinkByGlobalID(key, args[1])
+	}()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success("Remote link %s deleted from issue %s", args[1], key)
+}
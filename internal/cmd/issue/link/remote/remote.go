@@ -8,6 +8,9 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/link/remote/delete"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/link/remote/list"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/link/remote/update"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/internal/query"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
@@ -15,7 +18,16 @@ import (
 
 const (
 	helpText = `Adds a remote web link to an issue`
-	examples = `$ jira issue link remote ISSUE-1 http://weblink.com weblink-title`
+	examples = `$ jira issue link remote ISSUE-1 http://weblink.com weblink-title
+
+# Create or update a link identified by a global id, with an icon
+$ jira issue link remote ISSUE-1 https://ci.example.com/42 "Build #42" \
+  --global-id "ci-build-42" --icon-url https://ci.example.com/favicon.ico --relationship "built by"
+
+# List, update and delete remote links
+$ jira issue link remote list ISSUE-1
+$ jira issue link remote update ISSUE-1 10000 --title "Build #43"
+$ jira issue link remote delete ISSUE-1 ci-build-42`
 )
 
 // NewCmdRemoteLink is a link command.
@@ -34,6 +46,14 @@ func NewCmdRemoteLink() *cobra.Command {
 		Run: remotelink,
 	}
 
+	cmd.AddCommand(list.NewCmdList(), update.NewCmdUpdate(), delete.NewCmdDelete())
+
+	cmd.Flags().String("global-id", "", "Global id of the remote object, a link with the same global id is updated")
+	cmd.Flags().String("summary", "", "Summary of the remote object")
+	cmd.Flags().String("relationship", "", "Relationship between the issue and the remote object, eg: causes")
+	cmd.Flags().String("icon-url", "", "Url of a 16x16 icon shown next to the link")
+	cmd.Flags().String("icon-title", "", "Title of the icon")
+
 	return &cmd
 }
 
@@ -54,7 +74,21 @@ func remotelink(cmd *cobra.Command, args []string) {
 		s := cmdutil.Info("Creating remote web link for issue")
 		defer s.Stop()
 
-		return client.RemoteLinkIssue(lc.params.issueKey, lc.params.title, lc.params.url)
+		link := jira.RemoteLink{
+			GlobalID:     lc.params.globalID,
+			Relationship: lc.params.relationship,
+			Object: jira.RemoteLinkObject{
+				URL:     lc.params.url,
+				Title:   lc.params.title,
+				Summary: lc.params.summary,
+			},
+		}
+		if lc.params.iconURL != "" || lc.params.iconTitle != "" {
+			link.Object.Icon = &jira.RemoteLinkIcon{URL: lc.params.iconURL, Title: lc.params.iconTitle}
+		}
+
+		_, err := client.CreateRemoteLink(lc.params.issueKey, &link)
+		return err
 	}()
 	cmdutil.ExitIfError(err)
 
@@ -70,10 +104,15 @@ func remotelink(cmd *cobra.Command, args []string) {
 }
 
 type linkParams struct {
-	issueKey string
-	url      string
-	title    string
-	debug    bool
+	issueKey     string
+	url          string
+	title        string
+	globalID     string
+	summary      string
+	relationship string
+	iconURL      string
+	iconTitle    string
+	debug        bool
 }
 
 func parseArgsAndFlags(flags query.FlagParser, args []string, project string) *linkParams {
@@ -92,11 +131,22 @@ func parseArgsAndFlags(flags query.FlagParser, args []string, project string) *l
 	debug, err := flags.GetBool("debug")
 	cmdutil.ExitIfError(err)
 
+	str := func(name string) string {
+		v, err := flags.GetString(name)
+		cmdutil.ExitIfError(err)
+		return v
+	}
+
 	return &linkParams{
-		issueKey: issueKey,
-		url:      url,
-		title:    title,
-		debug:    debug,
+		issueKey:     issueKey,
+		url:          url,
+		title:        title,
+		globalID:     str("global-id"),
+		summary:      str("summary"),
+		relationship: str("relationship"),
+		iconURL:      str("icon-url"),
+		iconTitle:    str("icon-title"),
+		debug:        debug,
 	}
 }
 
@@ -0,0 +1,133 @@
+package update
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Update updates a remote link of an issue. Only the given fields are changed.`
+	examples = `$ jira issue link remote update ISSUE-1 10000 --title "Build #43" --url https://ci.example.com/43
+$ jira issue link remote update ISSUE-1 ci-build-42 --icon-url https://ci.example.com/failed.ico --summary Failed`
+)
+
+// NewCmdUpdate is an update command.
+func NewCmdUpdate() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "update ISSUE_KEY LINK",
+		Short:   "Update updates a remote link of an issue",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"edit"},
+		Annotations: map[string]string{
+			"help:args": "ISSUE_KEY\tIssue key, eg: ISSUE-1\n" +
+				"LINK\tId or global id of the remote link",
+		},
+		Args: cobra.ExactArgs(2),
+		Run:  Update,
+	}
+
+	cmd.Flags().String("url", "", "Url of the remote object")
+	cmd.Flags().String("title", "", "Title of the remote object")
+	cmd.Flags().String("summary", "", "Summary of the remote object")
+	cmd.Flags().String("relationship", "", "Relationship between the issue and the remote object")
+	cmd.Flags().String("global-id", "", "New global id of the remote object")
+	cmd.Flags().String("icon-url", "", "Url of a 16x16 icon shown next to the link")
+	cmd.Flags().String("icon-title", "", "Title of the icon")
+
+	return &cmd
+}
+
+// Update updates a remote link.
+func Update(cmd *cobra.Command, args []string) {
+	key := cmdutil.GetJiraIssueKey(viper.GetString("project.key"), args[0])
+
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	changes := make(map[string]string)
+	for _, name := range []string{"url", "title", "summary", "relationship", "global-id", "icon-url", "icon-title"} {
+		if !cmd.Flags().Changed(name) {
+			continue
+		}
+		v, err := cmd.Flags().GetString(name)
+		cmdutil.ExitIfError(err)
+		changes[name] = v
+	}
+	if len(changes) == 0 {
+		cmdutil.Failed("At least one field must be provided to update")
+		return
+	}
+
+	client := api.DefaultClient(debug)
+
+	link, err := func() (*jira.RemoteLink, error) {
+		s := cmdutil.Info("Updating remote link...")
+		defer s.Stop()
+
+		link, err := findRemoteLink(client, key, args[1])
+		if err != nil {
+			return nil, err
+		}
+		apply(link, changes)
+
+		id := link.ID
+		link.ID, link.Self = 0, ""
+		if err := client.UpdateRemoteLink(key, id, link); err != nil {
+			return nil, err
+		}
+		link.ID = id
+		return link, nil
+	}()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success("Remote link updated: %s (ID: %d)", link.Object.Title, link.ID)
+}
+
+func findRemoteLink(client *jira.Client, key, idOrGlobalID string) (*jira.RemoteLink, error) {
+	links, err := client.GetRemoteLinks(key)
+	if err != nil {
+		return nil, err
+	}
+
+	id, _ := strconv.Atoi(idOrGlobalID)
+	for _, l := range links {
+		if (id != 0 && l.ID == id) || l.GlobalID == idOrGlobalID {
+			return l, nil
+		}
+	}
+	return nil, fmt.Errorf("remote link %q not found in issue %s", idOrGlobalID, key)
+}
+
+func apply(link *jira.RemoteLink, changes map[string]string) {
+	for name, v := range changes {
+		switch name {
+		case "url":
+			link.Object.URL = v
+		case "title":
+			link.Object.Title = v
+		case "summary":
+			link.Object.Summary = v
+		case "relationship":
+			link.Relationship = v
+		case "global-id":
+			link.GlobalID = v
+		case "icon-url", "icon-title":
+			if link.Object.Icon == nil {
+				link.Object.Icon = &jira.RemoteLinkIcon{}
+			}
+			if name == "icon-url" {
+				link.Object.Icon.URL = v
+			} else {
+				link.Object.Icon.Title = v
+			}
+		}
+	}
+}
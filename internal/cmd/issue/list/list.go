@@ -9,9 +9,11 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/internal/query"
 	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/internal/watch"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
 )
 
@@ -63,7 +65,10 @@ $ jira issue list -tEpic -sDone
 $ jira issue list -s~Open -ax
 
 # List issues from all projects
-$ jira issue list -q"project IS NOT EMPTY"`
+$ jira issue list -q"project IS NOT EMPTY"
+
+# Refresh the list every minute and highlight changed issues
+$ jira issue list -s"In Progress" --watch=1m`
 )
 
 // NewCmdList is a list command.
@@ -81,7 +86,7 @@ func NewCmdList() *cobra.Command {
 
 // List displays a list view.
 func List(cmd *cobra.Command, args []string) error {
-	loadList(cmd, args)
+	cmdutil.ExitIfError(loadList(cmd, args))
 	return nil
 }
 
@@ -100,6 +105,9 @@ func loadList(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	w, err := cmdcommon.GetWatch(cmd)
+	cmdutil.ExitIfError(err)
+
 	pk, err := cmd.Flags().GetString("parent")
 	if err != nil {
 		return err
@@ -125,16 +133,17 @@ func loadList(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	q, err := query.NewIssue(project, cmd.Flags())
+	if err != nil {
+		return err
+	}
+	jql := q.Get()
+
 	issues, err := func() ([]*jira.Issue, error) {
 		s := cmdutil.Info("Fetching issues...")
 		defer s.Stop()
 
-		q, err := query.NewIssue(project, cmd.Flags())
-		if err != nil {
-			return nil, err
-		}
-
-		resp, err := api.ProxySearch(api.DefaultClient(debug), q.Get(), q.Params().From, q.Params().Limit)
+		resp, err := api.ProxySearch(api.DefaultClient(debug), jql, q.Params().From, q.Params().Limit)
 		if err != nil {
 			return nil, err
 		}
@@ -161,6 +170,10 @@ func loadList(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if w.Enabled() && (keysOnly || (outputFormat != "" && outputFormat != "table")) {
+		cmdutil.Failed("--watch works only with the table output")
+	}
+
 	// Handle output formats
 	if keysOnly {
 		outputKeysOnly(issues)
@@ -251,6 +264,20 @@ func loadList(cmd *cobra.Command, args []string) error {
 		},
 	}
 
+	if w.Enabled() {
+		client := api.DefaultClient(debug)
+		search := func(jql string) ([]*jira.Issue, error) {
+			resp, err := api.ProxySearch(client, jql, 0, q.Params().Limit)
+			if err != nil {
+				return nil, err
+			}
+			return resp.Issues, nil
+		}
+		v.Watch = w.Poll(watch.NewPoller(jql, search, search), cmd.CommandPath(), issues)
+		v.WatchInterval = w.Interval
+		v.WatchContext = w.Context
+	}
+
 	return v.Render()
 }
 
@@ -2,6 +2,7 @@ package move
 
 import (
 	"fmt"
+	"os"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -15,12 +16,16 @@ import (
 const (
 	bulkHelpText = `Bulk move transitions multiple issues from one state to another.
 
-You can transition up to 50 issues at once. All issues will be transitioned to the same state.`
+You can transition up to 50 issues at once. All issues will be transitioned to the same state.
+Issues that can't reach the state directly are moved through intermediate statuses.`
 	bulkExamples = `# Transition multiple issues to "In Progress"
 $ jira issue move-bulk PROJ-1 PROJ-2 PROJ-3 "In Progress"
 
 # Transition with comment
-$ jira issue move-bulk PROJ-1 PROJ-2 PROJ-3 Done --comment "All completed" -RFixed`
+$ jira issue move-bulk PROJ-1 PROJ-2 PROJ-3 Done --comment "All completed" -RFixed
+
+# Preview transitions needed to move the issues to Done
+$ jira issue move-bulk PROJ-1 PROJ-2 Done --path`
 )
 
 // NewCmdMoveBulk is a bulk move command.
@@ -38,6 +43,8 @@ func NewCmdMoveBulk() *cobra.Command {
 	cmd.Flags().String("comment", "", "Add comment to all issues")
 	cmd.Flags().StringP("assignee", "a", "", "Assign all issues to a user")
 	cmd.Flags().StringP("resolution", "R", "", "Set resolution for all issues")
+	cmd.Flags().Bool("path", false, "Preview transitions needed to reach the state without moving the issues")
+	cmd.Flags().Bool("no-input", false, "Don't ask for confirmation or required fields of intermediate transitions")
 
 	return &cmd
 }
@@ -77,47 +84,15 @@ func moveBulk(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	if targetTransition == nil {
-		available := make([]string, 0, len(transitions))
-		for _, t := range transitions {
-			available = append(available, fmt.Sprintf("'%s'", t.Name))
-		}
-		return fmt.Errorf("invalid transition state %q\nAvailable states: %s", state, strings.Join(available, ", "))
-	}
-
-	// Prepare transition request
-	trFieldsReq := jira.TransitionRequestFields{}
-	trUpdateReq := jira.TransitionRequestUpdate{}
-
-	if assignee != "" {
-		trFieldsReq.Assignee = &struct {
-			Name string `json:"name"`
-		}{Name: assignee}
-	}
-	if resolution != "" {
-		trFieldsReq.Resolution = &struct {
-			Name string `json:"name"`
-		}{Name: resolution}
-	}
-	if comment != "" {
-		trUpdateReq.Comment = []struct {
-			Add struct {
-				Body string `json:"body"`
-			} `json:"add"`
-		}{
-			{Add: struct {
-				Body string `json:"body"`
-			}{Body: comment}},
-		}
+	transitionReq := newTransitionRequest(assignee, resolution, comment)
+
+	if path, _ := cmd.Flags().GetBool("path"); path || targetTransition == nil {
+		return moveBulkThroughPath(cmd, client, normalizedKeys, state, transitionReq, path)
 	}
 
-	transitionReq := &jira.TransitionRequest{
-		Fields: &trFieldsReq,
-		Update: &trUpdateReq,
-		Transition: &jira.TransitionRequestData{
-			ID:   targetTransition.ID.String(),
-			Name: targetTransition.Name,
-		},
+	transitionReq.Transition = &jira.TransitionRequestData{
+		ID:   targetTransition.ID.String(),
+		Name: targetTransition.Name,
 	}
 
 	// Transition all issues
@@ -152,3 +127,70 @@ func moveBulk(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// moveBulkThroughPath moves issues to the state through intermediate statuses.
+func moveBulkThroughPath(cmd *cobra.Command, client *jira.Client, keys []string, state string, req *jira.TransitionRequest, previewOnly bool) error {
+	noInput, err := cmd.Flags().GetBool("no-input")
+	if err != nil {
+		return err
+	}
+
+	m, err := newPathMover(client, noInput)
+	if err != nil {
+		return err
+	}
+
+	s := cmdutil.Info("Finding transition paths...")
+	plans := make([]*movePlan, 0, len(keys))
+	for _, key := range keys {
+		p, err := m.plan(key, state)
+		if err != nil {
+			s.Stop()
+			return fmt.Errorf("failed to find transition path for %s: %w", key, err)
+		}
+		plans = append(plans, p)
+	}
+	s.Stop()
+
+	for _, p := range plans {
+		p.render(os.Stdout, state)
+	}
+	if previewOnly {
+		return m.graphs.Save(m.graphFile)
+	}
+
+	if !noInput {
+		ok, err := confirm(fmt.Sprintf("Move %d issues to %q?", len(plans), state))
+		if err != nil {
+			return err
+		}
+		if !ok {
+			cmdutil.Fail("Action aborted")
+			return fmt.Errorf("action aborted")
+		}
+	}
+
+	var failed, succeeded []string
+	for _, p := range plans {
+		if p.done() {
+			succeeded = append(succeeded, p.key)
+			continue
+		}
+		if err := m.execute(p, state, req); err != nil {
+			cmdutil.Warn("%s: %s", p.key, err)
+			failed = append(failed, p.key)
+			continue
+		}
+		succeeded = append(succeeded, p.key)
+	}
+
+	if len(failed) > 0 {
+		if len(succeeded) == 0 {
+			return fmt.Errorf("failed to transition all issues")
+		}
+		cmdutil.Warn("Transitioned %d issues successfully, %d failed", len(succeeded), len(failed))
+		fmt.Printf("Failed: %s\n", strings.Join(failed, ", "))
+		return nil
+	}
+	cmdutil.Success("Successfully transitioned %d issues to state %q", len(succeeded), state)
+	return nil
+}
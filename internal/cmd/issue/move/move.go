@@ -15,9 +15,21 @@ import (
 )
 
 const (
-	helpText = `Move transitions an issue from one state to another.`
+	helpText = `Move transitions an issue from one state to another.
+
+If the state isn't directly reachable from the current status, the issue is moved
+through intermediate statuses using the shortest known path. Paths are learned as
+issues are moved, unknown parts of a workflow are explored one confirmed transition
+at a time. Exploring is not possible with --no-input. Required fields of
+intermediate transition screens are prompted for.`
 	examples = `$ jira issue move ISSUE-1 "In Progress"
-$ jira issue move ISSUE-1 Done`
+$ jira issue move ISSUE-1 Done
+
+# Preview the transitions needed to move the issue to Done
+$ jira issue move ISSUE-1 Done --path
+
+# Move the issue referenced in the current git branch name
+$ jira issue move Done`
 
 	optionCancel = "Cancel"
 )
@@ -45,6 +57,8 @@ STATE		State you want to transition the issue to`,
 	cmd.Flags().Bool("web", false, "Open issue in web browser after successful transition")
 	cmd.Flags().Bool("stdin", false, "Read issue keys from stdin (one per line)")
 	cmd.Flags().String("jql", "", "Apply to all issues matching JQL query")
+	cmd.Flags().Bool("path", false, "Preview transitions needed to reach the state without moving the issue")
+	cmd.Flags().Bool("no-input", false, "Don't ask for confirmation or required fields of intermediate transitions")
 
 	return &cmd
 }
@@ -84,6 +98,14 @@ func move(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("action aborted")
 	}
 
+	if path, _ := cmd.Flags().GetBool("path"); path || mc.directTransition() == nil {
+		moved, err := moveThroughPath(cmd, &mc, path)
+		if err != nil || !moved {
+			return err
+		}
+		return moveDone(cmd, mc.params.key, mc.params.state)
+	}
+
 	tr, err := mc.verifyTransition(installation)
 	if err != nil {
 		fmt.Println()
@@ -94,52 +116,30 @@ func move(cmd *cobra.Command, args []string) error {
 		s := cmdutil.Info(fmt.Sprintf("Transitioning issue to %q...", tr.Name))
 		defer s.Stop()
 
-		trFieldsReq := jira.TransitionRequestFields{}
-		trUpdateReq := jira.TransitionRequestUpdate{}
-
-		if mc.params.assignee != "" {
-			trFieldsReq.Assignee = &struct {
-				Name string `json:"name"`
-			}{Name: mc.params.assignee}
-		}
-		if mc.params.resolution != "" {
-			trFieldsReq.Resolution = &struct {
-				Name string `json:"name"`
-			}{Name: mc.params.resolution}
-		}
-		if mc.params.comment != "" {
-			trUpdateReq.Comment = []struct {
-				Add struct {
-					Body string `json:"body"`
-				} `json:"add"`
-			}{
-				{Add: struct {
-					Body string `json:"body"`
-				}{Body: mc.params.comment}},
-			}
+		req := mc.params.transitionRequest()
+		req.Transition = &jira.TransitionRequestData{
+			ID:   tr.ID.String(),
+			Name: tr.Name,
 		}
 
-		_, err := client.Transition(mc.params.key, &jira.TransitionRequest{
-			Fields: &trFieldsReq,
-			Update: &trUpdateReq,
-			Transition: &jira.TransitionRequestData{
-				ID:   tr.ID.String(),
-				Name: tr.Name,
-			},
-		})
+		_, err := client.Transition(mc.params.key, req)
 		return err
 	}()
 	if err != nil {
 		return err
 	}
 
+	return moveDone(cmd, mc.params.key, tr.Name)
+}
+
+func moveDone(cmd *cobra.Command, key, state string) error {
 	server := viper.GetString("server")
 
-	cmdutil.Success("Issue transitioned to state %q", tr.Name)
-	fmt.Printf("%s\n", cmdutil.GenerateServerBrowseURL(server, mc.params.key))
+	cmdutil.Success("Issue transitioned to state %q", state)
+	fmt.Printf("%s\n", cmdutil.GenerateServerBrowseURL(server, key))
 
 	if web, _ := cmd.Flags().GetBool("web"); web {
-		if err := cmdutil.Navigate(server, mc.params.key); err != nil {
+		if err := cmdutil.Navigate(server, key); err != nil {
 			return err
 		}
 	}
@@ -257,14 +257,11 @@ type moveParams struct {
 }
 
 func parseArgsAndFlags(flags query.FlagParser, args []string, project string) *moveParams {
-	var key, state string
+	var state string
 
-	nargs := len(args)
-	if nargs >= 1 {
-		key = cmdutil.GetJiraIssueKey(project, args[0])
-	}
-	if nargs >= 2 {
-		state = args[1]
+	key, rest := cmdutil.GetIssueKeyFromArgs(project, args)
+	if len(rest) >= 1 {
+		state = rest[0]
 	}
 
 	comment, err := flags.GetString("comment")
@@ -289,6 +286,43 @@ func parseArgsAndFlags(flags query.FlagParser, args []string, project string) *m
 	}
 }
 
+// transitionRequest builds a transition request with the fields and comment set by flags.
+func (p *moveParams) transitionRequest() *jira.TransitionRequest {
+	return newTransitionRequest(p.assignee, p.resolution, p.comment)
+}
+
+func newTransitionRequest(assignee, resolution, comment string) *jira.TransitionRequest {
+	trFieldsReq := jira.TransitionRequestFields{}
+	trUpdateReq := jira.TransitionRequestUpdate{}
+
+	if assignee != "" {
+		trFieldsReq.Assignee = &struct {
+			Name string `json:"name"`
+		}{Name: assignee}
+	}
+	if resolution != "" {
+		trFieldsReq.Resolution = &struct {
+			Name string `json:"name"`
+		}{Name: resolution}
+	}
+	if comment != "" {
+		trUpdateReq.Comment = []struct {
+			Add struct {
+				Body string `json:"body"`
+			} `json:"add"`
+		}{
+			{Add: struct {
+				Body string `json:"body"`
+			}{Body: comment}},
+		}
+	}
+
+	return &jira.TransitionRequest{
+		Fields: &trFieldsReq,
+		Update: &trUpdateReq,
+	}
+}
+
 type moveCmd struct {
 	client      *jira.Client
 	transitions []*jira.Transition
@@ -362,6 +396,16 @@ func (mc *moveCmd) setAvailableTransitions() error {
 	return nil
 }
 
+// directTransition returns the transition with the desired state name if it is available.
+func (mc *moveCmd) directTransition() *jira.Transition {
+	for _, t := range mc.transitions {
+		if strings.EqualFold(t.Name, mc.params.state) {
+			return t
+		}
+	}
+	return nil
+}
+
 func (mc *moveCmd) verifyTransition(it string) (*jira.Transition, error) {
 	var tr *jira.Transition
 
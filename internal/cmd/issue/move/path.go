@@ -0,0 +1,466 @@
+package move
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+	"github.com/ankitpokhrel/jira-cli/internal/workflow"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	// maxHops stops exploring workflows with loops or unreachable states.
+	maxHops = 20
+
+	graphFile = "transition-paths.json"
+)
+
+// moveThroughPath moves the issue to the desired state through intermediate statuses.
+// It returns false if the issue wasn't moved, eg: if only the path was previewed.
+func moveThroughPath(cmd *cobra.Command, mc *moveCmd, previewOnly bool) (bool, error) {
+	noInput, err := cmd.Flags().GetBool("no-input")
+	if err != nil {
+		return false, err
+	}
+
+	m, err := newPathMover(mc.client, noInput)
+	if err != nil {
+		return false, err
+	}
+
+	s := cmdutil.Info("Finding transition path...")
+	p, err := m.plan(mc.params.key, mc.params.state)
+	s.Stop()
+	if err != nil {
+		return false, err
+	}
+
+	p.render(os.Stdout, mc.params.state)
+	if previewOnly || p.done() {
+		return false, m.graphs.Save(m.graphFile)
+	}
+
+	if !noInput && p.needsConfirmation() {
+		ok, err := confirm(fmt.Sprintf("Move %s to %q?", p.key, mc.params.state))
+		if err != nil {
+			return false, err
+		}
+		if !ok {
+			cmdutil.Fail("Action aborted")
+			return false, fmt.Errorf("action aborted")
+		}
+	}
+
+	if err := m.execute(p, mc.params.state, mc.params.transitionRequest()); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func confirm(msg string) (bool, error) {
+	var ok bool
+	err := survey.AskOne(&survey.Confirm{Message: msg, Default: true}, &ok)
+	return ok, err
+}
+
+// pathMover moves issues through intermediate statuses when
+// the desired state isn't directly reachable from the current one.
+type pathMover struct {
+	client    *jira.Client
+	graphs    workflow.Graphs
+	graphFile string
+	noInput   bool
+	// statuses holds statuses of projects keyed by project key.
+	statuses map[string][]*jira.IssueTypeStatuses
+	// answers holds values of required screen fields keyed by transition and field
+	// so that they are asked only once when moving multiple issues.
+	answers map[string]any
+}
+
+// movePlan is the path an issue is expected to take to reach the desired state.
+type movePlan struct {
+	key    string
+	scope  string
+	status string
+	hops   []workflow.Hop
+	// known is false if the path is incomplete and the rest has to be explored.
+	known bool
+}
+
+func newPathMover(client *jira.Client, noInput bool) (*pathMover, error) {
+	file, err := jiraConfig.StatePath(graphFile)
+	if err != nil {
+		return nil, err
+	}
+
+	graphs, err := workflow.LoadGraphs(file)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pathMover{
+		client:    client,
+		graphs:    graphs,
+		graphFile: file,
+		noInput:   noInput,
+		statuses:  make(map[string][]*jira.IssueTypeStatuses),
+		answers:   make(map[string]any),
+	}, nil
+}
+
+// plan finds the shortest known path from the current status of the issue to the target.
+func (m *pathMover) plan(key, target string) (*movePlan, error) {
+	iss, err := api.ProxyGetIssue(m.client, key)
+	if err != nil {
+		return nil, err
+	}
+	transitions, err := api.ProxyTransitionsWithFields(m.client, key)
+	if err != nil {
+		return nil, err
+	}
+
+	project, _, _ := strings.Cut(key, "-")
+	p := movePlan{
+		key:    key,
+		scope:  workflow.GraphScope(project, iss.Fields.IssueType.Name),
+		status: iss.Fields.Status.Name,
+	}
+
+	g := m.graphs.Get(p.scope)
+	g.Learn(p.status, transitions)
+	p.hops, p.known = g.Path(p.status, target)
+
+	if !p.known {
+		if err := m.checkTarget(p.key, iss.Fields.IssueType.Name, target, transitions); err != nil {
+			return nil, err
+		}
+		// Start with the best guess so that the preview shows the first step.
+		if tr, ok := workflow.Explore(available(transitions), map[string]bool{strings.ToLower(p.status): true}); ok {
+			p.hops = []workflow.Hop{{Transition: tr.Name, From: p.status, To: tr.To.Name}}
+		}
+	}
+	return &p, nil
+}
+
+// checkTarget makes sure that the target is a transition or a status of the project
+// so that a mistyped target doesn't start exploring the workflow.
+func (m *pathMover) checkTarget(key, issueType, target string, transitions []*jira.Transition) error {
+	for _, t := range transitions {
+		if workflow.Matches(t, target) {
+			return nil
+		}
+	}
+
+	project, _, _ := strings.Cut(key, "-")
+	types, ok := m.statuses[project]
+	if !ok {
+		var err error
+		if types, err = m.client.ProjectStatuses(project); err != nil {
+			return err
+		}
+		m.statuses[project] = types
+	}
+
+	var (
+		all  []string
+		seen = make(map[string]bool)
+	)
+	add := func(name string) {
+		if k := strings.ToLower(name); !seen[k] {
+			seen[k] = true
+			all = append(all, fmt.Sprintf("'%s'", name))
+		}
+	}
+	for _, t := range transitions {
+		add(t.Name)
+	}
+	// Statuses of all issue types are checked if the issue type isn't listed.
+	known := slices.ContainsFunc(types, func(it *jira.IssueTypeStatuses) bool {
+		return strings.EqualFold(it.Name, issueType)
+	})
+	for _, it := range types {
+		if known && !strings.EqualFold(it.Name, issueType) {
+			continue
+		}
+		for _, st := range it.Statuses {
+			if strings.EqualFold(st.Name, target) {
+				return nil
+			}
+			add(st.Name)
+		}
+	}
+
+	return fmt.Errorf(
+		"invalid transition state %q\nAvailable states for issue %s: %s",
+		target, key, strings.Join(all, ", "),
+	)
+}
+
+// done checks if the issue is already in the target status.
+func (p *movePlan) done() bool {
+	return p.known && len(p.hops) == 0
+}
+
+// needsConfirmation checks if the plan moves the issue through more than one status.
+func (p *movePlan) needsConfirmation() bool {
+	return !p.known || len(p.hops) > 1
+}
+
+func (p *movePlan) render(w io.Writer, target string) {
+	tw := tabwriter.NewWriter(w, 0, 8, 1, ' ', 0)
+	defer func() { _ = tw.Flush() }()
+
+	_, _ = fmt.Fprintf(tw, "%s (%s)\n", p.key, p.status)
+	if p.done() {
+		_, _ = fmt.Fprintf(tw, "  Already in %q\n", target)
+		return
+	}
+	for i, h := range p.hops {
+		_, _ = fmt.Fprintf(tw, "  %d.\t%s\t→ %s\n", i+1, h.Transition, h.To)
+	}
+	if !p.known {
+		_, _ = fmt.Fprintf(tw, "  ?.\tExplore transitions until %q is reached\n", target)
+	}
+}
+
+// execute moves the issue to the target one transition at a time. Transitions are fetched
+// after every hop so that the path is adjusted if the workflow differs from what was learned.
+// Fields and updates of final are applied to the last transition only.
+func (m *pathMover) execute(p *movePlan, target string, final *jira.TransitionRequest) error {
+	defer func() {
+		if err := m.graphs.Save(m.graphFile); err != nil {
+			cmdutil.Warn("Unable to save learned transitions: %s", err)
+		}
+	}()
+
+	if !p.known && m.noInput {
+		return fmt.Errorf(
+			"transition path from %q to %q for issue %s is not known yet, run without --no-input to explore it",
+			p.status, target, p.key,
+		)
+	}
+
+	g := m.graphs.Get(p.scope)
+	status := p.status
+	visited := map[string]bool{strings.ToLower(status): true}
+
+	for range maxHops {
+		transitions, err := api.ProxyTransitionsWithFields(m.client, p.key)
+		if err != nil {
+			return err
+		}
+		g.Learn(status, transitions)
+
+		tr, explore := nextTransition(g, available(transitions), status, target, visited)
+		if tr == nil {
+			return fmt.Errorf("unable to find a transition path from %q to %q for issue %s", status, target, p.key)
+		}
+		if explore {
+			if err := m.confirmExplore(p.key, status, tr); err != nil {
+				return err
+			}
+		}
+
+		last := workflow.Matches(tr, target)
+
+		req := jira.TransitionRequest{Fields: &jira.TransitionRequestFields{}}
+		if last {
+			fields := *final.Fields
+			req.Fields, req.Update = &fields, final.Update
+		}
+		req.Transition = &jira.TransitionRequestData{ID: tr.ID.String(), Name: tr.Name}
+
+		if req.Fields.Extra, err = m.requiredFields(tr, req.Fields); err != nil {
+			return err
+		}
+
+		s := cmdutil.Info(fmt.Sprintf("Transitioning %s using %q...", p.key, tr.Name))
+		_, err = m.client.Transition(p.key, &req)
+		s.Stop()
+		if err != nil {
+			return err
+		}
+		if last {
+			return nil
+		}
+
+		if tr.To != nil {
+			status = tr.To.Name
+		} else {
+			iss, err := api.ProxyGetIssue(m.client, p.key)
+			if err != nil {
+				return err
+			}
+			status = iss.Fields.Status.Name
+		}
+		visited[strings.ToLower(status)] = true
+	}
+	return fmt.Errorf("unable to reach %q for issue %s after %d transitions", target, p.key, maxHops)
+}
+
+// confirmExplore asks before applying a transition that is only a guess.
+func (m *pathMover) confirmExplore(key, status string, tr *jira.Transition) error {
+	if m.noInput {
+		return fmt.Errorf(
+			"no known path from %q for issue %s, run without --no-input to explore it",
+			status, key,
+		)
+	}
+
+	ok, err := confirm(fmt.Sprintf("Explore %s using %q (%s → %s)?", key, tr.Name, status, tr.To.Name))
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("action aborted")
+	}
+	return nil
+}
+
+// nextTransition picks the transition to apply: a direct transition to the target,
+// the first hop of the shortest known path or a transition to an unexplored status.
+// It reports whether the transition is picked by exploring.
+func nextTransition(g workflow.Graph, transitions []*jira.Transition, status, target string, visited map[string]bool) (*jira.Transition, bool) {
+	for _, t := range transitions {
+		if strings.EqualFold(t.Name, target) {
+			return t, false
+		}
+	}
+	for _, t := range transitions {
+		if workflow.Matches(t, target) {
+			return t, false
+		}
+	}
+
+	if hops, ok := g.Path(status, target); ok && len(hops) > 0 {
+		for _, t := range transitions {
+			if strings.EqualFold(t.Name, hops[0].Transition) {
+				return t, false
+			}
+		}
+	}
+
+	if t, ok := workflow.Explore(transitions, visited); ok {
+		return t, true
+	}
+	return nil, false
+}
+
+// requiredFields returns values of required transition screen fields that are not set yet.
+func (m *pathMover) requiredFields(tr *jira.Transition, set *jira.TransitionRequestFields) (map[string]any, error) {
+	ids := make([]string, 0, len(tr.Fields))
+	for id, f := range tr.Fields {
+		if !f.Required || f.HasDefaultValue {
+			continue
+		}
+		if (id == "resolution" && set.Resolution != nil) || (id == "assignee" && set.Assignee != nil) {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	sort.Strings(ids)
+
+	out := make(map[string]any, len(ids))
+	for _, id := range ids {
+		k := tr.Name + "/" + id
+		if v, ok := m.answers[k]; ok {
+			out[id] = v
+			continue
+		}
+		if m.noInput {
+			return nil, fmt.Errorf("transition %q requires field %q, run without --no-input to be prompted", tr.Name, tr.Fields[id].Name)
+		}
+
+		v, err := promptField(tr.Name, tr.Fields[id])
+		if err != nil {
+			return nil, err
+		}
+		m.answers[k] = v
+		out[id] = v
+	}
+	return out, nil
+}
+
+func promptField(transition string, f jira.TransitionField) (any, error) {
+	msg := fmt.Sprintf("%s (required by %q):", f.Name, transition)
+
+	if len(f.AllowedValues) > 0 {
+		options := make([]string, 0, len(f.AllowedValues))
+		for _, v := range f.AllowedValues {
+			options = append(options, allowedValueLabel(v))
+		}
+
+		var idx int
+		if err := survey.AskOne(&survey.Select{Message: msg, Options: options}, &idx); err != nil {
+			return nil, err
+		}
+
+		v := map[string]string{"id": f.AllowedValues[idx].ID}
+		if f.Schema.Type == "array" {
+			return []map[string]string{v}, nil
+		}
+		return v, nil
+	}
+
+	var ans string
+	if err := survey.AskOne(&survey.Input{Message: msg}, &ans, survey.WithValidator(survey.Required)); err != nil {
+		return nil, err
+	}
+
+	switch f.Schema.Type {
+	case "number":
+		return strconv.ParseFloat(ans, 64)
+	case "array":
+		values := strings.Split(ans, ",")
+		for i, v := range values {
+			values[i] = strings.TrimSpace(v)
+		}
+		return values, nil
+	case "user":
+		if viper.GetString("installation") == jira.InstallationTypeLocal {
+			return map[string]string{"name": ans}, nil
+		}
+		return map[string]string{"accountId": ans}, nil
+	}
+	return ans, nil
+}
+
+func allowedValueLabel(v jira.TransitionFieldValue) string {
+	switch {
+	case v.Name != "":
+		return v.Name
+	case v.Value != "":
+		return v.Value
+	}
+	return v.ID
+}
+
+// available filters out transitions that the cloud server reports as unavailable.
+func available(transitions []*jira.Transition) []*jira.Transition {
+	if viper.GetString("installation") != jira.InstallationTypeCloud {
+		return transitions
+	}
+	out := make([]*jira.Transition, 0, len(transitions))
+	for _, t := range transitions {
+		if t.IsAvailable {
+			out = append(out, t)
+		}
+	}
+	return out
+}
@@ -71,34 +71,10 @@ func storyPoints(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to get configured custom fields: %w", err)
 	}
 
-	// Find story points field
-	var storyPointsField *jira.IssueTypeField
-	if fieldName != "" {
-		// Use provided field name
-		for _, f := range configuredFields {
-			if strings.EqualFold(f.Name, fieldName) {
-				storyPointsField = &f
-				break
-			}
-		}
-		if storyPointsField == nil {
-			return fmt.Errorf("custom field %q not found in configuration", fieldName)
-		}
-	} else {
-		// Try to find story points field automatically
-		storyPointsKeywords := []string{"story point", "storypoint", "story-point"}
-		for _, f := range configuredFields {
-			nameLower := strings.ToLower(f.Name)
-			for _, keyword := range storyPointsKeywords {
-				if strings.Contains(nameLower, keyword) {
-					storyPointsField = &f
-					break
-				}
-			}
-			if storyPointsField != nil {
-				break
-			}
-		}
+	// Find story points field, either the given one or automatically
+	storyPointsField := cmdcommon.FindStoryPointsField(configuredFields, fieldName)
+	if storyPointsField == nil && fieldName != "" {
+		return fmt.Errorf("custom field %q not found in configuration", fieldName)
 	}
 
 	if storyPointsField == nil {
@@ -2,6 +2,7 @@ package unlink
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/spf13/cobra"
@@ -15,7 +16,19 @@ import (
 
 const (
 	helpText = `Unlink disconnects two issues from each other, if already connected.`
-	examples = `$ jira issue unlink ISSUE-1 ISSUE-2`
+	examples = `$ jira issue unlink ISSUE-1 ISSUE-2
+
+# Remove only the "Blocks" link between two issues
+$ jira issue unlink ISSUE-1 ISSUE-2 --type Blocks
+
+# Remove all links of an issue
+$ jira issue unlink ISSUE-1 --all
+
+# Remove all links of an issue without confirmation
+$ jira issue unlink ISSUE-1 --all --no-input
+
+# Remove all "Relates" links of an issue
+$ jira issue unlink ISSUE-1 --all --type Relates`
 )
 
 // NewCmdUnlink is an unlink command.
@@ -28,12 +41,15 @@ func NewCmdUnlink() *cobra.Command {
 		Aliases: []string{"uln"},
 		Annotations: map[string]string{
 			"help:args": "INWARD_ISSUE_KEY\tIssue key of the source issue, eg: ISSUE-1\n" +
-				"OUTWARD_ISSUE_KEY\tIssue key of the target issue, eg: ISSUE-2.",
+				"OUTWARD_ISSUE_KEY\tIssue key of the target issue, eg: ISSUE-2. Not needed with --all",
 		},
 		Run: unlink,
 	}
 
 	cmd.Flags().Bool("web", false, "Open inward issue in web browser after successful unlinking")
+	cmd.Flags().Bool("all", false, "Remove all links of the inward issue")
+	cmd.Flags().String("type", "", "Remove only links of the given type, eg: Blocks")
+	cmd.Flags().Bool("no-input", false, "Don't ask for confirmation before removing all links")
 
 	return &cmd
 }
@@ -48,24 +64,68 @@ func unlink(cmd *cobra.Command, args []string) {
 	}
 
 	cmdutil.ExitIfError(uc.setInwardIssueKey(project))
-	cmdutil.ExitIfError(uc.setOutwardIssueKey(project))
+	if !uc.params.all {
+		cmdutil.ExitIfError(uc.setOutwardIssueKey(project))
+	}
 
-	err := func() error {
-		s := cmdutil.Info("Unlinking issues")
-		defer s.Stop()
+	var count int
 
-		linkID, err := client.GetLinkID(uc.params.inwardIssueKey, uc.params.outwardIssueKey)
-		if err != nil {
-			return err
+	if !uc.params.all && uc.params.linkType == "" {
+		err := func() error {
+			s := cmdutil.Info("Unlinking issues")
+			defer s.Stop()
+
+			linkID, err := client.GetLinkID(uc.params.inwardIssueKey, uc.params.outwardIssueKey)
+			if err != nil {
+				return err
+			}
+			count = 1
+			return client.UnlinkIssue(linkID)
+		}()
+		cmdutil.ExitIfError(err)
+	} else {
+		links, err := func() ([]*jira.IssueLink, error) {
+			s := cmdutil.Info("Fetching issue links")
+			defer s.Stop()
+
+			return uc.matchingLinks()
+		}()
+		cmdutil.ExitIfError(err)
+
+		if len(links) == 0 {
+			cmdutil.Failed("Error: no matching links found")
+		}
+		if uc.params.all && !uc.params.noInput {
+			ok, err := uc.confirm(links)
+			cmdutil.ExitIfError(err)
+			if !ok {
+				cmdutil.Fail("Action aborted")
+				return
+			}
 		}
 
-		return client.UnlinkIssue(linkID)
-	}()
-	cmdutil.ExitIfError(err)
+		err = func() error {
+			s := cmdutil.Info("Unlinking issues")
+			defer s.Stop()
+
+			for _, l := range links {
+				if err := client.UnlinkIssue(l.ID); err != nil {
+					return fmt.Errorf("removed %d of %d links: %w", count, len(links), err)
+				}
+				count++
+			}
+			return nil
+		}()
+		cmdutil.ExitIfError(err)
+	}
 
 	server := viper.GetString("server")
 
-	cmdutil.Success("Issues unlinked")
+	if count > 1 {
+		cmdutil.Success("Removed %d links", count)
+	} else {
+		cmdutil.Success("Issues unlinked")
+	}
 	fmt.Printf("%s\n", cmdutil.GenerateServerBrowseURL(server, uc.params.inwardIssueKey))
 
 	if web, _ := cmd.Flags().GetBool("web"); web {
@@ -77,6 +137,9 @@ func unlink(cmd *cobra.Command, args []string) {
 type unlinkParams struct {
 	inwardIssueKey  string
 	outwardIssueKey string
+	linkType        string
+	all             bool
+	noInput         bool
 	debug           bool
 }
 
@@ -91,12 +154,24 @@ func parseArgsAndFlags(flags query.FlagParser, args []string, project string) *u
 		outwardIssueKey = cmdutil.GetJiraIssueKey(project, args[1])
 	}
 
+	all, err := flags.GetBool("all")
+	cmdutil.ExitIfError(err)
+
+	linkType, err := flags.GetString("type")
+	cmdutil.ExitIfError(err)
+
+	noInput, err := flags.GetBool("no-input")
+	cmdutil.ExitIfError(err)
+
 	debug, err := flags.GetBool("debug")
 	cmdutil.ExitIfError(err)
 
 	return &unlinkParams{
 		inwardIssueKey:  inwardIssueKey,
 		outwardIssueKey: outwardIssueKey,
+		linkType:        linkType,
+		all:             all,
+		noInput:         noInput,
 		debug:           debug,
 	}
 }
@@ -145,3 +220,42 @@ func (uc *unlinkCmd) setOutwardIssueKey(project string) error {
 
 	return nil
 }
+
+// matchingLinks returns links of the inward issue that
+// match the outward issue and the link type, if given.
+func (uc *unlinkCmd) matchingLinks() ([]*jira.IssueLink, error) {
+	links, err := uc.client.GetIssueLinks(uc.params.inwardIssueKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []*jira.IssueLink
+	for _, l := range links {
+		if uc.params.linkType != "" && !strings.EqualFold(l.Type.Name, uc.params.linkType) {
+			continue
+		}
+		if !uc.params.all {
+			if iss, _ := l.Linked(); iss == nil || iss.Key != uc.params.outwardIssueKey {
+				continue
+			}
+		}
+		out = append(out, l)
+	}
+	return out, nil
+}
+
+// confirm lists the links to be removed and asks the user to proceed.
+func (uc *unlinkCmd) confirm(links []*jira.IssueLink) (bool, error) {
+	fmt.Printf("Links of %s to be removed:\n", uc.params.inwardIssueKey)
+	for _, l := range links {
+		iss, rel := l.Linked()
+		if iss == nil {
+			continue
+		}
+		fmt.Printf("  %s %s %s\n", rel, iss.Key, iss.Fields.Summary)
+	}
+
+	var ok bool
+	err := survey.AskOne(&survey.Confirm{Message: fmt.Sprintf("Remove %d links?", len(links))}, &ok)
+	return ok, err
+}
@@ -3,21 +3,29 @@ package view
 import (
 	"encoding/json"
 	"fmt"
+	"os"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
 	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	tuiView "github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/internal/watch"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira/filter/issue"
+	"github.com/ankitpokhrel/jira-cli/pkg/tui"
 )
 
 const (
 	helpText = `View displays contents of an issue.`
 	examples = `$ jira issue view ISSUE-1
 
+# View the issue referenced in the current git branch name
+$ jira issue view
+
 # Show 5 recent comments when viewing the issue
 $ jira issue view ISSUE-1 --comments 5
 
@@ -25,13 +33,20 @@ $ jira issue view ISSUE-1 --comments 5
 $ jira issue view ISSUE-1 --output json
 
 # Extract sprint IDs from an issue
-$ jira issue view ISSUE-1 --sprint-ids`
+$ jira issue view ISSUE-1 --sprint-ids
+
+# Show SLA timers of a service management request
+$ jira issue view ITH-1 --sla
+
+# Refresh the issue every 10 seconds and highlight changed fields
+$ jira issue view ISSUE-1 --watch=10s`
 
 	flagOutput    = "output"
 	flagDebug     = "debug"
 	flagComments  = "comments"
 	flagPlain     = "plain"
 	flagSprintIDs = "sprint-ids"
+	flagSLA       = "sla"
 
 	configProject = "project.key"
 	configServer  = "server"
@@ -42,15 +57,15 @@ $ jira issue view ISSUE-1 --sprint-ids`
 // NewCmdView is a view command.
 func NewCmdView() *cobra.Command {
 	cmd := cobra.Command{
-		Use:     "view ISSUE-KEY",
+		Use:     "view [ISSUE-KEY]",
 		Short:   "View displays contents of an issue",
 		Long:    helpText,
 		Example: examples,
 		Aliases: []string{"show"},
 		Annotations: map[string]string{
-			"help:args": "ISSUE-KEY\tIssue key, eg: ISSUE-1",
+			"help:args": "[ISSUE-KEY]\tIssue key, eg: ISSUE-1 (defaults to the key in the current git branch name)",
 		},
-		Args: cobra.MinimumNArgs(1),
+		Args: cobra.MaximumNArgs(1),
 		RunE: view,
 	}
 
@@ -58,6 +73,9 @@ func NewCmdView() *cobra.Command {
 	cmd.Flags().Bool(flagPlain, false, "Display output in plain mode")
 	cmd.Flags().String(flagOutput, "", "Output format: json (default: formatted)")
 	cmd.Flags().Bool(flagSprintIDs, false, "Extract and display sprint IDs only")
+	cmd.Flags().Bool(flagSLA, false, "Show SLA timers of a service management request")
+
+	cmdcommon.SetWatchFlags(&cmd)
 
 	return &cmd
 }
@@ -89,7 +107,10 @@ func viewRaw(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	key := cmdutil.GetJiraIssueKey(viper.GetString(configProject), args[0])
+	key, err := issueKey(args)
+	if err != nil {
+		return err
+	}
 
 	apiResp, err := func() (string, error) {
 		s := cmdutil.Info(messageFetchingData)
@@ -112,7 +133,10 @@ func viewSprintIDs(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	key := cmdutil.GetJiraIssueKey(viper.GetString(configProject), args[0])
+	key, err := issueKey(args)
+	if err != nil {
+		return err
+	}
 
 	apiResp, err := func() (string, error) {
 		s := cmdutil.Info(messageFetchingData)
@@ -194,13 +218,36 @@ func viewPretty(cmd *cobra.Command, args []string) error {
 		comments = max(numComments, 1)
 	}
 
-	key := cmdutil.GetJiraIssueKey(viper.GetString(configProject), args[0])
-	iss, err := func() (*jira.Issue, error) {
+	withSLA, err := cmd.Flags().GetBool(flagSLA)
+	if err != nil {
+		return err
+	}
+
+	key, err := issueKey(args)
+	if err != nil {
+		return err
+	}
+
+	w, err := cmdcommon.GetWatch(cmd)
+	if err != nil {
+		return err
+	}
+
+	client := api.DefaultClient(debug)
+	fetch := func() (*jira.Issue, []*jira.SLA, error) {
+		iss, err := api.ProxyGetIssue(client, key, issue.NewNumCommentsFilter(comments))
+		if err != nil || !withSLA {
+			return iss, nil, err
+		}
+		slas, err := client.RequestSLA(key)
+		return iss, slas, err
+	}
+
+	iss, slas, err := func() (*jira.Issue, []*jira.SLA, error) {
 		s := cmdutil.Info(messageFetchingData)
 		defer s.Stop()
 
-		client := api.DefaultClient(debug)
-		return api.ProxyGetIssue(client, key, issue.NewNumCommentsFilter(comments))
+		return fetch()
 	}()
 	if err != nil {
 		return err
@@ -216,6 +263,72 @@ func viewPretty(cmd *cobra.Command, args []string) error {
 		Data:    iss,
 		Display: tuiView.DisplayFormat{Plain: plain},
 		Options: tuiView.IssueOption{NumComments: comments},
+		SLAs:    slas,
+	}
+	if w.Enabled() {
+		return watchIssue(&v, w, fetch)
 	}
 	return v.Render()
 }
+
+// watchIssue re-renders the issue whenever it is updated until the watch context
+// is done. In plain mode, or if the output is not a terminal, the issue is printed
+// once followed by its changes.
+func watchIssue(v *tuiView.Issue, w cmdcommon.Watch, fetch func() (*jira.Issue, []*jira.SLA, error)) error {
+	key := v.Data.Key
+	plain := v.Display.Plain || tui.IsDumbTerminal() || tui.IsNotTTY()
+
+	status := func(msg string) string {
+		return fmt.Sprintf("Watching %s every %s • %s", key, w.Interval, msg)
+	}
+
+	if plain {
+		if err := v.Render(); err != nil {
+			return err
+		}
+	} else if err := v.RenderWatch(os.Stdout, status("Press CTRL+C to quit"), nil); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(w.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.Context.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		iss, slas, err := fetch()
+		if err != nil {
+			cmdutil.Warn("%s Unable to fetch %s: %s", time.Now().Format(time.TimeOnly), key, err)
+			continue
+		}
+		if iss.Fields.Updated == v.Data.Fields.Updated {
+			continue
+		}
+
+		change := watch.Change{Kind: watch.Updated, Issue: iss, Fields: watch.Diff(v.Data, iss)}
+		go func() { _ = w.Notifier.NotifyChanges(key, []watch.Change{change}) }()
+
+		v.Data, v.SLAs = iss, slas
+
+		if plain {
+			fmt.Printf("%s %s\n", time.Now().Format(time.TimeOnly), change)
+			continue
+		}
+		msg := "Updated at " + time.Now().Format(time.Kitchen)
+		if err := v.RenderWatch(os.Stdout, status(msg), change.Fields); err != nil {
+			return err
+		}
+	}
+}
+
+func issueKey(args []string) (string, error) {
+	key, _ := cmdutil.GetIssueKeyFromArgs(viper.GetString(configProject), args)
+	if key == "" {
+		return "", fmt.Errorf("issue key is required: unable to detect it from the current git branch")
+	}
+	return key, nil
+}
@@ -34,6 +34,9 @@ $ jira issue worklog add ISSUE-1 3h --started "2022-01-01 09:30:00" --timezone "
 # Or, you can use start date in Jira datetime format and skip the timezone flag
 $ jira issue worklog add ISSUE-1 "1h 30m" --started "2022-01-01T09:30:00.000+0200"
 
+# Log time to the issue referenced in the current git branch name
+$ jira issue worklog add 2h --no-input
+
 # Or, you can update a worklogs remaining estimate
 $ jira issue worklog add ISSUE-1 "1h 30m" --started "2022-01-01T09:30:00.000+0200" --new-estimate 0h`
 )
@@ -123,14 +126,11 @@ type addParams struct {
 }
 
 func parseArgsAndFlags(args []string, flags query.FlagParser) *addParams {
-	var issueKey, timeSpent string
+	var timeSpent string
 
-	nargs := len(args)
-	if nargs >= 1 {
-		issueKey = cmdutil.GetJiraIssueKey(viper.GetString("project.key"), args[0])
-	}
-	if nargs >= 2 {
-		timeSpent = args[1]
+	issueKey, rest := cmdutil.GetIssueKeyFromArgs(viper.GetString("project.key"), args)
+	if len(rest) >= 1 {
+		timeSpent = rest[0]
 	}
 
 	debug, err := flags.GetBool("debug")
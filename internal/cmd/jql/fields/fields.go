@@ -0,0 +1,85 @@
+package fields
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const examples = `$ jira jql fields
+
+# Find the JQL name of a custom field
+$ jira jql fields "story points"
+
+# Only list fields that can be used in ORDER BY
+$ jira jql fields --orderable --plain`
+
+// NewCmdFields is a fields command.
+func NewCmdFields() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "fields [SEARCH]",
+		Short:   "Fields lists fields that can be used in JQL",
+		Long:    "Fields lists fields that can be used in JQL along with operators they support.",
+		Example: examples,
+		Annotations: map[string]string{
+			"help:args": "[SEARCH]\tOnly list fields whose name contains the text",
+		},
+		Args: cobra.MaximumNArgs(1),
+		Run:  Fields,
+	}
+
+	cmd.Flags().Bool("orderable", false, "Only list fields that can be used to order results")
+	cmd.Flags().Bool("plain", false, "Display output in plain mode")
+	cmd.Flags().String("output", "", "Output format: json (default: table)")
+
+	return &cmd
+}
+
+// Fields lists JQL fields.
+func Fields(cmd *cobra.Command, args []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	orderable, _ := cmd.Flags().GetBool("orderable")
+	plain, _ := cmd.Flags().GetBool("plain")
+	output, _ := cmd.Flags().GetString("output")
+
+	if output != "" && output != "json" {
+		cmdutil.Failed("Invalid output format %q, valid format is: json", output)
+	}
+
+	data, err := func() (*jira.JQLAutocompleteData, error) {
+		s := cmdutil.Info("Fetching JQL fields...")
+		defer s.Stop()
+
+		return api.DefaultClient(debug).JQLAutocompleteData()
+	}()
+	cmdutil.ExitIfError(err)
+
+	search := ""
+	if len(args) > 0 {
+		search = strings.ToLower(args[0])
+	}
+
+	out := make([]*jira.JQLField, 0, len(data.Fields))
+	for _, f := range data.Fields {
+		if orderable && f.Orderable != "true" {
+			continue
+		}
+		if !strings.Contains(strings.ToLower(f.Value), search) && !strings.Contains(strings.ToLower(f.DisplayName), search) {
+			continue
+		}
+		out = append(out, f)
+	}
+	if len(out) == 0 {
+		cmdutil.Failed("No fields found")
+	}
+
+	v := view.NewJQLFields(out, view.WithJQLPlain(plain), view.WithJQLJSON(output == "json"))
+	cmdutil.ExitIfError(v.Render())
+}
@@ -0,0 +1,72 @@
+package format
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jql"
+)
+
+const (
+	helpText = `Fmt pretty-prints a JQL query.
+
+Keywords are upper-cased, each clause joined with AND or OR is put on its own line
+and groups of clauses in parentheses are indented. The query is read from the
+standard input if it is not given as an argument.`
+
+	examples = `$ jira jql fmt "project = ABC and (assignee = currentUser() or reporter = currentUser()) order by created desc"
+
+# Normalize a query in a single line
+$ jira jql fmt --oneline < query.jql`
+)
+
+// NewCmdFormat is a fmt command.
+func NewCmdFormat() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "fmt [JQL]",
+		Short:   "Fmt pretty-prints a JQL query",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"format"},
+		Annotations: map[string]string{
+			"help:args": "[JQL]\tQuery to format, read from the standard input if omitted",
+		},
+		Run: Format,
+	}
+
+	cmd.Flags().Bool("oneline", false, "Format the query in a single line")
+
+	return &cmd
+}
+
+// Format formats a JQL query.
+func Format(cmd *cobra.Command, args []string) {
+	oneline, _ := cmd.Flags().GetBool("oneline")
+
+	q := strings.Join(args, " ")
+	if q == "" {
+		b, err := cmdutil.ReadFile("")
+		cmdutil.ExitIfError(err)
+		q = string(b)
+	}
+	if strings.TrimSpace(q) == "" {
+		cmdutil.Failed("JQL can't be empty")
+	}
+
+	var (
+		out string
+		err error
+	)
+	if oneline {
+		out, err = jql.Compact(q)
+	} else {
+		out, err = jql.Format(q)
+	}
+	if err != nil {
+		cmdutil.Failed("Invalid JQL: %s", err)
+	}
+	fmt.Println(out)
+}
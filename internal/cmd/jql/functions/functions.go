@@ -0,0 +1,77 @@
+package functions
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const examples = `$ jira jql functions
+
+# Find functions related to sprints
+$ jira jql functions sprint`
+
+// NewCmdFunctions is a functions command.
+func NewCmdFunctions() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "functions [SEARCH]",
+		Short:   "Functions lists functions that can be used in JQL",
+		Long:    "Functions lists functions that can be used in JQL along with types of values they return.",
+		Example: examples,
+		Aliases: []string{"funcs"},
+		Annotations: map[string]string{
+			"help:args": "[SEARCH]\tOnly list functions whose name contains the text",
+		},
+		Args: cobra.MaximumNArgs(1),
+		Run:  Functions,
+	}
+
+	cmd.Flags().Bool("plain", false, "Display output in plain mode")
+	cmd.Flags().String("output", "", "Output format: json (default: table)")
+
+	return &cmd
+}
+
+// Functions lists JQL functions.
+func Functions(cmd *cobra.Command, args []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	plain, _ := cmd.Flags().GetBool("plain")
+	output, _ := cmd.Flags().GetString("output")
+
+	if output != "" && output != "json" {
+		cmdutil.Failed("Invalid output format %q, valid format is: json", output)
+	}
+
+	data, err := func() (*jira.JQLAutocompleteData, error) {
+		s := cmdutil.Info("Fetching JQL functions...")
+		defer s.Stop()
+
+		return api.DefaultClient(debug).JQLAutocompleteData()
+	}()
+	cmdutil.ExitIfError(err)
+
+	search := ""
+	if len(args) > 0 {
+		search = strings.ToLower(args[0])
+	}
+
+	out := make([]*jira.JQLFunction, 0, len(data.Functions))
+	for _, f := range data.Functions {
+		if strings.Contains(strings.ToLower(f.Value), search) {
+			out = append(out, f)
+		}
+	}
+	if len(out) == 0 {
+		cmdutil.Failed("No functions found")
+	}
+
+	v := view.NewJQLFunctions(out, view.WithJQLPlain(plain), view.WithJQLJSON(output == "json"))
+	cmdutil.ExitIfError(v.Render())
+}
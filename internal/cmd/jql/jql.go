@@ -0,0 +1,39 @@
+package jql
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/jql/fields"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/jql/format"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/jql/functions"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/jql/validate"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/jql/values"
+)
+
+const helpText = `Jql validates and formats JQL queries and lists fields, functions and values
+that can be used in them. See available commands below.`
+
+// NewCmdJQL is a jql command.
+func NewCmdJQL() *cobra.Command {
+	cmd := cobra.Command{
+		Use:         "jql",
+		Short:       "Jql validates, formats and autocompletes JQL queries",
+		Long:        helpText,
+		Annotations: map[string]string{"cmd:main": "true"},
+		RunE:        jql,
+	}
+
+	cmd.AddCommand(
+		validate.NewCmdValidate(),
+		format.NewCmdFormat(),
+		fields.NewCmdFields(),
+		functions.NewCmdFunctions(),
+		values.NewCmdValues(),
+	)
+
+	return &cmd
+}
+
+func jql(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
@@ -0,0 +1,124 @@
+package validate
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Validate checks JQL queries against Jira and explains what is wrong with them.
+
+Each argument is validated as a separate query. The query is read from the standard
+input if no arguments are given. The command exits with a non-zero status if any of
+the queries is invalid.`
+
+	examples = `$ jira jql validate "project = ABC AND status = 'In Progress'"
+
+# Validate multiple queries
+$ jira jql validate "assignee = currentUser()" "type = Bug ORDER BY priority"
+
+# Validate a query from a file
+$ jira jql validate < query.jql`
+)
+
+// NewCmdValidate is a validate command.
+func NewCmdValidate() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "validate [JQL...]",
+		Short:   "Validate checks JQL queries against Jira",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"check", "lint"},
+		Annotations: map[string]string{
+			"help:args": "[JQL]\tQueries to validate, read from the standard input if omitted",
+		},
+		Run: Validate,
+	}
+
+	cmd.Flags().String("output", "", "Output format: json (default: text)")
+
+	return &cmd
+}
+
+// Validate validates JQL queries.
+func Validate(cmd *cobra.Command, args []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	output, _ := cmd.Flags().GetString("output")
+	if output != "" && output != "json" {
+		cmdutil.Failed("Invalid output format %q, valid format is: json", output)
+	}
+
+	queries := args
+	if len(queries) == 0 {
+		b, err := cmdutil.ReadFile("")
+		cmdutil.ExitIfError(err)
+		queries = []string{strings.TrimSpace(string(b))}
+	}
+	for _, q := range queries {
+		if strings.TrimSpace(q) == "" {
+			cmdutil.Failed("JQL can't be empty")
+		}
+		cmdutil.ExitIfError(cmdutil.ValidateJQL(q))
+	}
+
+	parsed, err := func() ([]*jira.ParsedJQL, error) {
+		s := cmdutil.Info("Validating JQL...")
+		defer s.Stop()
+
+		return api.ProxyParseJQL(api.DefaultClient(debug), queries...)
+	}()
+	cmdutil.ExitIfError(err)
+
+	invalid := 0
+	for _, p := range parsed {
+		if !p.Valid() {
+			invalid++
+		}
+	}
+
+	if output == "json" {
+		out, err := json.MarshalIndent(parsed, "", "  ")
+		cmdutil.ExitIfError(err)
+		fmt.Println(string(out))
+	} else {
+		for _, p := range parsed {
+			printResult(p, len(parsed) > 1)
+		}
+	}
+
+	if invalid > 0 {
+		cmdutil.Failed("%d of %d queries are invalid", invalid, len(parsed))
+	}
+}
+
+func printResult(p *jira.ParsedJQL, named bool) {
+	msg := "Valid JQL"
+	if !p.Valid() {
+		msg = "Invalid JQL"
+	}
+	if named {
+		msg += ": " + p.Query
+	}
+
+	if p.Valid() {
+		cmdutil.Success("%s", msg)
+	} else {
+		cmdutil.Fail("%s", msg)
+	}
+	for _, e := range p.Errors {
+		_, _ = fmt.Fprintf(os.Stderr, "  - %s\n", e)
+	}
+	for _, w := range p.Warnings {
+		cmdutil.Warn("  - %s", w)
+	}
+}
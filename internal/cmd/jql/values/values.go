@@ -0,0 +1,73 @@
+package values
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const examples = `$ jira jql values status
+
+# Values of a field that start with the given text
+$ jira jql values status "in p"
+
+# Values of a custom field
+$ jira jql values cf[10010]`
+
+// NewCmdValues is a values command.
+func NewCmdValues() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "values FIELD [PREFIX]",
+		Short:   "Values lists suggested values of a JQL field",
+		Long:    "Values lists values of a field suggested by Jira for use in JQL queries.",
+		Example: examples,
+		Aliases: []string{"suggest"},
+		Annotations: map[string]string{
+			"help:args": "FIELD\tField name as used in JQL, eg: status, cf[10010]\n" +
+				"[PREFIX]\tOnly list values that start with the text",
+		},
+		Args: cobra.RangeArgs(1, 2),
+		Run:  Values,
+	}
+
+	cmd.Flags().Bool("plain", false, "Display output in plain mode")
+	cmd.Flags().String("output", "", "Output format: json (default: table)")
+
+	return &cmd
+}
+
+// Values lists suggested values of a JQL field.
+func Values(cmd *cobra.Command, args []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	plain, _ := cmd.Flags().GetBool("plain")
+	output, _ := cmd.Flags().GetString("output")
+
+	if output != "" && output != "json" {
+		cmdutil.Failed("Invalid output format %q, valid format is: json", output)
+	}
+
+	prefix := ""
+	if len(args) > 1 {
+		prefix = args[1]
+	}
+
+	suggestions, err := func() ([]*jira.JQLSuggestion, error) {
+		s := cmdutil.Info("Fetching suggestions...")
+		defer s.Stop()
+
+		return api.DefaultClient(debug).JQLSuggestions(args[0], prefix)
+	}()
+	cmdutil.ExitIfError(err)
+
+	if len(suggestions) == 0 {
+		cmdutil.Failed("No suggestions found for field %q", args[0])
+	}
+
+	v := view.NewJQLSuggestions(suggestions, view.WithJQLPlain(plain), view.WithJQLJSON(output == "json"))
+	cmdutil.ExitIfError(v.Render())
+}
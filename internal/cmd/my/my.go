@@ -4,9 +4,16 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/list"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/workflow"
 )
 
-const helpText = `My displays issues assigned to the current user.`
+const helpText = `My displays issues assigned to the current user.
+
+Status filters use the "todo", "done" and "blocked" states of the workflow config:
+  workflow:
+    done:
+      statuses: [Done, Closed]`
 
 // NewCmdMy is a my command.
 func NewCmdMy() *cobra.Command {
@@ -37,14 +44,27 @@ func myIssues(cmd *cobra.Command, args []string) {
 	jql := "assignee = currentUser()"
 	
 	// Add status filters
+	wf, err := workflow.FromConfig()
+	cmdutil.ExitIfError(err)
+
+	var states []workflow.State
 	if todo, _ := cmd.Flags().GetBool("todo"); todo {
-		jql += " AND status = \"To Do\""
+		states = append(states, workflow.Todo)
 	} else if done, _ := cmd.Flags().GetBool("done"); done {
-		jql += " AND status = \"Done\""
+		states = append(states, workflow.Done)
 	}
 	
 	if blocked, _ := cmd.Flags().GetBool("blocked"); blocked {
-		jql += " AND status = \"Blocked\""
+		states = append(states, workflow.Blocked)
+	}
+
+	for _, s := range states {
+		cond := wf.JQL(s)
+		if cond == "" {
+			cmdutil.Warn("Workflow state %q has no statuses or category, skipping the filter", s)
+			continue
+		}
+		jql += " AND " + cond
 	}
 	
 	// Set JQL flag
@@ -0,0 +1,73 @@
+// Package exec runs external plugins as jira subcommands.
+package exec
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/plugin"
+)
+
+// NewCmdExec is a command that runs the given plugin.
+func NewCmdExec(p *plugin.Plugin) *cobra.Command {
+	return &cobra.Command{
+		Use:                p.Name,
+		Short:              "Run plugin " + p.Path,
+		DisableFlagParsing: true,
+		// Plugins handle configuration and credentials themselves.
+		PersistentPreRun: func(*cobra.Command, []string) {},
+		Run: func(cmd *cobra.Command, args []string) {
+			Exec(cmd, p, args)
+		},
+	}
+}
+
+// Exec runs the plugin with the resolved configuration in the environment
+// and exits with the exit code of the plugin.
+func Exec(cmd *cobra.Command, p *plugin.Plugin, args []string) {
+	c := exec.Command(p.Path, args...)
+	c.Stdin = cmd.InOrStdin()
+	c.Stdout = cmd.OutOrStdout()
+	c.Stderr = cmd.ErrOrStderr()
+	c.Env = plugin.Env(os.Environ(), env())
+
+	err := c.Run()
+
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		os.Exit(exitErr.ExitCode())
+	}
+	cmdutil.ExitIfError(err)
+}
+
+func env() map[string]string {
+	server, login := viper.GetString("server"), viper.GetString("login")
+
+	vars := map[string]string{
+		"JIRA_SERVER":       server,
+		"JIRA_LOGIN":        login,
+		"JIRA_API_TOKEN":    os.Getenv("JIRA_API_TOKEN"),
+		"JIRA_PROJECT":      viper.GetString("project.key"),
+		"JIRA_BOARD":        viper.GetString("board.name"),
+		"JIRA_INSTALLATION": viper.GetString("installation"),
+		"JIRA_AUTH_TYPE":    viper.GetString("auth_type"),
+		"JIRA_CONFIG_FILE":  viper.ConfigFileUsed(),
+	}
+	if id := viper.GetInt("board.id"); id > 0 {
+		vars["JIRA_BOARD_ID"] = strconv.Itoa(id)
+	}
+	if vars["JIRA_API_TOKEN"] == "" && server != "" {
+		vars["JIRA_API_TOKEN"] = api.APIToken(server, login)
+	}
+	if bin, err := os.Executable(); err == nil {
+		vars["JIRA_CLI"] = bin
+	}
+	return vars
+}
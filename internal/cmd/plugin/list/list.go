@@ -0,0 +1,58 @@
+package list
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/plugin"
+)
+
+const examples = `$ jira plugin list
+
+# Run a plugin installed as jira-deploy
+$ jira deploy --env staging`
+
+// NewCmdList is a list command.
+func NewCmdList() *cobra.Command {
+	return &cobra.Command{
+		Use:     "list",
+		Short:   "List installed plugins",
+		Long:    "List plugins found in the config directory plugins folder and on PATH.",
+		Example: examples,
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		Run:     List,
+	}
+}
+
+// List displays installed plugins.
+func List(cmd *cobra.Command, _ []string) {
+	plugins := plugin.Find(plugin.Dirs())
+	if len(plugins) == 0 {
+		cmdutil.Failed("No plugins found.\nInstall an executable named %s<name> on PATH to add one.", plugin.Prefix)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 1, '\t', 0)
+	_, _ = fmt.Fprintln(w, "NAME\tPATH")
+
+	var warnings []string
+	for _, p := range plugins {
+		_, _ = fmt.Fprintf(w, "%s\t%s\n", p.Name, p.Path)
+
+		if builtin, _, err := cmd.Root().Find([]string{p.Name}); err == nil && builtin != cmd.Root() {
+			warnings = append(warnings, fmt.Sprintf("Plugin %s is ignored since it has the same name as a built-in command.", p.Path))
+		}
+		for _, s := range p.Shadows {
+			warnings = append(warnings, fmt.Sprintf("Plugin %s is shadowed by %s.", s, p.Path))
+		}
+	}
+	cmdutil.ExitIfError(w.Flush())
+
+	for _, msg := range warnings {
+		cmdutil.Warn(msg)
+	}
+}
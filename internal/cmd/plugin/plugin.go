@@ -0,0 +1,38 @@
+package plugin
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/plugin/list"
+)
+
+const helpText = `Plugin manages external subcommands. See available commands below.
+
+Any executable named jira-<name> in the plugins folder of the config directory
+or on PATH can be run as 'jira <name>'. Built-in commands always take precedence.
+
+Plugins receive the resolved configuration in the environment: JIRA_SERVER,
+JIRA_LOGIN, JIRA_API_TOKEN, JIRA_PROJECT, JIRA_BOARD, JIRA_BOARD_ID, JIRA_INSTALLATION,
+JIRA_AUTH_TYPE, JIRA_CONFIG_FILE and JIRA_CLI, the path to the jira binary.`
+
+// NewCmdPlugin is a plugin command.
+func NewCmdPlugin() *cobra.Command {
+	cmd := cobra.Command{
+		Use:         "plugin",
+		Short:       "Plugin manages external subcommands",
+		Long:        helpText,
+		Aliases:     []string{"plugins"},
+		Annotations: map[string]string{"cmd:main": "true"},
+		RunE:        plugin,
+		// Listing plugins doesn't need a configured Jira server.
+		PersistentPreRun: func(*cobra.Command, []string) {},
+	}
+
+	cmd.AddCommand(list.NewCmdList())
+
+	return &cmd
+}
+
+func plugin(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
@@ -6,16 +6,25 @@ import (
 
 	"github.com/ankitpokhrel/jira-cli/api"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/workflow"
 )
 
 const (
-	helpText = `Block links an issue as "blocks" and optionally moves it to blocked status.`
-	examples = `$ jira quick block PROJ-123 PROJ-456`
+	helpText = `Block links an issue as "blocks" and optionally moves it to blocked status.
+
+The transition is picked from the "blocked" state of the workflow config:
+  workflow:
+    blocked:
+      transitions: [Flag as Blocked]`
+	examples = `$ jira quick block PROJ-123 PROJ-456
+
+# Also move PROJ-123 to blocked status
+$ jira quick block PROJ-123 PROJ-456 --move`
 )
 
 // NewCmdBlock is a block command.
 func NewCmdBlock() *cobra.Command {
-	return &cobra.Command{
+	cmd := cobra.Command{
 		Use:     "block <issue-key> <blocked-by-key>",
 		Short:   "Block links issue as blocks",
 		Long:    helpText,
@@ -23,6 +32,10 @@ func NewCmdBlock() *cobra.Command {
 		Args:    cobra.ExactArgs(2),
 		Run:     Block,
 	}
+
+	cmd.Flags().Bool("move", false, "Move the issue to blocked status")
+
+	return &cmd
 }
 
 // Block performs the quick block action.
@@ -30,6 +43,9 @@ func Block(cmd *cobra.Command, args []string) {
 	debug, err := cmd.Flags().GetBool("debug")
 	cmdutil.ExitIfError(err)
 
+	move, err := cmd.Flags().GetBool("move")
+	cmdutil.ExitIfError(err)
+
 	project := viper.GetString("project.key")
 	issueKey := cmdutil.GetJiraIssueKey(project, args[0])
 	blockedByKey := cmdutil.GetJiraIssueKey(project, args[1])
@@ -42,6 +58,16 @@ func Block(cmd *cobra.Command, args []string) {
 	s.Stop()
 	cmdutil.ExitIfError(err)
 
+	if move {
+		wf, err := workflow.FromConfig()
+		cmdutil.ExitIfError(err)
+
+		s := cmdutil.Info("Moving issue to blocked status...")
+		_, err = wf.Move(client, issueKey, workflow.Blocked)
+		s.Stop()
+		cmdutil.ExitIfError(err)
+	}
+
 	cmdutil.Success("Issue %s now blocks %s", issueKey, blockedByKey)
 }
 
@@ -6,11 +6,16 @@ import (
 
 	"github.com/ankitpokhrel/jira-cli/api"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
-	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/internal/workflow"
 )
 
 const (
-	helpText = `Done moves an issue to "Done" status.`
+	helpText = `Done moves an issue to "Done" status.
+
+The transition is picked from the "done" state of the workflow config:
+  workflow:
+    done:
+      transitions: [Resolve Issue]`
 	examples = `$ jira quick done PROJ-123`
 )
 
@@ -34,38 +39,16 @@ func Done(cmd *cobra.Command, args []string) {
 	project := viper.GetString("project.key")
 	key := cmdutil.GetJiraIssueKey(project, args[0])
 
+	wf, err := workflow.FromConfig()
+	cmdutil.ExitIfError(err)
+
 	client := api.DefaultClient(debug)
 
 	// Move to "Done"
 	s := cmdutil.Info("Moving issue to Done...")
-	transitions, err := api.ProxyTransitions(client, key)
-	cmdutil.ExitIfError(err)
-
-	var doneTransition *jira.Transition
-	for _, t := range transitions {
-		if t.Name == "Done" || t.Name == "Close" || t.Name == "Resolve" {
-			doneTransition = t
-			break
-		}
-	}
-
-	if doneTransition == nil {
-		s.Stop()
-		cmdutil.Failed("Could not find 'Done' transition for issue %s", key)
-		return
-	}
-
-	trReq := &jira.TransitionRequest{
-		Transition: &jira.TransitionRequestData{
-			ID:   doneTransition.ID.String(),
-			Name: doneTransition.Name,
-		},
-	}
-
-	_, err = client.Transition(key, trReq)
+	_, err = wf.Move(client, key, workflow.Done)
 	s.Stop()
 	cmdutil.ExitIfError(err)
 
 	cmdutil.Success("Issue %s marked as done", key)
 }
-
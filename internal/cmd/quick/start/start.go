@@ -6,11 +6,17 @@ import (
 
 	"github.com/ankitpokhrel/jira-cli/api"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/workflow"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
 )
 
 const (
-	helpText = `Start moves an issue to "In Progress" and assigns it to yourself.`
+	helpText = `Start moves an issue to "In Progress" and assigns it to yourself.
+
+The transition is picked from the "doing" state of the workflow config:
+  workflow:
+    doing:
+      transitions: [Start Progress]`
 	examples = `$ jira quick start PROJ-123`
 )
 
@@ -34,6 +40,9 @@ func Start(cmd *cobra.Command, args []string) {
 	project := viper.GetString("project.key")
 	key := cmdutil.GetJiraIssueKey(project, args[0])
 
+	wf, err := workflow.FromConfig()
+	cmdutil.ExitIfError(err)
+
 	client := api.DefaultClient(debug)
 
 	// Get current user
@@ -57,34 +66,9 @@ func Start(cmd *cobra.Command, args []string) {
 
 	// Move to "In Progress"
 	s = cmdutil.Info("Moving issue to In Progress...")
-	transitions, err := api.ProxyTransitions(client, key)
-	cmdutil.ExitIfError(err)
-
-	var inProgressTransition *jira.Transition
-	for _, t := range transitions {
-		if t.Name == "In Progress" || t.Name == "Start Progress" || t.Name == "Start" {
-			inProgressTransition = t
-			break
-		}
-	}
-
-	if inProgressTransition == nil {
-		s.Stop()
-		cmdutil.Failed("Could not find 'In Progress' transition for issue %s", key)
-		return
-	}
-
-	trReq := &jira.TransitionRequest{
-		Transition: &jira.TransitionRequestData{
-			ID:   inProgressTransition.ID.String(),
-			Name: inProgressTransition.Name,
-		},
-	}
-
-	_, err = client.Transition(key, trReq)
+	_, err = wf.Move(client, key, workflow.Doing)
 	s.Stop()
 	cmdutil.ExitIfError(err)
 
 	cmdutil.Success("Issue %s started: assigned to %s and moved to In Progress", key, me.Name)
 }
-
@@ -0,0 +1,59 @@
+package merge
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+)
+
+const (
+	helpText = `Merge moves all issues of a version to another version and deletes the merged version.`
+	examples = `$ jira release merge v1.2.1 v1.3.0
+$ jira release merge 10021 10022`
+)
+
+// NewCmdMerge is a merge command.
+func NewCmdMerge() *cobra.Command {
+	return &cobra.Command{
+		Use:     "merge VERSION TARGET-VERSION",
+		Short:   "Merge merges a project version into another",
+		Long:    helpText,
+		Example: examples,
+		Annotations: map[string]string{
+			"help:args": "VERSION\tVersion id or name to merge and delete, eg: v1.2.1\n" +
+				"TARGET-VERSION\tVersion id or name to move the issues to, eg: v1.3.0",
+		},
+		Args: cobra.ExactArgs(2),
+		Run:  Merge,
+	}
+}
+
+// Merge merges a version into another.
+func Merge(cmd *cobra.Command, args []string) {
+	project := viper.GetString("project.key")
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	client := api.DefaultClient(debug)
+
+	err = func() error {
+		s := cmdutil.Info("Merging versions...")
+		defer s.Stop()
+
+		from, err := cmdcommon.ResolveVersion(client, project, args[0])
+		if err != nil {
+			return err
+		}
+		to, err := cmdcommon.ResolveVersion(client, project, args[1])
+		if err != nil {
+			return err
+		}
+		return client.MergeVersion(from.ID, to.ID)
+	}()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success("Version %s merged into %s", args[0], args[1])
+}
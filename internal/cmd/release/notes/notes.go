@@ -0,0 +1,122 @@
+package notes
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Notes generates release notes from issues with the given fix version.
+
+The version can either be a version id or its name. Issues are grouped by issue type
+by default and can be rendered with a custom go template using the --template flag.
+The template receives the version, total number of issues and the list of groups, eg:
+
+  {{ .Version }}
+  {{ range .Groups }}{{ .Name }}
+  {{ range .Issues }}  {{ .Key }} {{ .Summary }} {{ .URL }}
+  {{ end }}{{ end }}`
+	examples = `$ jira release notes v1.2.0
+
+# Render notes for confluence grouped by component
+$ jira release notes v1.2.0 --format confluence-wiki --group-by component
+
+# Use a custom template
+$ jira release notes 10021 --template ./notes.tmpl > CHANGELOG.md`
+)
+
+// NewCmdNotes is a notes command.
+func NewCmdNotes() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "notes VERSION",
+		Short:   "Notes generates release notes for a project version",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"changelog"},
+		Annotations: map[string]string{
+			"help:args": "VERSION\tVersion id or name, eg: v1.2.0",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  Notes,
+	}
+
+	cmd.Flags().String("format", view.ReleaseNotesFormatMarkdown, "Output format: md, html, confluence-wiki, json")
+	cmd.Flags().String("group-by", view.ReleaseNotesGroupByType, "Group issues by: type, component, label")
+	cmd.Flags().String("template", "", "Path to a custom go template to render the notes")
+	cmd.Flags().Uint("limit", 1000, "Maximum number of issues to include")
+
+	return &cmd
+}
+
+// Notes generates release notes.
+func Notes(cmd *cobra.Command, args []string) {
+	project := viper.GetString("project.key")
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	format, err := cmd.Flags().GetString("format")
+	cmdutil.ExitIfError(err)
+
+	groupBy, err := cmd.Flags().GetString("group-by")
+	cmdutil.ExitIfError(err)
+
+	tmplFile, err := cmd.Flags().GetString("template")
+	cmdutil.ExitIfError(err)
+
+	limit, err := cmd.Flags().GetUint("limit")
+	cmdutil.ExitIfError(err)
+
+	var tmpl string
+	if tmplFile != "" {
+		b, err := os.ReadFile(tmplFile)
+		cmdutil.ExitIfError(err)
+		tmpl = string(b)
+	}
+
+	client := api.DefaultClient(debug)
+
+	version, result, err := func() (*jira.ProjectVersion, *jira.SearchResult, error) {
+		s := cmdutil.Info("Fetching release issues...")
+		defer s.Stop()
+
+		version, err := cmdcommon.ResolveVersion(client, project, args[0])
+		if err != nil {
+			return nil, nil, err
+		}
+
+		jql := fmt.Sprintf("project = %q AND fixVersion = %s ORDER BY issuetype ASC, key ASC", project, version.ID)
+		result, err := api.ProxySearchAll(client, jql, limit)
+		if err != nil {
+			return nil, nil, err
+		}
+		return version, result, nil
+	}()
+	cmdutil.ExitIfError(err)
+
+	issues := result.Issues
+	if !result.IsLast {
+		cmdutil.Warn("Showing first %d issues of the release, use --limit to include more", len(issues))
+	}
+
+	if len(issues) == 0 {
+		cmdutil.Warn("No issues found with fix version %q", version.Name)
+	}
+
+	v := view.NewReleaseNotes(
+		viper.GetString("server"), version, issues,
+		view.WithReleaseNotesFormat(format),
+		view.WithReleaseNotesGroupBy(groupBy),
+		view.WithReleaseNotesTemplate(tmpl),
+	)
+
+	cmdutil.ExitIfError(v.Render())
+}
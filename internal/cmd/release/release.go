@@ -6,6 +6,9 @@ import (
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/release/create"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/release/delete"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/release/list"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/release/merge"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/release/notes"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/release/ship"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/release/update"
 )
 
@@ -27,6 +30,9 @@ func NewCmdRelease() *cobra.Command {
 		create.NewCmdCreate(),
 		update.NewCmdUpdate(),
 		delete.NewCmdDelete(),
+		notes.NewCmdNotes(),
+		ship.NewCmdShip(),
+		merge.NewCmdMerge(),
 	)
 
 	return &cmd
@@ -0,0 +1,239 @@
+package ship
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Ship releases a project version.
+
+It checks the version for unresolved issues, optionally moves them to another version,
+and marks the version as released with the given release date (defaults to today).
+
+In strict mode, the release is aborted if any unresolved issue has one of the blocker
+priorities. Use --dry-run to only print the readiness report.`
+	examples = `$ jira release ship v1.2.0
+
+# Move unresolved issues to the next unreleased version
+$ jira release ship v1.2.0 --move-to next
+
+# Abort if there are unresolved blockers, release on a specific date
+$ jira release ship v1.2.0 --strict --release-date 2026-01-31
+
+# Only print the readiness report
+$ jira release ship v1.2.0 --dry-run`
+
+	moveToNext = "next"
+)
+
+// NewCmdShip is a ship command.
+func NewCmdShip() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "ship VERSION",
+		Short:   "Ship checks and releases a project version",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"release"},
+		Annotations: map[string]string{
+			"help:args": "VERSION\tVersion id or name, eg: v1.2.0",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  Ship,
+	}
+
+	cmd.Flags().String("move-to", "", "Move unresolved issues to this version id or name, use 'next' for the next unreleased version")
+	cmd.Flags().String("release-date", "", "Release date (YYYY-MM-DD), defaults to today")
+	cmd.Flags().Bool("strict", false, "Fail if unresolved blockers exist")
+	cmd.Flags().StringArray("blocker-priority", []string{"Blocker", "Highest", "Critical"}, "Priorities considered as blockers in strict mode")
+	cmd.Flags().Bool("dry-run", false, "Print the readiness report without making any changes")
+
+	return &cmd
+}
+
+type shipParams struct {
+	moveTo          string
+	releaseDate     string
+	strict          bool
+	blockerPriority []string
+	dryRun          bool
+	debug           bool
+}
+
+// Ship releases a version.
+func Ship(cmd *cobra.Command, args []string) {
+	project := viper.GetString("project.key")
+	params := parseFlags(cmd)
+
+	client := api.DefaultClient(params.debug)
+
+	version, unresolved, err := func() (*jira.ProjectVersion, []*jira.Issue, error) {
+		s := cmdutil.Info("Checking release readiness...")
+		defer s.Stop()
+
+		version, err := cmdcommon.ResolveVersion(client, project, args[0])
+		if err != nil {
+			return nil, nil, err
+		}
+
+		jql := fmt.Sprintf("project = %q AND fixVersion = %s AND resolution = Unresolved ORDER BY priority DESC, key ASC", project, version.ID)
+		result, err := api.ProxySearchAll(client, jql, 0)
+		if err != nil {
+			return nil, nil, err
+		}
+		return version, result.Issues, nil
+	}()
+	cmdutil.ExitIfError(err)
+
+	if version.Released {
+		cmdutil.Failed("Version %q is already released", version.Name)
+	}
+
+	blockers := make([]*jira.Issue, 0)
+	for _, iss := range unresolved {
+		if slices.ContainsFunc(params.blockerPriority, func(p string) bool {
+			return strings.EqualFold(p, iss.Fields.Priority.Name)
+		}) {
+			blockers = append(blockers, iss)
+		}
+	}
+
+	printReport(version, unresolved, blockers)
+
+	if params.strict && len(blockers) > 0 {
+		cmdutil.Failed("Release aborted: %d unresolved blockers in version %q", len(blockers), version.Name)
+	}
+	if params.dryRun {
+		return
+	}
+
+	var target *jira.ProjectVersion
+	if params.moveTo != "" && len(unresolved) > 0 {
+		if params.moveTo == moveToNext {
+			target, err = cmdcommon.NextVersion(client, project, version)
+		} else {
+			target, err = cmdcommon.ResolveVersion(client, project, params.moveTo)
+		}
+		cmdutil.ExitIfError(err)
+
+		if target.ID == version.ID {
+			cmdutil.Failed("Unable to move issues to the version being released")
+		}
+
+		moveIssues(client, unresolved, version, target)
+
+		// Issues created or reopened in the meantime would be released unresolved.
+		count, err := client.UnresolvedIssueCount(version.ID)
+		cmdutil.ExitIfError(err)
+		if count > 0 {
+			cmdutil.Failed("Release aborted: %d unresolved issues are still in version %q", count, version.Name)
+		}
+	}
+
+	released := true
+	_, err = func() (*jira.ProjectVersion, error) {
+		s := cmdutil.Info(fmt.Sprintf("Releasing version %s...", version.Name))
+		defer s.Stop()
+
+		return client.UpdateVersion(version.ID, &jira.UpdateVersionRequest{
+			Released:    &released,
+			ReleaseDate: params.releaseDate,
+		})
+	}()
+	cmdutil.ExitIfError(err)
+
+	msg := fmt.Sprintf("Version %q released on %s", version.Name, params.releaseDate)
+	if target != nil {
+		msg += fmt.Sprintf(", %d unresolved issues moved to %q", len(unresolved), target.Name)
+	} else if len(unresolved) > 0 {
+		msg += fmt.Sprintf(", %d issues are still unresolved", len(unresolved))
+	}
+	cmdutil.Success(msg)
+}
+
+func moveIssues(client *jira.Client, issues []*jira.Issue, from, to *jira.ProjectVersion) {
+	s := cmdutil.Info(fmt.Sprintf("Moving %d unresolved issues to %s...", len(issues), to.Name))
+	defer s.Stop()
+
+	failed := make([]string, 0)
+	for _, iss := range issues {
+		if err := client.MoveFixVersion(iss.Key, from.Name, to.Name); err != nil {
+			failed = append(failed, iss.Key)
+		}
+	}
+	if len(failed) > 0 {
+		s.Stop()
+		cmdutil.Failed("Unable to move issues to %q: %s", to.Name, strings.Join(failed, ", "))
+	}
+}
+
+func printReport(version *jira.ProjectVersion, unresolved, blockers []*jira.Issue) {
+	fmt.Printf("Version:    %s (ID: %s)\n", version.Name, version.ID)
+	if version.ReleaseDate != "" {
+		overdue := ""
+		if version.Overdue {
+			overdue = " (overdue)"
+		}
+		fmt.Printf("Due:        %s%s\n", version.ReleaseDate, overdue)
+	}
+	fmt.Printf("Unresolved: %d\n", len(unresolved))
+	fmt.Printf("Blockers:   %d\n", len(blockers))
+
+	for _, iss := range unresolved {
+		marker := " "
+		if slices.Contains(blockers, iss) {
+			marker = "!"
+		}
+		fmt.Printf("  %s %s [%s] [%s] %s\n", marker, iss.Key, iss.Fields.Status.Name, iss.Fields.Priority.Name, iss.Fields.Summary)
+	}
+	fmt.Println()
+}
+
+func parseFlags(cmd *cobra.Command) *shipParams {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	moveTo, err := cmd.Flags().GetString("move-to")
+	cmdutil.ExitIfError(err)
+
+	releaseDate, err := cmd.Flags().GetString("release-date")
+	cmdutil.ExitIfError(err)
+
+	strict, err := cmd.Flags().GetBool("strict")
+	cmdutil.ExitIfError(err)
+
+	blockerPriority, err := cmd.Flags().GetStringArray("blocker-priority")
+	cmdutil.ExitIfError(err)
+
+	dryRun, err := cmd.Flags().GetBool("dry-run")
+	cmdutil.ExitIfError(err)
+
+	if releaseDate == "" {
+		releaseDate = time.Now().Format("2006-01-02")
+	} else {
+		t, err := time.Parse("2006-01-02", releaseDate)
+		if err != nil {
+			cmdutil.Failed("Invalid release date %q, expected format YYYY-MM-DD", releaseDate)
+		}
+		releaseDate = t.Format("2006-01-02")
+	}
+
+	return &shipParams{
+		moveTo:          moveTo,
+		releaseDate:     releaseDate,
+		strict:          strict,
+		blockerPriority: blockerPriority,
+		dryRun:          dryRun,
+		debug:           debug,
+	}
+}
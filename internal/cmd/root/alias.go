@@ -0,0 +1,84 @@
+package root
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/internal/alias"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+)
+
+// ExpandAlias replaces a user-defined alias in args with the command it stands for,
+// eg: `jira standup` becomes `jira issue list -s"In Progress"`. Built-in commands
+// take precedence over aliases. Shell aliases are run right away and the process
+// exits with their exit code.
+func ExpandAlias(cmd *cobra.Command, args []string) []string {
+	if len(args) == 0 || args[0] == "" || args[0][0] == '-' {
+		return args
+	}
+	if _, _, err := cmd.Find(args[:1]); err == nil {
+		return args
+	}
+
+	// Flags are not parsed yet, so the config file is located from raw args.
+	if config == "" {
+		config = configFlag(args)
+	}
+	initConfig()
+
+	expansion, ok := viper.GetStringMapString("aliases")[args[0]]
+	if !ok {
+		return args
+	}
+	if alias.IsShell(expansion) {
+		runShellAlias(args[0], expansion, args[1:])
+	}
+
+	expanded, err := alias.Expand(expansion, args[1:])
+	if err != nil {
+		cmdutil.Failed("Unable to expand alias %q: %s", args[0], err)
+	}
+	return expanded
+}
+
+func runShellAlias(name, expansion string, args []string) {
+	sh := alias.ShellCommand(name, expansion, args)
+
+	c := exec.Command(sh[0], sh[1:]...)
+	c.Stdin = os.Stdin
+	c.Stdout = os.Stdout
+	c.Stderr = os.Stderr
+
+	err := c.Run()
+
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		os.Exit(exitErr.ExitCode())
+	}
+	cmdutil.ExitIfError(err)
+	os.Exit(0)
+}
+
+// configFlag returns the value of the --config flag in args.
+func configFlag(args []string) string {
+	for i, arg := range args {
+		switch {
+		case arg == "--":
+			return ""
+		case arg == "--config" || arg == "-c":
+			if i+1 < len(args) {
+				return args[i+1]
+			}
+		case strings.HasPrefix(arg, "--config="):
+			return strings.TrimPrefix(arg, "--config=")
+		case strings.HasPrefix(arg, "-c") && !strings.HasPrefix(arg, "--"):
+			return strings.TrimPrefix(strings.TrimPrefix(arg, "-c"), "=")
+		}
+	}
+	return ""
+}
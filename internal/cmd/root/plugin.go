@@ -0,0 +1,22 @@
+package root
+
+import (
+	"github.com/spf13/cobra"
+
+	pluginExec "github.com/ankitpokhrel/jira-cli/internal/cmd/plugin/exec"
+	"github.com/ankitpokhrel/jira-cli/internal/plugin"
+)
+
+// AddPluginCommand registers an external plugin as a subcommand if the first
+// argument doesn't match any built-in command, eg: `jira deploy` runs `jira-deploy`.
+func AddPluginCommand(cmd *cobra.Command, args []string) {
+	if len(args) == 0 || args[0] == "" || args[0][0] == '-' {
+		return
+	}
+	if _, _, err := cmd.Find(args); err == nil {
+		return
+	}
+	if p, ok := plugin.Lookup(args[0], plugin.Dirs()); ok {
+		cmd.AddCommand(pluginExec.NewCmdExec(p))
+	}
+}
@@ -1,6 +1,7 @@
 package root
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"slices"
@@ -8,23 +9,35 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
+	"github.com/ankitpokhrel/jira-cli/api"
+	aliasCmd "github.com/ankitpokhrel/jira-cli/internal/cmd/alias"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/automate"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/board"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/cache"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/completion"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/component"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/epic"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/feed"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/filter"
+	gitCmd "github.com/ankitpokhrel/jira-cli/internal/cmd/git"
 	initCmd "github.com/ankitpokhrel/jira-cli/internal/cmd/init"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/jql"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/man"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/me"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/my"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/open"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/plugin"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/project"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/quick"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/release"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/serverinfo"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/sm"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/sprint"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/stats"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/version"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/webhook"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
@@ -44,33 +57,35 @@ var (
 )
 
 func init() {
-	cobra.OnInitialize(func() {
-		if config != "" {
-			// 1. Command line flag has the highest priority
-			viper.SetConfigFile(config)
-		} else if configFile := os.Getenv("JIRA_CONFIG_FILE"); configFile != "" {
-			// 2. Environment variable has second priority
-			viper.SetConfigFile(configFile)
-		} else {
-			// 3. Default location has the lowest priority
-			home, err := cmdutil.GetConfigHome()
-			if err != nil {
-				cmdutil.Failed("Error: %s", err)
-				return
-			}
+	cobra.OnInitialize(initConfig)
+}
 
-			viper.AddConfigPath(fmt.Sprintf("%s/%s", home, jiraConfig.Dir))
-			viper.SetConfigName(jiraConfig.FileName)
-			viper.SetConfigType(jiraConfig.FileType)
+func initConfig() {
+	if config != "" {
+		// 1. Command line flag has the highest priority
+		viper.SetConfigFile(config)
+	} else if configFile := os.Getenv("JIRA_CONFIG_FILE"); configFile != "" {
+		// 2. Environment variable has second priority
+		viper.SetConfigFile(configFile)
+	} else {
+		// 3. Default location has the lowest priority
+		home, err := cmdutil.GetConfigHome()
+		if err != nil {
+			cmdutil.Failed("Error: %s", err)
+			return
 		}
 
-		viper.AutomaticEnv()
-		viper.SetEnvPrefix("jira")
+		viper.AddConfigPath(fmt.Sprintf("%s/%s", home, jiraConfig.Dir))
+		viper.SetConfigName(jiraConfig.FileName)
+		viper.SetConfigType(jiraConfig.FileType)
+	}
+
+	viper.AutomaticEnv()
+	viper.SetEnvPrefix("jira")
 
-		if err := viper.ReadInConfig(); err == nil && debug {
-			fmt.Printf("Using config file: %s\n", viper.ConfigFileUsed())
-		}
-	})
+	if err := viper.ReadInConfig(); err == nil && debug {
+		fmt.Printf("Using config file: %s\n", viper.ConfigFileUsed())
+	}
 }
 
 // NewCmdRoot is a root command.
@@ -83,6 +98,8 @@ func NewCmdRoot() *cobra.Command {
 			return cmd.Help()
 		},
 		PersistentPreRun: func(cmd *cobra.Command, _ []string) {
+			setContext(cmd)
+
 			subCmd := cmd.Name()
 			if !cmdRequireToken(subCmd) {
 				return
@@ -122,14 +139,22 @@ func NewCmdRoot() *cobra.Command {
 		),
 	)
 	cmd.PersistentFlags().BoolVar(&debug, "debug", false, "Turn on debug output")
+	cmd.PersistentFlags().Bool("no-cache", false, "Bypass the http response cache")
+	cmd.PersistentFlags().String("trace-file", "", "Record requests and responses to a HAR file with credentials redacted")
+	cmd.PersistentFlags().String("trace-otlp-endpoint", "", "Send requests as OpenTelemetry spans to an OTLP/HTTP collector, eg: http://localhost:4318")
+	cmd.PersistentFlags().Duration("timeout", 0, "Abort the command if it doesn't complete within the given duration, eg: 30s, 2m")
 
 	cmd.SetHelpFunc(helpFunc)
 
 	_ = viper.BindPFlag("config", cmd.PersistentFlags().Lookup("config"))
 	_ = viper.BindPFlag("project.key", cmd.PersistentFlags().Lookup("project"))
 	_ = viper.BindPFlag("debug", cmd.PersistentFlags().Lookup("debug"))
+	_ = viper.BindPFlag("no_cache", cmd.PersistentFlags().Lookup("no-cache"))
+	_ = viper.BindPFlag("trace.file", cmd.PersistentFlags().Lookup("trace-file"))
+	_ = viper.BindPFlag("trace.otlp_endpoint", cmd.PersistentFlags().Lookup("trace-otlp-endpoint"))
 
 	addChildCommands(&cmd)
+	cmdcommon.SetJQLCompletion(&cmd)
 
 	return &cmd
 }
@@ -142,20 +167,51 @@ func addChildCommands(cmd *cobra.Command) {
 		sprint.NewCmdSprint(),
 		board.NewCmdBoard(),
 		project.NewCmdProject(),
+		component.NewCmdComponent(),
+		cache.NewCmdCache(),
 		filter.NewCmdFilter(),
+		jql.NewCmdJQL(),
 		quick.NewCmdQuick(),
 		open.NewCmdOpen(),
 		me.NewCmdMe(),
 		my.NewCmdMy(),
+		feed.NewCmdFeed(),
+		webhook.NewCmdWebhook(),
+		automate.NewCmdAutomate(),
 		serverinfo.NewCmdServerInfo(),
 		completion.NewCmdCompletion(),
 		version.NewCmdVersion(),
 		release.NewCmdRelease(),
 		stats.NewCmdStats(),
+		gitCmd.NewCmdGit(),
+		sm.NewCmdSM(),
 		man.NewCmdMan(),
+		plugin.NewCmdPlugin(),
+		aliasCmd.NewCmdAlias(),
 	)
 }
 
+// setContext bounds the command context with the --timeout flag and
+// passes it to the jira client so that requests are cancelled on interrupt.
+func setContext(cmd *cobra.Command) {
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	timeout, err := cmd.Flags().GetDuration("timeout")
+	cmdutil.ExitIfError(err)
+
+	if timeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		cobra.OnFinalize(cancel)
+		cmd.SetContext(ctx)
+	}
+	api.SetContext(ctx)
+}
+
 func cmdRequireToken(cmd string) bool {
 	allowList := []string{
 		"init",
@@ -0,0 +1,60 @@
+package answer
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const helpArgs = "ISSUE-KEY\tIssue key of the request, eg: ITH-1\n" +
+	"APPROVAL-ID\tApproval id, see 'jira sm approval list'"
+
+// NewCmdApprove is an approve command.
+func NewCmdApprove() *cobra.Command {
+	return &cobra.Command{
+		Use:         "approve ISSUE-KEY APPROVAL-ID",
+		Short:       "Approve approves a pending approval",
+		Long:        "Approve approves a pending approval of a customer request.",
+		Example:     "$ jira sm approval approve ITH-1 5",
+		Annotations: map[string]string{"help:args": helpArgs},
+		Args:        cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			answer(cmd, args, jira.ApprovalDecisionApprove)
+		},
+	}
+}
+
+// NewCmdDecline is a decline command.
+func NewCmdDecline() *cobra.Command {
+	return &cobra.Command{
+		Use:         "decline ISSUE-KEY APPROVAL-ID",
+		Short:       "Decline declines a pending approval",
+		Long:        "Decline declines a pending approval of a customer request.",
+		Example:     "$ jira sm approval decline ITH-1 5",
+		Annotations: map[string]string{"help:args": helpArgs},
+		Args:        cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			answer(cmd, args, jira.ApprovalDecisionDecline)
+		},
+	}
+}
+
+func answer(cmd *cobra.Command, args []string, decision string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	key := cmdutil.GetJiraIssueKey(viper.GetString("project.key"), args[0])
+
+	approval, err := func() (*jira.Approval, error) {
+		s := cmdutil.Info("Answering approval...")
+		defer s.Stop()
+
+		return api.DefaultClient(debug).AnswerApproval(key, args[1], decision)
+	}()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success("Approval %q of %s is now %s", approval.Name, key, approval.FinalDecision)
+}
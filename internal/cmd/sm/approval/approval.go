@@ -0,0 +1,33 @@
+package approval
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/sm/approval/answer"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/sm/approval/list"
+)
+
+const helpText = `Approval manages approvals of a customer request. See available commands below.`
+
+// NewCmdApproval is an approval command.
+func NewCmdApproval() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "approval",
+		Short:   "Approval manages approvals of a customer request",
+		Long:    helpText,
+		Aliases: []string{"approvals"},
+		RunE:    approval,
+	}
+
+	cmd.AddCommand(
+		list.NewCmdList(),
+		answer.NewCmdApprove(),
+		answer.NewCmdDecline(),
+	)
+
+	return &cmd
+}
+
+func approval(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
@@ -0,0 +1,50 @@
+package list
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// NewCmdList is a list command.
+func NewCmdList() *cobra.Command {
+	return &cobra.Command{
+		Use:     "list ISSUE-KEY",
+		Short:   "List lists approvals of a customer request",
+		Long:    "List lists approvals of a customer request.",
+		Example: "$ jira sm approval list ITH-1",
+		Aliases: []string{"lists", "ls"},
+		Annotations: map[string]string{
+			"help:args": "ISSUE-KEY\tIssue key of the request, eg: ITH-1",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  List,
+	}
+}
+
+// List displays a list of approvals.
+func List(cmd *cobra.Command, args []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	key := cmdutil.GetJiraIssueKey(viper.GetString("project.key"), args[0])
+
+	approvals, err := func() ([]*jira.Approval, error) {
+		s := cmdutil.Info("Fetching approvals...")
+		defer s.Stop()
+
+		return api.DefaultClient(debug).Approvals(key)
+	}()
+	cmdutil.ExitIfError(err)
+
+	if len(approvals) == 0 {
+		cmdutil.Failed("No approvals found.")
+		return
+	}
+
+	cmdutil.ExitIfError(view.NewApproval(approvals).Render())
+}
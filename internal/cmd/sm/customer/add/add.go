@@ -0,0 +1,55 @@
+package add
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+)
+
+const (
+	helpText = `Add adds existing users as customers of a service desk.
+
+Users are identified by account id in cloud installations and by username in local installations.`
+	examples = `$ jira sm customer add ITH 5b10ac8d82e05b22cc7d4ef5
+$ jira sm customer add 1 jane.doe john.doe`
+)
+
+// NewCmdAdd is an add command.
+func NewCmdAdd() *cobra.Command {
+	return &cobra.Command{
+		Use:     "add DESK USER...",
+		Short:   "Add adds customers to a service desk",
+		Long:    helpText,
+		Example: examples,
+		Annotations: map[string]string{
+			"help:args": "DESK\tService desk id or project key, eg: ITH\n" +
+				"USER...\tAccount ids or usernames of the users to add",
+		},
+		Args: cobra.MinimumNArgs(2),
+		Run:  Add,
+	}
+}
+
+// Add adds customers to a service desk.
+func Add(cmd *cobra.Command, args []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	err = func() error {
+		s := cmdutil.Info("Adding customers...")
+		defer s.Stop()
+
+		client := api.DefaultClient(debug)
+
+		id, err := cmdcommon.ResolveServiceDesk(client, args[0])
+		if err != nil {
+			return err
+		}
+		return client.AddCustomers(id, cmdcommon.GetSDUsersRequest(args[1:]))
+	}()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success("Customers added to service desk %s", args[0])
+}
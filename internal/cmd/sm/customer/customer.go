@@ -0,0 +1,28 @@
+package customer
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/sm/customer/add"
+)
+
+const helpText = `Customer manages customers of a service desk. See available commands below.`
+
+// NewCmdCustomer is a customer command.
+func NewCmdCustomer() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "customer",
+		Short:   "Customer manages customers of a service desk",
+		Long:    helpText,
+		Aliases: []string{"customers"},
+		RunE:    customer,
+	}
+
+	cmd.AddCommand(add.NewCmdAdd())
+
+	return &cmd
+}
+
+func customer(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
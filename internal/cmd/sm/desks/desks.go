@@ -0,0 +1,42 @@
+package desks
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// NewCmdDesks is a desks command.
+func NewCmdDesks() *cobra.Command {
+	return &cobra.Command{
+		Use:     "desks",
+		Short:   "Desks lists service desks",
+		Long:    "Desks lists service desks that a user has access to.",
+		Aliases: []string{"desk", "ls"},
+		Run:     Desks,
+	}
+}
+
+// Desks displays a list of service desks.
+func Desks(cmd *cobra.Command, _ []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	desks, err := func() ([]*jira.ServiceDesk, error) {
+		s := cmdutil.Info("Fetching service desks...")
+		defer s.Stop()
+
+		return api.DefaultClient(debug).ServiceDesks()
+	}()
+	cmdutil.ExitIfError(err)
+
+	if len(desks) == 0 {
+		cmdutil.Failed("No service desks found.")
+		return
+	}
+
+	cmdutil.ExitIfError(view.NewServiceDesk(desks).Render())
+}
@@ -0,0 +1,52 @@
+package add
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+)
+
+const (
+	helpText = `Add adds participants to a customer request.
+
+Users are identified by account id in cloud installations and by username in local installations.`
+	examples = `$ jira sm participant add ITH-1 5b10ac8d82e05b22cc7d4ef5
+$ jira sm participant add ITH-1 jane.doe john.doe`
+)
+
+// NewCmdAdd is an add command.
+func NewCmdAdd() *cobra.Command {
+	return &cobra.Command{
+		Use:     "add ISSUE-KEY USER...",
+		Short:   "Add adds participants to a customer request",
+		Long:    helpText,
+		Example: examples,
+		Annotations: map[string]string{
+			"help:args": "ISSUE-KEY\tIssue key of the request, eg: ITH-1\n" +
+				"USER...\tAccount ids or usernames of the users to add",
+		},
+		Args: cobra.MinimumNArgs(2),
+		Run:  Add,
+	}
+}
+
+// Add adds participants to a customer request.
+func Add(cmd *cobra.Command, args []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	key := cmdutil.GetJiraIssueKey(viper.GetString("project.key"), args[0])
+
+	err = func() error {
+		s := cmdutil.Info("Adding participants...")
+		defer s.Stop()
+
+		return api.DefaultClient(debug).AddParticipants(key, cmdcommon.GetSDUsersRequest(args[1:]))
+	}()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success("Participants added to request %s", key)
+}
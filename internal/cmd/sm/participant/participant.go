@@ -0,0 +1,28 @@
+package participant
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/sm/participant/add"
+)
+
+const helpText = `Participant manages participants of a customer request. See available commands below.`
+
+// NewCmdParticipant is a participant command.
+func NewCmdParticipant() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "participant",
+		Short:   "Participant manages participants of a customer request",
+		Long:    helpText,
+		Aliases: []string{"participants"},
+		RunE:    participant,
+	}
+
+	cmd.AddCommand(add.NewCmdAdd())
+
+	return &cmd
+}
+
+func participant(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
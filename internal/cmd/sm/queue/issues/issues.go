@@ -0,0 +1,103 @@
+package issues
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/tui"
+)
+
+const (
+	helpText = `Issues lists issues in a service desk queue.`
+	examples = `$ jira sm queue issues ITH 10
+$ jira sm queue issues 1 10 --limit 20 --plain`
+)
+
+// NewCmdIssues is an issues command.
+func NewCmdIssues() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "issues DESK QUEUE-ID",
+		Short:   "Issues lists issues in a service desk queue",
+		Long:    helpText,
+		Example: examples,
+		Annotations: map[string]string{
+			"help:args": "DESK\tService desk id or project key, eg: ITH\n" +
+				"QUEUE-ID\tQueue id, eg: 10",
+		},
+		Args: cobra.ExactArgs(2),
+		Run:  Issues,
+	}
+
+	cmd.Flags().Uint("from", 0, "Index to start fetching issues from")
+	cmd.Flags().UintP("limit", "l", 50, "Maximum number of issues to return")
+	cmd.Flags().Bool("plain", false, "Display output in plain mode")
+	cmd.Flags().Bool("no-headers", false, "Don't display table headers in plain mode")
+
+	return &cmd
+}
+
+// Issues displays issues in a queue.
+func Issues(cmd *cobra.Command, args []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	from, err := cmd.Flags().GetUint("from")
+	cmdutil.ExitIfError(err)
+
+	limit, err := cmd.Flags().GetUint("limit")
+	cmdutil.ExitIfError(err)
+
+	plain, err := cmd.Flags().GetBool("plain")
+	cmdutil.ExitIfError(err)
+
+	noHeaders, err := cmd.Flags().GetBool("no-headers")
+	cmdutil.ExitIfError(err)
+
+	queueID := args[1]
+
+	result, err := func() (*jira.QueueIssuesResult, error) {
+		s := cmdutil.Info("Fetching queue issues...")
+		defer s.Stop()
+
+		client := api.DefaultClient(debug)
+
+		id, err := cmdcommon.ResolveServiceDesk(client, args[0])
+		if err != nil {
+			return nil, err
+		}
+		return client.QueueIssues(id, queueID, from, limit)
+	}()
+	cmdutil.ExitIfError(err)
+
+	if len(result.Issues) == 0 {
+		cmdutil.Failed("No issues found in the queue.")
+		return
+	}
+
+	if tui.IsDumbTerminal() || tui.IsNotTTY() {
+		plain = true
+	}
+
+	v := view.IssueList{
+		Project: viper.GetString("project.key"),
+		Server:  viper.GetString("server"),
+		Data:    result.Issues,
+		Display: view.DisplayFormat{
+			Plain:      plain,
+			Delimiter:  "\t",
+			NoHeaders:  noHeaders,
+			TableStyle: cmdutil.GetTUIStyleConfig(),
+			Timezone:   viper.GetString("timezone"),
+		},
+		FooterText: fmt.Sprintf("Showing %d issues from queue %s", len(result.Issues), queueID),
+	}
+
+	cmdutil.ExitIfError(v.Render())
+}
@@ -0,0 +1,68 @@
+package list
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `List lists queues of a service desk.
+
+The desk can either be a service desk id or a project key and defaults to the configured project.`
+	examples = `$ jira sm queue list
+$ jira sm queue list ITH
+$ jira sm queue list 1`
+)
+
+// NewCmdList is a list command.
+func NewCmdList() *cobra.Command {
+	return &cobra.Command{
+		Use:     "list [DESK]",
+		Short:   "List lists queues of a service desk",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"lists", "ls"},
+		Annotations: map[string]string{
+			"help:args": "[DESK]\tService desk id or project key, eg: ITH",
+		},
+		Args: cobra.MaximumNArgs(1),
+		Run:  List,
+	}
+}
+
+// List displays a list of queues.
+func List(cmd *cobra.Command, args []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	var desk string
+	if len(args) > 0 {
+		desk = args[0]
+	}
+
+	queues, err := func() ([]*jira.Queue, error) {
+		s := cmdutil.Info("Fetching queues...")
+		defer s.Stop()
+
+		client := api.DefaultClient(debug)
+
+		id, err := cmdcommon.ResolveServiceDesk(client, desk)
+		if err != nil {
+			return nil, err
+		}
+		return client.Queues(id)
+	}()
+	cmdutil.ExitIfError(err)
+
+	if len(queues) == 0 {
+		cmdutil.Failed("No queues found.")
+		return
+	}
+
+	cmdutil.ExitIfError(view.NewQueue(queues).Render())
+}
@@ -0,0 +1,32 @@
+package queue
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/sm/queue/issues"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/sm/queue/list"
+)
+
+const helpText = `Queue lists service desk queues and the issues in them. See available commands below.`
+
+// NewCmdQueue is a queue command.
+func NewCmdQueue() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "queue",
+		Short:   "Queue lists service desk queues and their issues",
+		Long:    helpText,
+		Aliases: []string{"queues"},
+		RunE:    queue,
+	}
+
+	cmd.AddCommand(
+		list.NewCmdList(),
+		issues.NewCmdIssues(),
+	)
+
+	return &cmd
+}
+
+func queue(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
@@ -0,0 +1,159 @@
+package create
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Create creates a customer request in a service desk.
+
+The request type can either be a request type id or its name. Use "jira sm request types"
+to list the request types available in a service desk.`
+	examples = `$ jira sm request create --type "Get IT help" --summary "Printer is on fire"
+
+# Create a request in another desk on behalf of a customer
+$ jira sm request create --desk HR --type 25 -s"New laptop" -b"Need a new laptop" --on-behalf-of 5b10ac8d82e05b22cc7d4ef5
+
+# Set additional request field values
+$ jira sm request create --type 25 -s"VPN down" --field customfield_10010=high --field duedate=2026-01-31`
+)
+
+// NewCmdCreate is a create command.
+func NewCmdCreate() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "create",
+		Short:   "Create creates a customer request",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"add"},
+		Run:     Create,
+	}
+
+	cmd.Flags().String("desk", "", "Service desk id or project key (defaults to the configured project)")
+	cmd.Flags().StringP("type", "t", "", "Request type id or name")
+	cmd.Flags().StringP("summary", "s", "", "Request summary")
+	cmd.Flags().StringP("body", "b", "", "Request description")
+	cmd.Flags().StringToString("field", map[string]string{}, "Set request field values, eg: --field duedate=2026-01-31")
+	cmd.Flags().String("on-behalf-of", "", "Raise the request on behalf of a customer")
+	cmd.Flags().StringArray("participant", []string{}, "Request participants")
+
+	return &cmd
+}
+
+// Create creates a customer request.
+func Create(cmd *cobra.Command, _ []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	params := parseFlags(cmd.Flags())
+	if params.requestType == "" {
+		cmdutil.Failed("Request type is required")
+	}
+
+	resp, err := func() (*jira.CustomerRequest, error) {
+		s := cmdutil.Info("Creating customer request...")
+		defer s.Stop()
+
+		client := api.DefaultClient(debug)
+
+		deskID, err := cmdcommon.ResolveServiceDesk(client, params.desk)
+		if err != nil {
+			return nil, err
+		}
+		typeID, err := resolveRequestType(client, deskID, params.requestType)
+		if err != nil {
+			return nil, err
+		}
+
+		return client.CreateCustomerRequest(&jira.CreateCustomerRequest{
+			ServiceDeskID:       deskID,
+			RequestTypeID:       typeID,
+			RequestFieldValues:  fieldValues(params),
+			RaiseOnBehalfOf:     params.onBehalfOf,
+			RequestParticipants: params.participants,
+		})
+	}()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success("Request created\n%s", cmdutil.GenerateServerBrowseURL(viper.GetString("server"), resp.IssueKey))
+}
+
+func fieldValues(params *createParams) map[string]interface{} {
+	values := make(map[string]interface{}, len(params.fields)+2)
+	if params.summary != "" {
+		values["summary"] = params.summary
+	}
+	if params.body != "" {
+		values["description"] = params.body
+	}
+	for k, v := range params.fields {
+		values[k] = v
+	}
+	return values
+}
+
+func resolveRequestType(client *jira.Client, deskID, requestType string) (string, error) {
+	types, err := client.RequestTypes(deskID)
+	if err != nil {
+		return "", err
+	}
+	for _, t := range types {
+		if t.ID == requestType || strings.EqualFold(t.Name, requestType) {
+			return t.ID, nil
+		}
+	}
+	return "", fmt.Errorf("request type %q not found", requestType)
+}
+
+type createParams struct {
+	desk         string
+	requestType  string
+	summary      string
+	body         string
+	fields       map[string]string
+	onBehalfOf   string
+	participants []string
+}
+
+func parseFlags(flags query.FlagParser) *createParams {
+	desk, err := flags.GetString("desk")
+	cmdutil.ExitIfError(err)
+
+	requestType, err := flags.GetString("type")
+	cmdutil.ExitIfError(err)
+
+	summary, err := flags.GetString("summary")
+	cmdutil.ExitIfError(err)
+
+	body, err := flags.GetString("body")
+	cmdutil.ExitIfError(err)
+
+	fields, err := flags.GetStringToString("field")
+	cmdutil.ExitIfError(err)
+
+	onBehalfOf, err := flags.GetString("on-behalf-of")
+	cmdutil.ExitIfError(err)
+
+	participants, err := flags.GetStringArray("participant")
+	cmdutil.ExitIfError(err)
+
+	return &createParams{
+		desk:         desk,
+		requestType:  requestType,
+		summary:      summary,
+		body:         body,
+		fields:       fields,
+		onBehalfOf:   onBehalfOf,
+		participants: participants,
+	}
+}
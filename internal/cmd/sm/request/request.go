@@ -0,0 +1,32 @@
+package request
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/sm/request/create"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/sm/request/types"
+)
+
+const helpText = `Request manages customer requests of a service desk. See available commands below.`
+
+// NewCmdRequest is a request command.
+func NewCmdRequest() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "request",
+		Short:   "Request manages customer requests",
+		Long:    helpText,
+		Aliases: []string{"requests", "req"},
+		RunE:    request,
+	}
+
+	cmd.AddCommand(
+		create.NewCmdCreate(),
+		types.NewCmdTypes(),
+	)
+
+	return &cmd
+}
+
+func request(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
@@ -0,0 +1,62 @@
+package types
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const examples = `$ jira sm request types
+$ jira sm request types ITH`
+
+// NewCmdTypes is a request types command.
+func NewCmdTypes() *cobra.Command {
+	return &cobra.Command{
+		Use:     "types [DESK]",
+		Short:   "Types lists customer request types of a service desk",
+		Long:    "Types lists customer request types of a service desk.",
+		Example: examples,
+		Aliases: []string{"type"},
+		Annotations: map[string]string{
+			"help:args": "[DESK]\tService desk id or project key, eg: ITH",
+		},
+		Args: cobra.MaximumNArgs(1),
+		Run:  Types,
+	}
+}
+
+// Types displays a list of request types.
+func Types(cmd *cobra.Command, args []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	var desk string
+	if len(args) > 0 {
+		desk = args[0]
+	}
+
+	types, err := func() ([]*jira.RequestType, error) {
+		s := cmdutil.Info("Fetching request types...")
+		defer s.Stop()
+
+		client := api.DefaultClient(debug)
+
+		id, err := cmdcommon.ResolveServiceDesk(client, desk)
+		if err != nil {
+			return nil, err
+		}
+		return client.RequestTypes(id)
+	}()
+	cmdutil.ExitIfError(err)
+
+	if len(types) == 0 {
+		cmdutil.Failed("No request types found.")
+		return
+	}
+
+	cmdutil.ExitIfError(view.NewRequestType(types).Render())
+}
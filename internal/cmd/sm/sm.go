@@ -0,0 +1,41 @@
+package sm
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/sm/approval"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/sm/customer"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/sm/desks"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/sm/participant"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/sm/queue"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/sm/request"
+)
+
+const helpText = `Sm manages Jira Service Management desks, queues and customer requests. See available commands below.`
+
+// NewCmdSM is a service management command.
+func NewCmdSM() *cobra.Command {
+	cmd := cobra.Command{
+		Use:         "sm",
+		Short:       "Sm manages Jira Service Management desks and requests",
+		Long:        helpText,
+		Annotations: map[string]string{"cmd:main": "true"},
+		Aliases:     []string{"servicedesk", "jsm"},
+		RunE:        sm,
+	}
+
+	cmd.AddCommand(
+		desks.NewCmdDesks(),
+		queue.NewCmdQueue(),
+		request.NewCmdRequest(),
+		customer.NewCmdCustomer(),
+		participant.NewCmdParticipant(),
+		approval.NewCmdApproval(),
+	)
+
+	return &cmd
+}
+
+func sm(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
@@ -11,9 +11,11 @@ import (
 
 	"github.com/ankitpokhrel/jira-cli/api"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/list"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/internal/query"
 	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/internal/watch"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
 	"github.com/ankitpokhrel/jira-cli/pkg/tui"
 )
@@ -42,6 +44,9 @@ $ jira sprint list <SPRINT_ID> --no-headers
 $ jira sprint list --table --plain --columns name,start,end
 $ jira sprint list <SPRINT_ID> --plain --columns type,key,summary
 
+# Watch issues in the current sprint and highlight changes
+$ jira sprint list --current --watch
+
 # Display sprint issues in a plain table view and show all fields
 $ jira sprint list <SPRINT_ID> --plain --no-truncate`
 )
@@ -82,29 +87,39 @@ func sprintList(cmd *cobra.Command, args []string) {
 	sprintQuery, err := query.NewSprint(cmd.Flags())
 	cmdutil.ExitIfError(err)
 
+	w, err := cmdcommon.GetWatch(cmd)
+	cmdutil.ExitIfError(err)
+
 	if len(args) == 0 {
-		sprintExplorerView(sprintQuery, cmd.Flags(), boardID, project, server, client)
+		params := sprintQuery.Params()
+		if w.Enabled() && !params.Current && !params.Prev && !params.Next {
+			cmdutil.Failed("Error: --watch works only with a sprint ID, --current, --prev or --next")
+		}
+		sprintExplorerView(sprintQuery, cmd.Flags(), boardID, project, server, client, w)
 	} else {
 		sprintID, err := strconv.Atoi(args[0])
 		cmdutil.ExitIfError(err)
 
-		singleSprintView(sprintQuery, cmd.Flags(), boardID, sprintID, project, server, client, nil)
+		singleSprintView(sprintQuery, cmd.Flags(), boardID, sprintID, project, server, client, nil, w)
 	}
 }
 
-func singleSprintView(sprintQuery *query.Sprint, flags query.FlagParser, boardID, sprintID int, project, server string, client *jira.Client, sprint *jira.Sprint) {
+func singleSprintView(
+	sprintQuery *query.Sprint, flags query.FlagParser, boardID, sprintID int, project, server string,
+	client *jira.Client, sprint *jira.Sprint, w cmdcommon.Watch,
+) {
+	q, err := query.NewIssue(project, flags)
+	cmdutil.ExitIfError(err)
+	if sprintQuery.Params().ShowAllIssues {
+		q.Params().JQL = "project IS NOT EMPTY"
+	}
+	jql := q.Get()
+
 	issues, err := func() ([]*jira.Issue, error) {
 		s := cmdutil.Info("Fetching sprint issues...")
 		defer s.Stop()
 
-		q, err := query.NewIssue(project, flags)
-		if err != nil {
-			return nil, err
-		}
-		if sprintQuery.Params().ShowAllIssues {
-			q.Params().JQL = "project IS NOT EMPTY"
-		}
-		resp, err := client.SprintIssues(sprintID, q.Get(), q.Params().From, q.Params().Limit)
+		resp, err := client.SprintIssues(sprintID, jql, q.Params().From, q.Params().Limit)
 		if err != nil {
 			return nil, err
 		}
@@ -167,7 +182,7 @@ func singleSprintView(sprintQuery *query.Sprint, flags query.FlagParser, boardID
 		Data:       issues,
 		FooterText: ft,
 		Refresh: func() {
-			singleSprintView(sprintQuery, flags, boardID, sprintID, project, server, client, nil)
+			singleSprintView(sprintQuery, flags, boardID, sprintID, project, server, client, nil, w)
 		},
 		Display: view.DisplayFormat{
 			Plain:        plain,
@@ -187,10 +202,34 @@ func singleSprintView(sprintQuery *query.Sprint, flags query.FlagParser, boardID
 		},
 	}
 
+	if w.Enabled() {
+		search := func(jql string) ([]*jira.Issue, error) {
+			resp, err := client.SprintIssues(sprintID, jql, 0, q.Params().Limit)
+			if err != nil {
+				return nil, err
+			}
+			return resp.Issues, nil
+		}
+		// Issues moved out of the sprint are only found outside of it.
+		lookup := func(jql string) ([]*jira.Issue, error) {
+			resp, err := api.ProxySearch(client, jql, 0, q.Params().Limit)
+			if err != nil {
+				return nil, err
+			}
+			return resp.Issues, nil
+		}
+		v.Watch = w.Poll(watch.NewPoller(jql, search, lookup), fmt.Sprintf("Sprint #%d", sprintID), issues)
+		v.WatchInterval = w.Interval
+		v.WatchContext = w.Context
+	}
+
 	cmdutil.ExitIfError(v.Render())
 }
 
-func sprintExplorerView(sprintQuery *query.Sprint, flags query.FlagParser, boardID int, project, server string, client *jira.Client) {
+func sprintExplorerView(
+	sprintQuery *query.Sprint, flags query.FlagParser, boardID int, project, server string,
+	client *jira.Client, w cmdcommon.Watch,
+) {
 	sprints := func() []*jira.Sprint {
 		s := cmdutil.Info("Fetching sprints...")
 		defer s.Stop()
@@ -208,7 +247,7 @@ func sprintExplorerView(sprintQuery *query.Sprint, flags query.FlagParser, board
 		if sprintQuery.Params().Next {
 			sprint = sprints[len(sprints)-1]
 		}
-		singleSprintView(sprintQuery, flags, boardID, sprint.ID, project, server, client, sprint)
+		singleSprintView(sprintQuery, flags, boardID, sprint.ID, project, server, client, sprint, w)
 		return
 	}
 
@@ -289,6 +328,8 @@ func setFlags(cmd *cobra.Command) {
 	cmd.Flags().Bool("current", false, "List issues in current active sprint")
 	cmd.Flags().Bool("prev", false, "List issues in previous sprint")
 	cmd.Flags().Bool("next", false, "List issues in next planned sprint")
+
+	cmdcommon.SetWatchFlags(cmd)
 }
 
 func hideFlags(cmd *cobra.Command) {
@@ -0,0 +1,292 @@
+package blockers
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/graph"
+	"github.com/ankitpokhrel/jira-cli/internal/workflow"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Blockers shows what is stopping the issues in an epic or a sprint.
+
+It lists issues blocked by unresolved issues outside of the epic or sprint, issues
+that are in progress while still blocked, and the critical path: the longest chain
+of unresolved issues blocking each other, weighted by story points. Issues that
+block each other in a cycle are reported as well.
+
+Story points are read from the custom field configured for story points, see
+'jira issue story-points'. Without it, every issue on the critical path weighs 1.
+Statuses are mapped to todo, in progress and done using the workflow config.`
+	examples = `# Show blockers of the active sprint of the configured board
+$ jira stats blockers
+
+# Show blockers of a sprint
+$ jira stats blockers --sprint 123
+
+# Show blockers of an epic
+$ jira stats blockers --epic PROJ-42
+
+# Use a custom link type
+$ jira stats blockers --epic PROJ-42 --link-type Dependency`
+
+	pageSize     = 100
+	defaultLimit = 2000
+	pointsChunk  = 100
+)
+
+// NewCmdBlockers is a blockers stats command.
+func NewCmdBlockers() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "blockers",
+		Short:   "Find blocked work and the critical path of an epic or sprint",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"blocked", "critical-path"},
+		Args:    cobra.NoArgs,
+		RunE:    blockers,
+	}
+
+	cmd.Flags().String("epic", "", "Epic key to analyze")
+	cmd.Flags().Int("sprint", 0, "Sprint ID to analyze (defaults to the active sprint of the configured board)")
+	cmd.Flags().String("link-type", graph.TypeBlocks, "Name of the link type that blocks issues")
+	cmd.Flags().String("points-field", "", "Custom field name for story points (overrides config)")
+	cmd.Flags().Uint("limit", defaultLimit, "Maximum number of issues of the epic or sprint to analyze, 0 analyzes all")
+
+	return &cmd
+}
+
+func blockers(cmd *cobra.Command, _ []string) error {
+	debug, _ := cmd.Flags().GetBool("debug")
+	epic, _ := cmd.Flags().GetString("epic")
+	sprintID, _ := cmd.Flags().GetInt("sprint")
+	linkType, _ := cmd.Flags().GetString("link-type")
+	pointsField, _ := cmd.Flags().GetString("points-field")
+	limit, _ := cmd.Flags().GetUint("limit")
+
+	if epic != "" && sprintID != 0 {
+		return fmt.Errorf("--epic and --sprint cannot be used together")
+	}
+
+	wf, err := workflow.FromConfig()
+	if err != nil {
+		return err
+	}
+
+	client := api.DefaultClient(debug)
+
+	var title string
+	if epic != "" {
+		epic = cmdutil.GetJiraIssueKey(viper.GetString("project.key"), epic)
+		title = fmt.Sprintf("Epic %s", epic)
+	} else {
+		if sprintID == 0 {
+			if sprintID, err = activeSprint(client); err != nil {
+				return err
+			}
+		}
+		title = fmt.Sprintf("Sprint %d", sprintID)
+	}
+
+	s := cmdutil.Info(fmt.Sprintf("Analyzing blockers of %s...", strings.ToLower(title)))
+	defer s.Stop()
+
+	issues, truncated, err := fetchIssues(client, epic, sprintID, limit)
+	if err != nil {
+		return err
+	}
+	if len(issues) == 0 {
+		s.Stop()
+		fmt.Printf("No issues found in %s\n", strings.ToLower(title))
+		return nil
+	}
+
+	points, err := fetchPoints(client, issues, pointsField)
+	if err != nil {
+		return err
+	}
+
+	report := graph.Blockers(issues, linkType, points, wf)
+
+	s.Stop()
+
+	if truncated {
+		cmdutil.Warn("Analyzed only the first %d issues of %s, blockers of the rest are missing. Use --limit to include more",
+			limit, title)
+	}
+	render(report, title, points != nil)
+
+	return nil
+}
+
+func activeSprint(client *jira.Client) (int, error) {
+	boardID := viper.GetInt("board.id")
+	if boardID == 0 {
+		return 0, fmt.Errorf("--epic or --sprint required or configure board.id in config")
+	}
+
+	sprints, err := client.Sprints(boardID, "state=active", 0, 1)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get sprints: %w", err)
+	}
+	if len(sprints.Sprints) == 0 {
+		return 0, fmt.Errorf("no active sprint found. Please specify --sprint or --epic")
+	}
+	return sprints.Sprints[0].ID, nil
+}
+
+// fetchIssues fetches up to limit issues of the epic or sprint, or all of them if
+// limit is 0. It tells if there were more issues than the limit.
+func fetchIssues(client *jira.Client, epic string, sprintID int, limit uint) ([]*jira.Issue, bool, error) {
+	var issues []*jira.Issue
+
+	for from := uint(0); ; from += pageSize {
+		size := uint(pageSize)
+		if limit > 0 {
+			size = min(size, limit-from)
+		}
+
+		var (
+			res *jira.SearchResult
+			err error
+		)
+		if epic != "" {
+			res, err = client.EpicIssues(epic, "", from, size)
+		} else {
+			res, err = client.SprintIssues(sprintID, "", from, size)
+		}
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to get issues: %w", err)
+		}
+
+		issues = append(issues, res.Issues...)
+		if len(res.Issues) < int(size) || (res.Total > 0 && len(issues) >= res.Total) {
+			return issues, false, nil
+		}
+		if limit > 0 && uint(len(issues)) >= limit {
+			// The total isn't known if the response doesn't include it.
+			return issues, res.Total == 0 || res.Total > len(issues), nil
+		}
+	}
+}
+
+// fetchPoints returns story points of the issues. It returns nil if the
+// story points field is not configured.
+func fetchPoints(client *jira.Client, issues []*jira.Issue, name string) (map[string]float64, error) {
+	configuredFields, err := cmdcommon.GetConfiguredCustomFields()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get configured custom fields: %w", err)
+	}
+
+	field := cmdcommon.FindStoryPointsField(configuredFields, name)
+	if field == nil {
+		if name != "" {
+			return nil, fmt.Errorf("custom field %q not found in configuration", name)
+		}
+		return nil, nil
+	}
+
+	points := make(map[string]float64, len(issues))
+	for i := 0; i < len(issues); i += pointsChunk {
+		chunk := issues[i:min(i+pointsChunk, len(issues))]
+
+		keys := make([]string, 0, len(chunk))
+		for _, iss := range chunk {
+			keys = append(keys, iss.Key)
+		}
+
+		jql := fmt.Sprintf("key IN (%s)", strings.Join(keys, ", "))
+		values, err := api.ProxySearchFieldValues(client, jql, field.Key, pointsChunk)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get story points: %w", err)
+		}
+
+		for key, raw := range values {
+			var v float64
+			if err := json.Unmarshal(raw, &v); err == nil {
+				points[key] = v
+			}
+		}
+	}
+
+	return points, nil
+}
+
+func render(r *graph.BlockerReport, title string, withPoints bool) {
+	fmt.Printf("\nBlockers: %s (%d issues)\n", title, r.Total)
+	fmt.Println(strings.Repeat("─", 60))
+
+	fmt.Printf("\nBlocked by unresolved issues outside the %s:\n", strings.ToLower(strings.Fields(title)[0]))
+	renderBlocked(r.External)
+
+	fmt.Println("\nIn progress while blocked:")
+	renderBlocked(r.InProgress)
+
+	switch {
+	case len(r.CriticalPath) == 0:
+		fmt.Println("\nCritical path:")
+		fmt.Println("  None")
+	case withPoints:
+		fmt.Printf("\nCritical path (%s points, %d issues):\n", formatWeight(r.CriticalWeight), len(r.CriticalPath))
+	default:
+		fmt.Printf("\nCritical path (%d issues):\n", len(r.CriticalPath))
+	}
+	if len(r.CriticalPath) > 0 {
+		for i, key := range r.CriticalPath {
+			n, _ := r.Graph.Node(key)
+			prefix := "  "
+			if i > 0 {
+				prefix = "  → "
+			}
+			fmt.Printf("%s%s\n", prefix, describe(n))
+		}
+	}
+
+	if len(r.Cycles) > 0 {
+		fmt.Println("\nBlocking cycles:")
+		for _, c := range r.Cycles {
+			fmt.Printf("  %s → %s\n", strings.Join(c, " → "), c[0])
+		}
+	}
+
+	fmt.Println()
+	fmt.Println(strings.Repeat("─", 60))
+	fmt.Println()
+}
+
+func renderBlocked(blocked []graph.BlockedIssue) {
+	if len(blocked) == 0 {
+		fmt.Println("  None")
+		return
+	}
+	for _, b := range blocked {
+		fmt.Printf("  %s\n", describe(b.Issue))
+		for _, by := range b.By {
+			fmt.Printf("      blocked by %s\n", describe(by))
+		}
+	}
+}
+
+func describe(n *graph.Node) string {
+	out := n.Key
+	if n.Summary != "" {
+		out += " " + n.Summary
+	}
+	if n.Status != "" {
+		out += " [" + n.Status + "]"
+	}
+	return out
+}
+
+func formatWeight(w float64) string {
+	return strings.TrimSuffix(strings.TrimRight(fmt.Sprintf("%.1f", w), "0"), ".")
+}
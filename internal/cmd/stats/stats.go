@@ -4,6 +4,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/stats/assigned"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/stats/blockers"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/stats/sprint"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/stats/velocity"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/stats/worklog"
@@ -27,6 +28,7 @@ func NewCmdStats() *cobra.Command {
 		velocity.NewCmdVelocity(),
 		worklog.NewCmdWorklog(),
 		assigned.NewCmdAssigned(),
+		blockers.NewCmdBlockers(),
 	)
 
 	return &cmd
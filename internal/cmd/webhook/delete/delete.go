@@ -0,0 +1,80 @@
+package delete
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const examples = `$ jira webhook delete 7
+$ jira webhook delete "Local automation"`
+
+// NewCmdDelete is a delete command.
+func NewCmdDelete() *cobra.Command {
+	return &cobra.Command{
+		Use:     "delete WEBHOOK",
+		Short:   "Delete deletes a registered webhook",
+		Long:    "Delete deletes a webhook registered in Jira.",
+		Example: examples,
+		Aliases: []string{"remove", "rm", "unregister"},
+		Annotations: map[string]string{
+			"help:args": "WEBHOOK\tWebhook id or name, eg: 7",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  Delete,
+	}
+}
+
+// Delete deletes a webhook.
+func Delete(cmd *cobra.Command, args []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	client := api.DefaultClient(debug)
+
+	err = func() error {
+		s := cmdutil.Info("Deleting webhook...")
+		defer s.Stop()
+
+		id, err := resolve(client, args[0])
+		if err != nil {
+			return err
+		}
+		return client.DeleteWebhook(id)
+	}()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success("Webhook %s deleted successfully", args[0])
+}
+
+// resolve returns id of the webhook with the given id or name.
+func resolve(client *jira.Client, idOrName string) (string, error) {
+	if _, err := strconv.Atoi(idOrName); err == nil {
+		return idOrName, nil
+	}
+
+	webhooks, err := client.GetWebhooks()
+	if err != nil {
+		return "", err
+	}
+
+	var ids []string
+	for _, wh := range webhooks {
+		if strings.EqualFold(wh.Name, idOrName) {
+			ids = append(ids, wh.ID())
+		}
+	}
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("webhook %q not found", idOrName)
+	case 1:
+		return ids[0], nil
+	}
+	return "", fmt.Errorf("multiple webhooks named %q, use one of the ids: %s", idOrName, strings.Join(ids, ", "))
+}
@@ -0,0 +1,69 @@
+package list
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const examples = `$ jira webhook list
+
+# List webhooks in plain mode
+$ jira webhook list --plain
+
+# Get the raw JSON data
+$ jira webhook list --output json`
+
+// NewCmdList is a list command.
+func NewCmdList() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "list",
+		Short:   "List lists registered webhooks",
+		Long:    "List lists webhooks registered in Jira. Managing webhooks requires admin permissions.",
+		Example: examples,
+		Aliases: []string{"lists", "ls"},
+		Args:    cobra.NoArgs,
+		Run:     List,
+	}
+
+	cmd.Flags().Bool("plain", false, "Display output in plain mode")
+	cmd.Flags().String("output", "", "Output format: json (default: table)")
+
+	return &cmd
+}
+
+// List displays a list view.
+func List(cmd *cobra.Command, _ []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	plain, err := cmd.Flags().GetBool("plain")
+	cmdutil.ExitIfError(err)
+
+	output, err := cmd.Flags().GetString("output")
+	cmdutil.ExitIfError(err)
+
+	webhooks, err := func() ([]*jira.Webhook, error) {
+		s := cmdutil.Info("Fetching webhooks...")
+		defer s.Stop()
+
+		return api.DefaultClient(debug).GetWebhooks()
+	}()
+	cmdutil.ExitIfError(err)
+
+	if len(webhooks) == 0 && output != "json" {
+		cmdutil.Failed("No webhooks found.")
+		return
+	}
+
+	v := view.NewWebhook(
+		webhooks,
+		view.WithWebhookPlain(plain),
+		view.WithWebhookJSON(output == "json"),
+	)
+
+	cmdutil.ExitIfError(v.Render())
+}
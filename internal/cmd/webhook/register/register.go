@@ -0,0 +1,130 @@
+package register
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/webhook"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Register registers a webhook in Jira that sends events to the given URL.
+
+All events supported by 'jira webhook serve' are sent by default. Issue and comment
+events can be limited to issues matching a JQL with --jql.
+
+Payloads are signed with the secret set with --secret, the JIRA_WEBHOOK_SECRET env var
+or the webhook.secret config, if the Jira instance supports signing. Registering
+webhooks requires admin permissions.`
+	examples = `$ jira webhook register "Local automation" https://hooks.example.com/jira
+
+# Send only issue events of a project
+$ jira webhook register "ABC issues" https://hooks.example.com/jira --event jira:issue_created --event jira:issue_updated --jql "project = ABC"
+
+# Send sprint events, signed with a secret
+$ jira webhook register Sprints https://hooks.example.com/jira --event sprint_started --event sprint_closed --secret s3cret`
+)
+
+// NewCmdRegister is a register command.
+func NewCmdRegister() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "register NAME URL",
+		Short:   "Register registers a webhook in Jira",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"create", "add"},
+		Annotations: map[string]string{
+			"help:args": "NAME\tName of the webhook\n" +
+				"URL\tURL events are sent to, eg: the public address of 'jira webhook serve'",
+		},
+		Args: cobra.ExactArgs(2),
+		Run:  Register,
+	}
+
+	cmd.Flags().StringArray("event", nil, fmt.Sprintf("Event to send, can be repeated (default: all)\nAccepts: %s", strings.Join(jira.WebhookEvents, ", ")))
+	cmd.Flags().String("jql", "", "Send issue and comment events only for issues matching the JQL")
+	cmd.Flags().String("secret", "", "Secret to sign payloads with (defaults to JIRA_WEBHOOK_SECRET or the webhook.secret config)")
+	cmd.Flags().Bool("exclude-body", false, "Send events without the payload")
+	cmd.Flags().Bool("disabled", false, "Register the webhook disabled")
+
+	return &cmd
+}
+
+// Register registers a webhook.
+func Register(cmd *cobra.Command, args []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	wh, err := parseFlags(cmd, args)
+	cmdutil.ExitIfError(err)
+
+	created, err := func() (*jira.Webhook, error) {
+		s := cmdutil.Info("Registering webhook...")
+		defer s.Stop()
+
+		return api.DefaultClient(debug).CreateWebhook(wh)
+	}()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success("Webhook %q registered with id %s", wh.Name, created.ID())
+}
+
+func parseFlags(cmd *cobra.Command, args []string) (*jira.Webhook, error) {
+	u, err := url.Parse(args[1])
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("invalid webhook url %q", args[1])
+	}
+
+	events, err := cmd.Flags().GetStringArray("event")
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range events {
+		if !slices.Contains(jira.WebhookEvents, e) {
+			return nil, fmt.Errorf("unknown event %q, valid events are: %s", e, strings.Join(jira.WebhookEvents, ", "))
+		}
+	}
+	if len(events) == 0 {
+		events = jira.WebhookEvents
+	}
+
+	jql, err := cmd.Flags().GetString("jql")
+	if err != nil {
+		return nil, err
+	}
+	secret, err := cmd.Flags().GetString("secret")
+	if err != nil {
+		return nil, err
+	}
+	if secret == "" {
+		secret = webhook.SecretFromConfig()
+	}
+	excludeBody, err := cmd.Flags().GetBool("exclude-body")
+	if err != nil {
+		return nil, err
+	}
+	disabled, err := cmd.Flags().GetBool("disabled")
+	if err != nil {
+		return nil, err
+	}
+
+	wh := jira.Webhook{
+		Name:        args[0],
+		URL:         u.String(),
+		Events:      events,
+		ExcludeBody: excludeBody,
+		Enabled:     !disabled,
+		Secret:      secret,
+	}
+	if jql != "" {
+		wh.Filters = map[string]string{jira.WebhookJQLFilter: jql}
+	}
+	return &wh, nil
+}
@@ -0,0 +1,157 @@
+package serve
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/logger"
+	"github.com/ankitpokhrel/jira-cli/internal/webhook"
+)
+
+const (
+	helpText = `Serve receives Jira webhook events and runs the handlers configured for them.
+
+Handlers are defined in the webhook section of the config. Each handler runs a shell
+command with the event JSON on stdin for events it matches. Events are matched by
+name, and by fields of the issue or sprint in the event:
+
+  webhook:
+    secret: s3cret
+    handlers:
+      - name: done
+        events: [jira:issue_updated]
+        match:
+          project: ABC
+          changed: status
+          status: Done
+        run: jq -r .issue.key >> ~/done.txt
+      - name: sprint-report
+        events: [sprint_closed]
+        run: ./sprint-report.sh "$JIRA_SPRINT_ID"
+
+Event names and match values support glob patterns, and a value prefixed with ~
+negates the match. Fields: project, key, type, status, priority, assignee, reporter,
+label, component, changed, user, sprint, sprint_state and board.
+
+Commands get JIRA_WEBHOOK_EVENT, JIRA_WEBHOOK_HANDLER, JIRA_ISSUE_KEY and JIRA_SPRINT_ID
+env vars. Payload signatures in the X-Hub-Signature header are verified with the secret
+set with --secret, the JIRA_WEBHOOK_SECRET env var or the webhook.secret config.
+
+Handlers run shell commands, so the server listens only on localhost by default and
+refuses to start without a secret. Use --insecure to accept unsigned payloads, eg: for
+local testing.`
+	examples = `$ jira webhook serve --secret s3cret
+
+# Listen on a custom address and path
+$ jira webhook serve --addr 127.0.0.1:9000 --path /jira
+
+# Accept unsigned payloads for local testing
+$ jira webhook serve --insecure`
+
+	defaultAddr = "127.0.0.1:8080"
+
+	readHeaderTimeout = 10 * time.Second
+	shutdownTimeout   = 30 * time.Second
+)
+
+// NewCmdServe is a serve command.
+func NewCmdServe() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "serve",
+		Short:   "Serve receives Jira webhook events and runs handlers",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"listen"},
+		Args:    cobra.NoArgs,
+		RunE:    serve,
+	}
+
+	cmd.Flags().String("addr", defaultAddr, "Address to listen on")
+	cmd.Flags().String("path", "/", "URL path to receive events on")
+	cmd.Flags().String("secret", "", "Secret to verify payload signatures with\n"+
+		"Defaults to JIRA_WEBHOOK_SECRET or the webhook.secret config")
+	cmd.Flags().Duration("handler-timeout", webhook.DefaultTimeout, "Time a handler command is allowed to run")
+	cmd.Flags().Bool("insecure", false, "Start without a secret and accept payloads without verifying signatures")
+
+	return &cmd
+}
+
+func serve(cmd *cobra.Command, _ []string) error {
+	debug, _ := cmd.Flags().GetBool("debug")
+	addr, _ := cmd.Flags().GetString("addr")
+	path, _ := cmd.Flags().GetString("path")
+	secret, _ := cmd.Flags().GetString("secret")
+	timeout, _ := cmd.Flags().GetDuration("handler-timeout")
+	insecure, _ := cmd.Flags().GetBool("insecure")
+
+	if secret == "" {
+		secret = webhook.SecretFromConfig()
+	}
+	if secret == "" && !insecure {
+		cmd.SilenceUsage = true
+		return errors.New("no secret configured: set one with --secret, JIRA_WEBHOOK_SECRET or the webhook.secret config, " +
+			"or pass --insecure to accept unsigned payloads")
+	}
+
+	handlers, err := webhook.HandlersFromConfig()
+	if err != nil {
+		return err
+	}
+	if len(handlers) == 0 {
+		cmdutil.Warn("No webhook handlers configured, events will only be logged")
+	}
+	if secret == "" {
+		cmdutil.Warn("Running without a secret, payload signatures won't be verified")
+	}
+
+	s := &webhook.Server{
+		Secret:   secret,
+		Handlers: handlers,
+		Timeout:  timeout,
+		Log:      logger.New(debug),
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(path, s)
+
+	srv := &http.Server{
+		Addr:              addr,
+		Handler:           mux,
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- srv.ListenAndServe()
+	}()
+	s.Log.Info("Listening for webhook events on %s%s with %d handler(s)", addr, path, len(handlers))
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+	}
+
+	s.Log.Info("Shutting down, waiting for running handlers")
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err := srv.Shutdown(shutdownCtx); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	s.Wait()
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package webhook
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/webhook/delete"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/webhook/list"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/webhook/register"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/webhook/serve"
+)
+
+const helpText = `Webhook receives Jira webhook events and manages registered webhooks. See available commands below.`
+
+// NewCmdWebhook is a webhook command.
+func NewCmdWebhook() *cobra.Command {
+	cmd := cobra.Command{
+		Use:         "webhook",
+		Short:       "Webhook receives Jira events and manages webhooks",
+		Long:        helpText,
+		Annotations: map[string]string{"cmd:main": "true"},
+		Aliases:     []string{"webhooks", "hook"},
+		RunE:        webhooks,
+	}
+
+	cmd.AddCommand(
+		serve.NewCmdServe(),
+		register.NewCmdRegister(),
+		list.NewCmdList(),
+		delete.NewCmdDelete(),
+	)
+
+	return &cmd
+}
+
+func webhooks(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
@@ -0,0 +1,54 @@
+package cmdcommon
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// ResolveComponent finds a project component by its id or name.
+func ResolveComponent(client *jira.Client, project, component string) (*jira.Component, error) {
+	components, err := client.Components(project)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range components {
+		if c.ID == component || strings.EqualFold(c.Name, component) {
+			return c, nil
+		}
+	}
+	return nil, fmt.Errorf("component %q not found in project %q", component, project)
+}
+
+// SetComponentLead sets component lead based on the jira installation type.
+func SetComponentLead(client *jira.Client, project, lead string, req *jira.ComponentRequest) {
+	if lead == "" {
+		return
+	}
+	key := GetRelevantUser(client, project, lead)
+	if viper.GetString("installation") == jira.InstallationTypeLocal {
+		req.LeadUserName = key
+	} else {
+		req.LeadAccountID = key
+	}
+}
+
+// ValidateAssigneeType validates and normalizes default assignee type of a component.
+func ValidateAssigneeType(assigneeType string) (string, error) {
+	if assigneeType == "" {
+		return "", nil
+	}
+	normalized := strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(assigneeType), "-", "_"))
+	switch normalized {
+	case jira.AssigneeTypeProjectDefault, jira.AssigneeTypeComponentLead,
+		jira.AssigneeTypeProjectLead, jira.AssigneeTypeUnassigned:
+		return normalized, nil
+	}
+	return "", fmt.Errorf(
+		"invalid assignee type %q, valid types are: project-default, component-lead, project-lead, unassigned",
+		assigneeType,
+	)
+}
@@ -240,6 +240,28 @@ func GetConfiguredCustomFields() ([]jira.IssueTypeField, error) {
 	return configuredFields, nil
 }
 
+// FindStoryPointsField returns the configured custom field that holds story points.
+// The field is looked up by name if given, otherwise by common story points field names.
+func FindStoryPointsField(configuredFields []jira.IssueTypeField, name string) *jira.IssueTypeField {
+	keywords := []string{"story point", "storypoint", "story-point"}
+
+	for _, f := range configuredFields {
+		if name != "" {
+			if strings.EqualFold(f.Name, name) {
+				return &f
+			}
+			continue
+		}
+		nameLower := strings.ToLower(f.Name)
+		for _, keyword := range keywords {
+			if strings.Contains(nameLower, keyword) {
+				return &f
+			}
+		}
+	}
+	return nil
+}
+
 // ValidateCustomFields validates custom fields and returns an error if invalid fields are found.
 func ValidateCustomFields(fields map[string]string, configuredFields []jira.IssueTypeField) error {
 	if len(fields) == 0 {
@@ -0,0 +1,318 @@
+package cmdcommon
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/jql"
+)
+
+type jqlState int
+
+const (
+	expectField jqlState = iota
+	expectOperator
+	expectValue
+	expectListValue
+	expectEmpty
+	expectConnector
+	expectOrderBy
+	expectOrderField
+	expectDirection
+	expectNothing
+)
+
+// plainJQLValue matches values that don't need to be quoted in JQL.
+var plainJQLValue = regexp.MustCompile(`^[\w.\-:@\[\]]+(\(\))?$`)
+
+// SetJQLCompletion sets shell completion backed by the JQL autocomplete
+// data of Jira for jql flags of the command and its subcommands.
+func SetJQLCompletion(cmd *cobra.Command) {
+	if cmd.Flags().Lookup("jql") != nil {
+		// Commands that share flags of another command share the completion too.
+		_ = cmd.RegisterFlagCompletionFunc("jql", JQLCompletion)
+	}
+	for _, c := range cmd.Commands() {
+		SetJQLCompletion(c)
+	}
+}
+
+// JQLCompletion completes a JQL query with field names, operators, values and keywords.
+func JQLCompletion(_ *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	directive := cobra.ShellCompDirectiveNoSpace | cobra.ShellCompDirectiveNoFileComp
+
+	client := api.DefaultClient(false)
+	data, err := client.JQLAutocompleteData()
+	if err != nil {
+		cobra.CompErrorln(err.Error())
+		return nil, directive
+	}
+
+	c := jqlCompleter{data: data, suggest: client.JQLSuggestions}
+	return c.complete(toComplete), directive
+}
+
+type jqlCompleter struct {
+	data    *jira.JQLAutocompleteData
+	suggest func(field, value string) ([]*jira.JQLSuggestion, error)
+}
+
+func (c jqlCompleter) complete(toComplete string) []string {
+	tokens, _ := jql.Tokenize(toComplete)
+
+	var (
+		prefix  = toComplete
+		partial string
+		sep     string
+	)
+	if n := len(tokens); n > 0 && !endsWithSpace(toComplete) {
+		last := tokens[n-1]
+		switch last.Kind {
+		case jql.TokenWord, jql.TokenString:
+			prefix, partial, tokens = toComplete[:last.Pos], last.Value, tokens[:n-1]
+		case jql.TokenLeftParen:
+		default:
+			sep = " "
+		}
+	}
+
+	state, field, ops := jqlContext(tokens)
+
+	var candidates []string
+	switch state {
+	case expectField:
+		for _, f := range c.data.Fields {
+			if f.Searchable != "false" {
+				candidates = append(candidates, f.Value)
+			}
+		}
+	case expectOperator:
+		candidates = c.operators(field, ops)
+	case expectValue, expectListValue:
+		candidates = c.values(field, partial)
+	case expectEmpty:
+		candidates = []string{"EMPTY", "NULL"}
+		if len(ops) == 0 {
+			candidates = append(candidates, "NOT")
+		}
+	case expectConnector:
+		candidates = []string{"AND", "OR", "ORDER BY"}
+	case expectOrderBy:
+		candidates = []string{"BY"}
+	case expectOrderField:
+		for _, f := range c.data.Fields {
+			if f.Orderable == "true" {
+				candidates = append(candidates, f.Value)
+			}
+		}
+	case expectDirection:
+		candidates = []string{"ASC", "DESC"}
+	}
+
+	needle := strings.ToLower(unquoteJQL(partial))
+
+	out := make([]string, 0, len(candidates))
+	for _, cand := range candidates {
+		value, _, _ := strings.Cut(cand, "\t")
+		if strings.HasPrefix(strings.ToLower(unquoteJQL(value)), needle) {
+			out = append(out, prefix+sep+cand)
+		}
+	}
+	return out
+}
+
+func (c jqlCompleter) field(name string) *jira.JQLField {
+	name = unquoteJQL(name)
+	for _, f := range c.data.Fields {
+		if strings.EqualFold(unquoteJQL(f.Value), name) || strings.EqualFold(f.CfID, name) {
+			return f
+		}
+	}
+	return nil
+}
+
+// operators returns operators of a field that follow operator words typed so far, eg: `was`.
+func (c jqlCompleter) operators(field string, typed []string) []string {
+	ops := []string{"=", "!=", "~", "!~", ">", ">=", "<", "<=", "in", "not in", "is", "is not"}
+	if f := c.field(field); f != nil && len(f.Operators) > 0 {
+		ops = f.Operators
+	}
+
+	start := strings.ToLower(strings.Join(typed, " "))
+	if start != "" {
+		start += " "
+	}
+
+	out := make([]string, 0, len(ops))
+	for _, op := range ops {
+		if rest, ok := strings.CutPrefix(strings.ToLower(op), start); ok && rest != "" {
+			out = append(out, strings.ToUpper(rest))
+		}
+	}
+	return out
+}
+
+// values returns suggested values of a field and functions that return the type of the field.
+func (c jqlCompleter) values(field, partial string) []string {
+	var out []string
+
+	if suggestions, err := c.suggest(unquoteJQL(field), unquoteJQL(partial)); err == nil {
+		for _, s := range suggestions {
+			v := quoteJQL(s.Value)
+			if name := view.SuggestionName(s); name != unquoteJQL(s.Value) {
+				v += "\t" + name
+			}
+			out = append(out, v)
+		}
+	}
+
+	f := c.field(field)
+	if f == nil {
+		return out
+	}
+	for _, fn := range c.data.Functions {
+		if hasCommonType(fn.Types, f.Types) {
+			out = append(out, fn.Value)
+		}
+	}
+	return out
+}
+
+// jqlContext walks through tokens of a query and returns what is expected next along with
+// the field of the current clause and operator words, eg: `not` in `status not`, typed so far.
+func jqlContext(tokens []jql.Token) (jqlState, string, []string) {
+	var (
+		state jqlState
+		field string
+		ops   []string
+		depth int
+	)
+
+	for _, t := range tokens {
+		// Skip arguments of functions.
+		if depth > 0 {
+			switch t.Kind {
+			case jql.TokenLeftParen:
+				depth++
+			case jql.TokenRightParen:
+				depth--
+			}
+			continue
+		}
+
+		word := t.Kind == jql.TokenWord || t.Kind == jql.TokenString
+
+		switch state {
+		case expectField:
+			switch {
+			case t.Kind == jql.TokenLeftParen, t.Is("NOT"):
+			case t.Is("ORDER"):
+				state = expectOrderBy
+			case word:
+				state, field, ops = expectOperator, t.Value, nil
+			}
+		case expectOperator:
+			switch {
+			case t.Kind == jql.TokenOperator, t.Is("IN"):
+				state = expectValue
+			case t.Is("IS"):
+				state, ops = expectEmpty, nil
+			case t.Is("CHANGED"):
+				state = expectConnector
+			case t.Is("NOT"), t.Is("WAS"):
+				ops = append(ops, t.Value)
+			}
+		case expectValue:
+			switch {
+			case t.Kind == jql.TokenLeftParen:
+				state = expectListValue
+			case word:
+				state = expectConnector
+			}
+		case expectListValue:
+			switch t.Kind {
+			case jql.TokenLeftParen:
+				depth = 1
+			case jql.TokenRightParen:
+				state = expectConnector
+			}
+		case expectEmpty:
+			switch {
+			case t.Is("NOT"):
+				ops = append(ops, t.Value)
+			case t.Is("EMPTY"), t.Is("NULL"):
+				state = expectConnector
+			}
+		case expectConnector:
+			switch {
+			case t.Is("AND"), t.Is("OR"):
+				state = expectField
+			case t.Is("ORDER"):
+				state = expectOrderBy
+			case t.Kind == jql.TokenLeftParen:
+				depth = 1
+			case t.Is("FROM"), t.Is("TO"), t.Is("BY"), t.Is("ON"), t.Is("BEFORE"), t.Is("AFTER"), t.Is("DURING"):
+				state = expectValue
+			}
+		case expectOrderBy:
+			if t.Is("BY") {
+				state = expectOrderField
+			}
+		case expectOrderField:
+			if word {
+				state = expectDirection
+			}
+		case expectDirection:
+			if t.Kind == jql.TokenComma {
+				state = expectOrderField
+			} else if t.Is("ASC") || t.Is("DESC") {
+				state = expectNothing
+			}
+		case expectNothing:
+			if t.Kind == jql.TokenComma {
+				state = expectOrderField
+			}
+		}
+	}
+	if depth > 0 {
+		return expectNothing, field, ops
+	}
+	return state, field, ops
+}
+
+func hasCommonType(a, b []string) bool {
+	for _, x := range a {
+		for _, y := range b {
+			if x == y {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func endsWithSpace(s string) bool {
+	return s != "" && strings.TrimRight(s, " \t\n") != s
+}
+
+func unquoteJQL(s string) string {
+	if s == "" {
+		return s
+	}
+	if q := s[0]; q == '"' || q == '\'' {
+		s = strings.TrimSuffix(s[1:], string(q))
+	}
+	return s
+}
+
+func quoteJQL(s string) string {
+	if s == "" || s[0] == '"' || s[0] == '\'' || plainJQLValue.MatchString(s) {
+		return s
+	}
+	return `"` + strings.ReplaceAll(s, `"`, `\"`) + `"`
+}
@@ -0,0 +1,76 @@
+package cmdcommon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestJQLCompleter(t *testing.T) {
+	userType := []string{"com.atlassian.jira.user.ApplicationUser"}
+
+	c := jqlCompleter{
+		data: &jira.JQLAutocompleteData{
+			Fields: []*jira.JQLField{
+				{Value: "assignee", Orderable: "true", Searchable: "true", Operators: []string{"=", "!=", "in", "not in", "is", "is not", "was", "was not"}, Types: userType},
+				{Value: "status", Orderable: "true", Searchable: "true", Operators: []string{"=", "!=", "in", "not in", "was", "was in"}},
+				{Value: `"Story Points"`, CfID: "cf[10010]", Orderable: "true", Searchable: "true", Operators: []string{"=", ">", "<"}},
+				{Value: "text", Orderable: "false", Searchable: "true", Operators: []string{"~"}},
+			},
+			Functions: []*jira.JQLFunction{
+				{Value: "currentUser()", Types: userType},
+				{Value: "now()", Types: []string{"java.util.Date"}},
+			},
+		},
+		suggest: func(field, value string) ([]*jira.JQLSuggestion, error) {
+			if field != "status" {
+				return nil, nil
+			}
+			return []*jira.JQLSuggestion{
+				{Value: `"In Progress"`, DisplayName: "<b>In P</b>rogress"},
+				{Value: "Open", DisplayName: "Open"},
+			}, nil
+		},
+	}
+
+	cases := []struct {
+		input    string
+		expected []string
+	}{
+		{input: "", expected: []string{"assignee", "status", `"Story Points"`, "text"}},
+		{input: "st", expected: []string{"status", `"Story Points"`}},
+		{input: `"Story`, expected: []string{`"Story Points"`}},
+		{input: "status", expected: []string{"status"}},
+		{input: "status ", expected: []string{"status =", "status !=", "status IN", "status NOT IN", "status WAS", "status WAS IN"}},
+		{input: "status not ", expected: []string{"status not IN"}},
+		{input: "assignee was n", expected: []string{"assignee was NOT"}},
+		{input: "status =", expected: []string{`status = "In Progress"`, "status = Open"}},
+		{input: "status = ", expected: []string{`status = "In Progress"`, "status = Open"}},
+		{input: `status in ("In Progress", O`, expected: []string{`status in ("In Progress", Open`}},
+		{input: "assignee = cu", expected: []string{"assignee = currentUser()"}},
+		{input: "assignee is ", expected: []string{"assignee is EMPTY", "assignee is NULL", "assignee is NOT"}},
+		{input: "assignee is not e", expected: []string{"assignee is not EMPTY"}},
+		{input: "assignee = currentUser() ", expected: []string{"assignee = currentUser() AND", "assignee = currentUser() OR", "assignee = currentUser() ORDER BY"}},
+		{input: "status = Open AND (assignee = currentUser() o", expected: []string{"status = Open AND (assignee = currentUser() OR", "status = Open AND (assignee = currentUser() ORDER BY"}},
+		{input: "status = Open AND (t", expected: []string{"status = Open AND (text"}},
+		{input: "status = Open ORDER BY ", expected: []string{"status = Open ORDER BY assignee", "status = Open ORDER BY status", `status = Open ORDER BY "Story Points"`}},
+		{input: "status = Open ORDER BY status d", expected: []string{"status = Open ORDER BY status DESC"}},
+		{input: "status = Open ORDER BY status DESC, a", expected: []string{"status = Open ORDER BY status DESC, assignee"}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.input, func(t *testing.T) {
+			assert.Equal(t, tc.expected, c.complete(tc.input))
+		})
+	}
+}
+
+func TestQuoteJQL(t *testing.T) {
+	assert.Equal(t, "ABC-123", quoteJQL("ABC-123"))
+	assert.Equal(t, "currentUser()", quoteJQL("currentUser()"))
+	assert.Equal(t, `"In Progress"`, quoteJQL("In Progress"))
+	assert.Equal(t, `"In Progress"`, quoteJQL(`"In Progress"`))
+	assert.Equal(t, `"say \"hi\""`, quoteJQL(`say "hi"`))
+}
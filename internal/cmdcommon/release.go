@@ -0,0 +1,42 @@
+package cmdcommon
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// ResolveVersion finds a project version by its id or name.
+func ResolveVersion(client *jira.Client, project, version string) (*jira.ProjectVersion, error) {
+	versions, err := client.Release(project)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range versions {
+		if v.ID == version || strings.EqualFold(v.Name, version) {
+			return v, nil
+		}
+	}
+	return nil, fmt.Errorf("version %q not found in project %q", version, project)
+}
+
+// NextVersion returns the first unreleased and unarchived version listed after the given version.
+func NextVersion(client *jira.Client, project string, current *jira.ProjectVersion) (*jira.ProjectVersion, error) {
+	versions, err := client.Release(project)
+	if err != nil {
+		return nil, err
+	}
+
+	var found bool
+	for _, v := range versions {
+		if v.ID == current.ID {
+			found = true
+			continue
+		}
+		if found && !v.Released && !v.Archived {
+			return v, nil
+		}
+	}
+	return nil, fmt.Errorf("no unreleased version found after %q", current.Name)
+}
@@ -0,0 +1,45 @@
+package cmdcommon
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// ResolveServiceDesk resolves service desk id from the given desk id or project key.
+// It falls back to the configured project if the input is empty.
+func ResolveServiceDesk(client *jira.Client, desk string) (string, error) {
+	if desk == "" {
+		desk = viper.GetString("project.key")
+	}
+	if desk == "" {
+		return "", fmt.Errorf("service desk id or project key is required")
+	}
+	if _, err := strconv.Atoi(desk); err == nil {
+		return desk, nil
+	}
+
+	desks, err := client.ServiceDesks()
+	if err != nil {
+		return "", err
+	}
+	for _, d := range desks {
+		if strings.EqualFold(d.ProjectKey, desk) {
+			return d.ID, nil
+		}
+	}
+	return "", fmt.Errorf("service desk not found for project %q", desk)
+}
+
+// GetSDUsersRequest builds service desk users request based on the installation type.
+// Cloud installations identify users by account id and local installations by username.
+func GetSDUsersRequest(users []string) *jira.SDUsersRequest {
+	if viper.GetString("installation") == jira.InstallationTypeLocal {
+		return &jira.SDUsersRequest{Usernames: users}
+	}
+	return &jira.SDUsersRequest{AccountIDs: users}
+}
@@ -0,0 +1,88 @@
+package cmdcommon
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/internal/watch"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// Watch holds options of the watch mode.
+type Watch struct {
+	// Interval is the poll interval, watch mode is off if it is zero.
+	Interval time.Duration
+	Notifier watch.Notifier
+	// Context stops the watch mode when it is done, eg: on CTRL+C.
+	Context context.Context
+}
+
+// SetWatchFlags sets flags of the watch mode.
+func SetWatchFlags(cmd *cobra.Command) {
+	cmd.Flags().Duration("watch", 0, "Refresh every interval and highlight changes, eg: --watch=1m")
+	cmd.Flags().Lookup("watch").NoOptDefVal = watch.DefaultInterval.String()
+	cmd.Flags().String("notify", "", "Command to run on changes in watch mode, eg: 'notify-send \"$1\" \"$2\"'\n"+
+		"Defaults to the watch.notify config")
+}
+
+// GetWatch returns watch mode options set with flags of the command.
+func GetWatch(cmd *cobra.Command) (Watch, error) {
+	w := Watch{Context: cmd.Context()}
+	if w.Context == nil {
+		w.Context = context.Background()
+	}
+
+	flags := cmd.Flags()
+
+	if flags.Lookup("watch") == nil {
+		return w, nil
+	}
+
+	interval, err := flags.GetDuration("watch")
+	if err != nil {
+		return w, err
+	}
+	if interval != 0 && interval < watch.MinInterval {
+		return w, fmt.Errorf("watch interval must be at least %s", watch.MinInterval)
+	}
+
+	notify, err := flags.GetString("notify")
+	if err != nil {
+		return w, err
+	}
+	if notify == "" {
+		notify = viper.GetString("watch.notify")
+	}
+
+	w.Interval = interval
+	w.Notifier = watch.Notifier{Command: notify}
+
+	return w, nil
+}
+
+// Enabled tells if the watch mode is on.
+func (w Watch) Enabled() bool {
+	return w.Interval > 0
+}
+
+// Poll returns a func that polls for changes to issues and notifies about them.
+// Notifications are titled with title, eg: the command being watched.
+func (w Watch) Poll(p *watch.Poller, title string, issues []*jira.Issue) func() ([]*jira.Issue, []watch.Change, error) {
+	current := issues
+
+	return func() ([]*jira.Issue, []watch.Change, error) {
+		out, changes, err := p.Poll(current)
+		if err != nil {
+			return nil, nil, err
+		}
+		current = out
+
+		go func() { _ = w.Notifier.NotifyChanges(title, changes) }()
+
+		return out, changes, nil
+	}
+}
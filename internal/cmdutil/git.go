@@ -0,0 +1,39 @@
+package cmdutil
+
+import (
+	"regexp"
+	"strconv"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/git"
+)
+
+var issueKeyArgRegex = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*-\d+$`)
+
+// IsIssueKeyArg checks if an argument looks like an issue key or a bare issue number.
+func IsIssueKeyArg(arg string) bool {
+	if _, err := strconv.Atoi(arg); err == nil {
+		return true
+	}
+	return issueKeyArgRegex.MatchString(arg)
+}
+
+// GetIssueKeyFromBranch detects issue key from the name of the current git branch.
+// It returns an empty string if the key cannot be detected.
+func GetIssueKeyFromBranch(project string) string {
+	branch, err := git.CurrentBranch()
+	if err != nil {
+		return ""
+	}
+	return git.IssueKeyFromBranch(branch, project)
+}
+
+// GetIssueKeyFromArgs returns the normalized issue key and remaining args.
+//
+// If the first arg doesn't look like an issue key, the key is detected from
+// the current git branch and all args are returned as is.
+func GetIssueKeyFromArgs(project string, args []string) (string, []string) {
+	if len(args) > 0 && IsIssueKeyArg(args[0]) {
+		return GetJiraIssueKey(project, args[0]), args[1:]
+	}
+	return GetIssueKeyFromBranch(project), args
+}
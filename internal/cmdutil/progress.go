@@ -0,0 +1,123 @@
+package cmdutil
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/term"
+)
+
+const (
+	progressBarWidth    = 30
+	progressRefreshRate = 100 * time.Millisecond
+)
+
+// Progress renders a progress bar of a transfer on stderr.
+// Nothing is rendered if stderr is not a terminal.
+type Progress struct {
+	mu      sync.Mutex
+	w       io.Writer
+	label   string
+	total   int64
+	current int64
+	drawn   time.Time
+	enabled bool
+}
+
+// NewProgress constructs a progress bar for a transfer of total bytes.
+// A total of 0 or less renders transferred bytes only.
+func NewProgress(label string, total int64) *Progress {
+	return newProgress(os.Stderr, label, total, term.IsTerminal(int(os.Stderr.Fd())))
+}
+
+func newProgress(w io.Writer, label string, total int64, enabled bool) *Progress {
+	return &Progress{w: w, label: label, total: total, enabled: enabled}
+}
+
+// Add records n transferred bytes. It is safe for concurrent use.
+func (p *Progress) Add(n int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.current += n
+	if now := time.Now(); now.Sub(p.drawn) >= progressRefreshRate {
+		p.drawn = now
+		p.draw()
+	}
+}
+
+// Reader wraps r so that bytes read from it are recorded.
+func (p *Progress) Reader(r io.Reader) io.Reader {
+	return &progressReader{Reader: r, progress: p}
+}
+
+// Done renders the final state and moves the cursor to the next line.
+func (p *Progress) Done() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if !p.enabled {
+		return
+	}
+	p.draw()
+	_, _ = fmt.Fprintln(p.w)
+}
+
+func (p *Progress) draw() {
+	if !p.enabled {
+		return
+	}
+	_, _ = fmt.Fprintf(p.w, "\r\033[K%s", p.String())
+}
+
+// String returns the rendered progress bar.
+func (p *Progress) String() string {
+	if p.total <= 0 {
+		return fmt.Sprintf("%s %s", p.label, FormatBytes(p.current))
+	}
+
+	ratio := min(float64(p.current)/float64(p.total), 1)
+	filled := int(ratio * progressBarWidth)
+
+	return fmt.Sprintf(
+		"%s [%s%s] %3.0f%% %s/%s",
+		p.label,
+		strings.Repeat("=", filled),
+		strings.Repeat(" ", progressBarWidth-filled),
+		ratio*100, //nolint:mnd
+		FormatBytes(p.current),
+		FormatBytes(p.total),
+	)
+}
+
+type progressReader struct {
+	io.Reader
+	progress *Progress
+}
+
+func (r *progressReader) Read(b []byte) (int, error) {
+	n, err := r.Reader.Read(b)
+	if n > 0 {
+		r.progress.Add(int64(n))
+	}
+	return n, err
+}
+
+// FormatBytes formats size in bytes to a human readable string, eg: 1.5 MiB.
+func FormatBytes(n int64) string {
+	const unit = 1024
+
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
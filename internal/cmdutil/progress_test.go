@@ -0,0 +1,39 @@
+package cmdutil
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProgress(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+
+	p := newProgress(&out, "report.log", 4096, true)
+	n, err := io.Copy(io.Discard, p.Reader(strings.NewReader(strings.Repeat("x", 1024))))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1024), n)
+
+	assert.Equal(t, "report.log [=======                       ]  25% 1.0 KiB/4.0 KiB", p.String())
+
+	p.Add(4096)
+	p.Done()
+	assert.True(t, strings.HasSuffix(out.String(), "\r\033[Kreport.log [==============================] 100% 5.0 KiB/4.0 KiB\n"))
+
+	p = newProgress(&out, "stream", 0, false)
+	p.Add(10)
+	assert.Equal(t, "stream 10 B", p.String())
+}
+
+func TestFormatBytes(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "512 B", FormatBytes(512))
+	assert.Equal(t, "1.5 KiB", FormatBytes(1536))
+	assert.Equal(t, "2.0 GiB", FormatBytes(2<<30))
+}
@@ -1,6 +1,8 @@
 package cmdutil
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -14,6 +16,7 @@ import (
 	"github.com/spf13/viper"
 	"golang.org/x/term"
 
+	"github.com/ankitpokhrel/jira-cli/api"
 	"github.com/ankitpokhrel/jira-cli/pkg/browser"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
 	"github.com/ankitpokhrel/jira-cli/pkg/tui"
@@ -95,6 +98,14 @@ func ExitIfError(err error) {
 			suggestion = "Try adjusting your search criteria or filters"
 		default:
 			msg = fmt.Sprintf("Error: %s", err.Error())
+
+			switch {
+			case errors.Is(err, context.Canceled):
+				msg = "jira: Interrupted."
+			case errors.Is(err, context.DeadlineExceeded):
+				msg = "jira: Command timed out."
+				suggestion = "Increase the --timeout and try again"
+			}
 		}
 	}
 
@@ -102,7 +113,7 @@ func ExitIfError(err error) {
 	if suggestion != "" {
 		fmt.Fprintf(os.Stderr, "\n💡 %s\n", suggestion)
 	}
-	os.Exit(1)
+	exit(1)
 }
 
 // Info displays spinner.
@@ -140,7 +151,13 @@ func Fail(msg string, args ...interface{}) {
 // DEPRECATED: Use fmt.Errorf and return errors instead. This function will be removed in a future version.
 func Failed(msg string, args ...interface{}) {
 	Fail(msg, args...)
-	os.Exit(1)
+	exit(1)
+}
+
+// exit flushes buffered traces and exits with the given status code.
+func exit(code int) {
+	api.FlushTraces()
+	os.Exit(code)
 }
 
 // Navigate navigates to jira issue.
@@ -264,3 +264,14 @@ func TestGetSubtaskHandle(t *testing.T) {
 		})
 	}
 }
+
+func TestIsIssueKeyArg(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, IsIssueKeyArg("TEST-1"))
+	assert.True(t, IsIssueKeyArg("test-12"))
+	assert.True(t, IsIssueKeyArg("12"))
+	assert.False(t, IsIssueKeyArg("In Progress"))
+	assert.False(t, IsIssueKeyArg("2h"))
+	assert.False(t, IsIssueKeyArg("Done"))
+}
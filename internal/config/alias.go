@@ -0,0 +1,104 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	aliasesKey = "aliases"
+	yamlIndent = 2
+)
+
+// ErrAliasNotFound is returned when deleting an alias that doesn't exist.
+var ErrAliasNotFound = errors.New("alias not found")
+
+// SetAlias adds or replaces an alias in the config file.
+//
+// The file is edited in place so that comments and formatting of other keys are preserved.
+func SetAlias(path, name, expansion string) error {
+	return updateAliases(path, func(aliases *yaml.Node) error {
+		for i := 0; i < len(aliases.Content); i += 2 {
+			if aliases.Content[i].Value == name {
+				aliases.Content[i+1] = stringNode(expansion)
+				return nil
+			}
+		}
+		aliases.Content = append(aliases.Content, stringNode(name), stringNode(expansion))
+		return nil
+	})
+}
+
+// DeleteAlias removes an alias from the config file.
+func DeleteAlias(path, name string) error {
+	return updateAliases(path, func(aliases *yaml.Node) error {
+		for i := 0; i < len(aliases.Content); i += 2 {
+			if aliases.Content[i].Value == name {
+				aliases.Content = append(aliases.Content[:i], aliases.Content[i+2:]...)
+				return nil
+			}
+		}
+		return ErrAliasNotFound
+	})
+}
+
+func updateAliases(path string, fn func(*yaml.Node) error) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(b, &doc); err != nil {
+		return fmt.Errorf("unable to parse config file %s: %w", path, err)
+	}
+	if doc.Kind == 0 {
+		doc = yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{{Kind: yaml.MappingNode}}}
+	}
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return fmt.Errorf("unable to parse config file %s: not a map", path)
+	}
+
+	var aliases *yaml.Node
+	for i := 0; i < len(root.Content); i += 2 {
+		if root.Content[i].Value == aliasesKey {
+			aliases = root.Content[i+1]
+			break
+		}
+	}
+	switch {
+	case aliases == nil:
+		aliases = &yaml.Node{Kind: yaml.MappingNode}
+		root.Content = append(root.Content, stringNode(aliasesKey), aliases)
+	case aliases.Kind != yaml.MappingNode:
+		// An empty `aliases:` key is parsed as null.
+		*aliases = yaml.Node{Kind: yaml.MappingNode}
+	}
+	if err := fn(aliases); err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(yamlIndent)
+	if err := enc.Encode(&doc); err != nil {
+		return err
+	}
+	if err := enc.Close(); err != nil {
+		return err
+	}
+	return os.WriteFile(path, buf.Bytes(), info.Mode().Perm())
+}
+
+func stringNode(v string) *yaml.Node {
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: v}
+}
@@ -0,0 +1,52 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetAndDeleteAlias(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), ".config.yml")
+	assert.NoError(t, os.WriteFile(path, []byte("# Jira config\nserver: https://jira.example.com\nproject:\n  key: TEST\n"), 0o600))
+
+	assert.NoError(t, SetAlias(path, "standup", `issue list -s"In Progress" --plain`))
+	assert.NoError(t, SetAlias(path, "mine", "!jira issue list -a$(jira me)"))
+	assert.NoError(t, SetAlias(path, "standup", "issue list --plain"))
+
+	b, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, `# Jira config
+server: https://jira.example.com
+project:
+  key: TEST
+aliases:
+  standup: issue list --plain
+  mine: '!jira issue list -a$(jira me)'
+`, string(b))
+
+	assert.NoError(t, DeleteAlias(path, "standup"))
+	assert.ErrorIs(t, DeleteAlias(path, "standup"), ErrAliasNotFound)
+
+	b, err = os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Contains(t, string(b), "aliases:\n  mine: '!jira issue list -a$(jira me)'\n")
+	assert.NotContains(t, string(b), "standup")
+}
+
+func TestSetAliasEmptyKey(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), ".config.yml")
+	assert.NoError(t, os.WriteFile(path, []byte("server: https://jira.example.com\naliases:\n"), 0o600))
+
+	assert.NoError(t, SetAlias(path, "todo", "issue list -sTo Do"))
+
+	b, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "server: https://jira.example.com\naliases:\n  todo: issue list -sTo Do\n", string(b))
+}
@@ -0,0 +1,17 @@
+package config
+
+import (
+	"path/filepath"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+)
+
+// StatePath returns path of a file the tool uses to persist its state, eg: caches.
+// State files are stored in the jira-cli config directory.
+func StatePath(name string) (string, error) {
+	home, err := cmdutil.GetConfigHome()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, Dir, name), nil
+}
@@ -0,0 +1,224 @@
+// Package feed builds a chronological activity stream of issue changes.
+package feed
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// DefaultSince is the period the feed looks back to if nothing was read before.
+const DefaultSince = 24 * time.Hour
+
+const (
+	hoursInDay = 24
+	daysInWeek = 7
+)
+
+// Kind is a kind of activity.
+type Kind string
+
+const (
+	// Changed is a change to a field of an issue, eg: status or assignee.
+	Changed Kind = "change"
+	// Commented is a comment added to an issue.
+	Commented Kind = "comment"
+	// Mentioned is a comment that mentions the current user.
+	Mentioned Kind = "mention"
+)
+
+var period = regexp.MustCompile(`^(\d+)([wdhm])$`)
+
+// Event is a single activity on an issue.
+type Event struct {
+	Time    time.Time `json:"time"`
+	Kind    Kind      `json:"kind"`
+	Key     string    `json:"key"`
+	Summary string    `json:"summary"`
+	Author  string    `json:"author"`
+	Field   string    `json:"field,omitempty"`
+	From    string    `json:"from,omitempty"`
+	To      string    `json:"to,omitempty"`
+}
+
+// String describes the event in a sentence, eg: "Alice moved ABC-1 to Review".
+func (e Event) String() string {
+	author := e.Author
+	if author == "" {
+		author = "Someone"
+	}
+
+	switch e.Kind {
+	case Commented:
+		return fmt.Sprintf("%s commented on %s", author, e.Key)
+	case Mentioned:
+		return fmt.Sprintf("%s mentioned you on %s", author, e.Key)
+	}
+
+	switch strings.ToLower(e.Field) {
+	case "status":
+		return fmt.Sprintf("%s moved %s to %s", author, e.Key, e.To)
+	case "assignee":
+		if e.To == "" {
+			return fmt.Sprintf("%s unassigned %s", author, e.Key)
+		}
+		return fmt.Sprintf("%s assigned %s to %s", author, e.Key, e.To)
+	case "resolution":
+		if e.To == "" {
+			return fmt.Sprintf("%s reopened %s", author, e.Key)
+		}
+		return fmt.Sprintf("%s resolved %s as %s", author, e.Key, e.To)
+	case "description", "summary", "environment":
+		return fmt.Sprintf("%s updated %s of %s", author, strings.ToLower(e.Field), e.Key)
+	}
+
+	if e.To == "" {
+		return fmt.Sprintf("%s cleared %s of %s", author, e.Field, e.Key)
+	}
+	return fmt.Sprintf("%s changed %s of %s to %s", author, e.Field, e.Key, e.To)
+}
+
+// User identifies the current user to skip own activity and to find mentions.
+type User struct {
+	AccountID string
+	Login     string
+	Email     string
+}
+
+// Is tells if u is the given jira user.
+func (u User) Is(j jira.User) bool {
+	return (u.AccountID != "" && u.AccountID == j.AccountID) ||
+		(u.Login != "" && u.Login == j.Name) ||
+		(u.Email != "" && strings.EqualFold(u.Email, j.Email))
+}
+
+// MentionedIn tells if the user is mentioned in a wiki markup text,
+// eg: `[~accountid:5b10a2844c20165700ede21g]` or `[~jdoe]`.
+func (u User) MentionedIn(body string) bool {
+	if u.AccountID != "" && strings.Contains(body, "[~accountid:"+u.AccountID+"]") {
+		return true
+	}
+	return u.Login != "" && strings.Contains(body, "[~"+u.Login+"]")
+}
+
+// ParseSince parses a period like 30m, 12h, 1d or 2w, or a date in yyyy-mm-dd
+// format and returns the time it points to, relative to now.
+func ParseSince(s string, now time.Time) (time.Time, error) {
+	if m := period.FindStringSubmatch(s); m != nil {
+		n, err := strconv.Atoi(m[1])
+		if err != nil {
+			return time.Time{}, err
+		}
+		unit := map[string]time.Duration{
+			"m": time.Minute,
+			"h": time.Hour,
+			"d": hoursInDay * time.Hour,
+			"w": daysInWeek * hoursInDay * time.Hour,
+		}[m[2]]
+		return now.Add(-time.Duration(n) * unit), nil
+	}
+
+	for _, layout := range []string{time.DateOnly, "2006/01/02"} {
+		if t, err := time.ParseInLocation(layout, s, now.Location()); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid period %q, eg: 30m, 12h, 1d, 2w or yyyy-mm-dd", s)
+}
+
+// JQL returns a query for issues the current user is assigned to, reported,
+// is watching or is mentioned in that were updated after since.
+func JQL(since, now time.Time) string {
+	minutes := int(math.Ceil(now.Sub(since).Minutes())) + 1
+
+	return fmt.Sprintf(
+		`(assignee = currentUser() OR reporter = currentUser() OR issue IN watchedIssues() OR comment ~ currentUser()) `+
+			`AND updated >= "-%dm" ORDER BY updated DESC`,
+		minutes,
+	)
+}
+
+// Events builds events of an issue from its changelog and comments that happened
+// after since. Activity of the current user is skipped.
+func Events(iss *jira.Issue, history []jira.HistoryEntry, comments []*jira.Comment, me User, since time.Time) []Event {
+	var out []Event
+
+	for _, h := range history {
+		t, ok := after(h.Created, since)
+		if !ok || me.Is(h.Author) {
+			continue
+		}
+		for _, item := range h.Items {
+			out = append(out, Event{
+				Time:    t,
+				Kind:    Changed,
+				Key:     iss.Key,
+				Summary: iss.Fields.Summary,
+				Author:  h.Author.DisplayName,
+				Field:   item.Field,
+				From:    item.FromString,
+				To:      item.ToString,
+			})
+		}
+	}
+
+	for _, c := range comments {
+		t, ok := after(c.Created, since)
+		if !ok || me.Is(c.Author) {
+			continue
+		}
+		kind := Commented
+		if body, ok := c.Body.(string); ok && me.MentionedIn(body) {
+			kind = Mentioned
+		}
+		out = append(out, Event{
+			Time:    t,
+			Kind:    kind,
+			Key:     iss.Key,
+			Summary: iss.Fields.Summary,
+			Author:  c.Author.DisplayName,
+		})
+	}
+
+	return out
+}
+
+// ReadUntil returns the time up to which the feed is read once issues are looked into.
+// If the search was truncated, older issues weren't looked into, so the feed is read
+// only up to the oldest issue that was.
+func ReadUntil(issues []*jira.Issue, truncated bool, now time.Time) time.Time {
+	if !truncated {
+		return now
+	}
+
+	until := now
+	for _, iss := range issues {
+		if t, err := time.Parse(jira.RFC3339, iss.Fields.Updated); err == nil && t.Before(until) {
+			until = t
+		}
+	}
+	// Events are shown only if they happened after the last read time, issues
+	// updated at the same time as the oldest one may not have been looked into.
+	return until.Add(-time.Millisecond)
+}
+
+// Sort sorts events chronologically, oldest first.
+func Sort(events []Event) {
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Time.Before(events[j].Time)
+	})
+}
+
+func after(dt string, since time.Time) (time.Time, bool) {
+	t, err := time.Parse(jira.RFC3339, dt)
+	if err != nil {
+		return t, false
+	}
+	return t, t.After(since)
+}
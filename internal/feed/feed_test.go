@@ -0,0 +1,130 @@
+package feed
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestEventString(t *testing.T) {
+	cases := []struct {
+		event    Event
+		expected string
+	}{
+		{Event{Kind: Changed, Key: "ABC-1", Author: "Alice", Field: "status", To: "Review"}, "Alice moved ABC-1 to Review"},
+		{Event{Kind: Changed, Key: "ABC-1", Author: "Alice", Field: "assignee", To: "Bob"}, "Alice assigned ABC-1 to Bob"},
+		{Event{Kind: Changed, Key: "ABC-1", Author: "Alice", Field: "assignee"}, "Alice unassigned ABC-1"},
+		{Event{Kind: Changed, Key: "ABC-1", Author: "Alice", Field: "resolution", To: "Done"}, "Alice resolved ABC-1 as Done"},
+		{Event{Kind: Changed, Key: "ABC-1", Author: "Alice", Field: "resolution"}, "Alice reopened ABC-1"},
+		{Event{Kind: Changed, Key: "ABC-1", Author: "Alice", Field: "description", To: "long text"}, "Alice updated description of ABC-1"},
+		{Event{Kind: Changed, Key: "ABC-1", Author: "Alice", Field: "priority", To: "High"}, "Alice changed priority of ABC-1 to High"},
+		{Event{Kind: Changed, Key: "ABC-1", Field: "labels"}, "Someone cleared labels of ABC-1"},
+		{Event{Kind: Commented, Key: "ABC-9", Author: "Bob"}, "Bob commented on ABC-9"},
+		{Event{Kind: Mentioned, Key: "ABC-9", Author: "Bob"}, "Bob mentioned you on ABC-9"},
+	}
+
+	for _, tc := range cases {
+		assert.Equal(t, tc.expected, tc.event.String())
+	}
+}
+
+func TestParseSince(t *testing.T) {
+	now := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		input    string
+		expected time.Time
+	}{
+		{"30m", now.Add(-30 * time.Minute)},
+		{"12h", now.Add(-12 * time.Hour)},
+		{"1d", now.Add(-24 * time.Hour)},
+		{"2w", now.Add(-14 * 24 * time.Hour)},
+		{"2024-05-01", time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)},
+		{"2024/05/01", time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tc := range cases {
+		got, err := ParseSince(tc.input, now)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.expected, got)
+	}
+
+	_, err := ParseSince("yesterday", now)
+	assert.Error(t, err)
+}
+
+func TestJQL(t *testing.T) {
+	now := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+
+	assert.Equal(
+		t,
+		`(assignee = currentUser() OR reporter = currentUser() OR issue IN watchedIssues() OR comment ~ currentUser()) `+
+			`AND updated >= "-1441m" ORDER BY updated DESC`,
+		JQL(now.Add(-24*time.Hour), now),
+	)
+}
+
+func TestEvents(t *testing.T) {
+	me := User{AccountID: "me-1", Login: "jdoe"}
+	since := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	iss := &jira.Issue{Key: "ABC-1"}
+	iss.Fields.Summary = "Fix login"
+
+	alice := jira.User{AccountID: "alice-1", DisplayName: "Alice"}
+	myself := jira.User{AccountID: "me-1", DisplayName: "Jane Doe"}
+
+	history := []jira.HistoryEntry{
+		{Author: alice, Created: "2024-05-01T09:00:00.000+0000", Items: []jira.HistoryItem{{Field: "status", ToString: "In Progress"}}},
+		{Author: alice, Created: "2024-05-01T11:00:00.000+0000", Items: []jira.HistoryItem{{Field: "status", FromString: "In Progress", ToString: "Review"}}},
+		{Author: myself, Created: "2024-05-01T11:30:00.000+0000", Items: []jira.HistoryItem{{Field: "assignee", ToString: "Jane Doe"}}},
+	}
+	comments := []*jira.Comment{
+		{Author: jira.User{AccountID: "bob-1", DisplayName: "Bob"}, Created: "2024-05-01T10:30:00.000+0000", Body: "Looks good"},
+		{Author: jira.User{Name: "carol", DisplayName: "Carol"}, Created: "2024-05-01T12:00:00.000+0000", Body: "cc [~accountid:me-1]"},
+		{Author: myself, Created: "2024-05-01T12:30:00.000+0000", Body: "Thanks"},
+	}
+
+	events := Events(iss, history, comments, me, since)
+	Sort(events)
+
+	got := make([]string, 0, len(events))
+	for _, e := range events {
+		got = append(got, e.String())
+	}
+	assert.Equal(t, []string{
+		"Bob commented on ABC-1",
+		"Alice moved ABC-1 to Review",
+		"Carol mentioned you on ABC-1",
+	}, got)
+
+	assert.Equal(t, "Fix login", events[0].Summary)
+	assert.Equal(t, "In Progress", events[1].From)
+	assert.Equal(t, time.Date(2024, 5, 1, 11, 0, 0, 0, time.UTC), events[1].Time.UTC())
+}
+
+func TestReadUntil(t *testing.T) {
+	now := time.Date(2024, 5, 2, 12, 0, 0, 0, time.UTC)
+	issues := []*jira.Issue{
+		{Key: "ABC-2", Fields: jira.IssueFields{Updated: "2024-05-02T10:00:00.000+0000"}},
+		{Key: "ABC-1", Fields: jira.IssueFields{Updated: "2024-05-02T08:30:00.000+0000"}},
+	}
+
+	assert.Equal(t, now, ReadUntil(issues, false, now))
+	assert.True(t, time.Date(2024, 5, 2, 8, 29, 59, 999000000, time.UTC).Equal(ReadUntil(issues, true, now)))
+	assert.True(t, now.Add(-time.Millisecond).Equal(ReadUntil(nil, true, now)))
+}
+
+func TestUser(t *testing.T) {
+	u := User{Login: "jdoe", Email: "jdoe@example.com"}
+
+	assert.True(t, u.Is(jira.User{Name: "jdoe"}))
+	assert.True(t, u.Is(jira.User{Email: "JDoe@example.com"}))
+	assert.False(t, u.Is(jira.User{Name: "alice"}))
+	assert.False(t, User{}.Is(jira.User{}))
+
+	assert.True(t, u.MentionedIn("ping [~jdoe] please"))
+	assert.False(t, u.MentionedIn("ping [~jdoe2] please"))
+}
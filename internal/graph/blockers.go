@@ -0,0 +1,197 @@
+package graph
+
+import (
+	"strings"
+
+	"github.com/ankitpokhrel/jira-cli/internal/workflow"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const statusCategoryDone = "done"
+
+// BlockedIssue is an issue that is blocked by unresolved issues.
+type BlockedIssue struct {
+	Issue *Node
+	By    []*Node
+}
+
+// BlockerReport describes what blocks a set of issues, eg: an epic or a sprint.
+type BlockerReport struct {
+	// Graph holds the issues in the set, their blockers and the blocking relations.
+	Graph *Graph
+	// Total is the number of issues in the set.
+	Total int
+	// External are issues blocked by unresolved issues outside of the set.
+	External []BlockedIssue
+	// InProgress are issues that are in progress while blocked by unresolved issues.
+	InProgress []BlockedIssue
+	// CriticalPath is the longest chain of unresolved issues in the set that block
+	// each other, from the first blocker to the last blocked issue.
+	CriticalPath []string
+	// CriticalWeight is the sum of weights of issues on the critical path.
+	CriticalWeight float64
+	// Cycles are issues that block each other.
+	Cycles [][]string
+}
+
+// Blockers analyzes the blocking relations of issues. Links of type linkType, eg: Blocks,
+// are followed. Issues on the critical path are weighted by their points, or by 1 if
+// points are not given. Statuses are mapped to states using the workflow.
+func Blockers(issues []*jira.Issue, linkType string, points map[string]float64, wf workflow.Workflow) *BlockerReport {
+	g := &Graph{index: make(map[string]*Node), edges: make(map[Edge]bool)}
+	if len(issues) > 0 {
+		g.Root = issues[0].Key
+	}
+
+	set := make(map[string]bool, len(issues))
+	resolved := make(map[string]bool)
+
+	for _, iss := range issues {
+		set[iss.Key] = true
+		g.node(iss.Key, 0).fill(iss)
+		if iss.Fields.Resolution.Name != "" {
+			resolved[iss.Key] = true
+		}
+	}
+
+	for _, iss := range issues {
+		for _, link := range iss.Fields.IssueLinks {
+			lt := link.LinkType
+			if !strings.EqualFold(lt.Name, linkType) {
+				continue
+			}
+			switch {
+			case link.InwardIssue != nil:
+				g.node(link.InwardIssue.Key, 1).fill(link.InwardIssue)
+				g.edge(Edge{From: link.InwardIssue.Key, To: iss.Key, Type: lt.Name, Outward: lt.Outward, Inward: lt.Inward})
+			case link.OutwardIssue != nil:
+				g.node(link.OutwardIssue.Key, 1).fill(link.OutwardIssue)
+				g.edge(Edge{From: iss.Key, To: link.OutwardIssue.Key, Type: lt.Name, Outward: lt.Outward, Inward: lt.Inward})
+			}
+		}
+	}
+
+	// Statuses in the done category count as resolved even if the workflow doesn't list them.
+	done := func(n *Node) bool {
+		if resolved[n.Key] || n.StatusCategory == statusCategoryDone {
+			return true
+		}
+		s, ok := wf.StateOf(n.Status)
+		return ok && s == workflow.Done
+	}
+
+	r := BlockerReport{Graph: g, Total: len(issues), Cycles: g.Cycles(linkType)}
+
+	for _, iss := range issues {
+		n := g.index[iss.Key]
+		if done(n) {
+			continue
+		}
+
+		var all, external []*Node
+		for _, e := range g.Edges {
+			if e.To != n.Key {
+				continue
+			}
+			b := g.index[e.From]
+			if done(b) {
+				continue
+			}
+			all = append(all, b)
+			if !set[b.Key] {
+				external = append(external, b)
+			}
+		}
+
+		if len(external) > 0 {
+			r.External = append(r.External, BlockedIssue{Issue: n, By: external})
+		}
+		if s, ok := wf.StateOf(n.Status); ok && (s == workflow.Doing || s == workflow.Review) && len(all) > 0 {
+			r.InProgress = append(r.InProgress, BlockedIssue{Issue: n, By: all})
+		}
+	}
+
+	weight := func(key string) float64 {
+		if points == nil {
+			return 1
+		}
+		return points[key]
+	}
+	pending := func(key string) bool {
+		return set[key] && !done(g.index[key])
+	}
+	r.CriticalPath, r.CriticalWeight = g.longestPath(pending, weight)
+
+	return &r
+}
+
+// longestPath returns the heaviest path along edges between nodes accepted by include.
+// Ties are broken by the number of nodes. Edges closing a cycle are ignored.
+func (g *Graph) longestPath(include func(string) bool, weight func(string) float64) ([]string, float64) {
+	type result struct {
+		weight float64
+		next   string
+		length int
+	}
+
+	adj := make(map[string][]string)
+	for _, e := range g.Edges {
+		if include(e.From) && include(e.To) {
+			adj[e.From] = append(adj[e.From], e.To)
+		}
+	}
+
+	var (
+		memo   = make(map[string]result)
+		active = make(map[string]bool)
+	)
+
+	var visit func(key string) result
+	visit = func(key string) result {
+		if r, ok := memo[key]; ok {
+			return r
+		}
+		active[key] = true
+
+		best := result{weight: weight(key), length: 1}
+		for _, next := range adj[key] {
+			if active[next] {
+				continue
+			}
+			r := visit(next)
+			w, l := weight(key)+r.weight, r.length+1
+			if w > best.weight || (w == best.weight && l > best.length) {
+				best = result{weight: w, next: next, length: l}
+			}
+		}
+
+		active[key] = false
+		memo[key] = best
+		return best
+	}
+
+	var (
+		start string
+		best  result
+	)
+	for _, n := range g.Nodes {
+		if _, ok := adj[n.Key]; !ok {
+			continue
+		}
+		r := visit(n.Key)
+		if r.weight > best.weight || (r.weight == best.weight && r.length > best.length) {
+			start, best = n.Key, r
+		}
+	}
+
+	// A single issue doesn't form a chain.
+	if best.length < 2 {
+		return nil, 0
+	}
+
+	path := []string{start}
+	for key := start; memo[key].next != ""; key = memo[key].next {
+		path = append(path, memo[key].next)
+	}
+	return path, best.weight
+}
@@ -0,0 +1,109 @@
+package graph
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/internal/workflow"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// SP-1 blocks SP-2 blocks SP-3, SP-4 blocks SP-3. OUT-1 (unresolved) blocks SP-2
+// and OUT-2 (done) blocks SP-4. SP-5 and SP-6 block each other.
+const sprintJSON = `[
+	{"key": "SP-1", "fields": {"summary": "One", "status": {"name": "To Do"}, "issueLinks": [
+		{"type": {"name": "Blocks", "inward": "is blocked by", "outward": "blocks"}, "outwardIssue": {"key": "SP-2", "fields": {"status": {"name": "In Progress"}}}}
+	]}},
+	{"key": "SP-2", "fields": {"summary": "Two", "status": {"name": "In Progress"}, "issueLinks": [
+		{"type": {"name": "Blocks", "inward": "is blocked by", "outward": "blocks"}, "inwardIssue": {"key": "SP-1", "fields": {"status": {"name": "To Do"}}}},
+		{"type": {"name": "Blocks", "inward": "is blocked by", "outward": "blocks"}, "inwardIssue": {"key": "OUT-1", "fields": {"summary": "Out", "status": {"name": "To Do"}}}},
+		{"type": {"name": "Blocks", "inward": "is blocked by", "outward": "blocks"}, "outwardIssue": {"key": "SP-3", "fields": {"status": {"name": "To Do"}}}},
+		{"type": {"name": "Relates", "inward": "relates to", "outward": "relates to"}, "outwardIssue": {"key": "OUT-3", "fields": {"status": {"name": "To Do"}}}}
+	]}},
+	{"key": "SP-3", "fields": {"summary": "Three", "status": {"name": "To Do"}}},
+	{"key": "SP-4", "fields": {"summary": "Four", "status": {"name": "In Review"}, "issueLinks": [
+		{"type": {"name": "Blocks", "inward": "is blocked by", "outward": "blocks"}, "outwardIssue": {"key": "SP-3", "fields": {"status": {"name": "To Do"}}}},
+		{"type": {"name": "Blocks", "inward": "is blocked by", "outward": "blocks"}, "inwardIssue": {"key": "OUT-2", "fields": {"status": {"name": "Done"}}}}
+	]}},
+	{"key": "SP-5", "fields": {"summary": "Five", "status": {"name": "To Do"}, "issueLinks": [
+		{"type": {"name": "Blocks", "inward": "is blocked by", "outward": "blocks"}, "outwardIssue": {"key": "SP-6", "fields": {"status": {"name": "To Do"}}}}
+	]}},
+	{"key": "SP-6", "fields": {"summary": "Six", "status": {"name": "To Do"}, "issueLinks": [
+		{"type": {"name": "Blocks", "inward": "is blocked by", "outward": "blocks"}, "outwardIssue": {"key": "SP-5", "fields": {"status": {"name": "To Do"}}}}
+	]}},
+	{"key": "SP-7", "fields": {"summary": "Seven", "status": {"name": "Closed"}, "resolution": {"name": "Fixed"}, "issueLinks": [
+		{"type": {"name": "Blocks", "inward": "is blocked by", "outward": "blocks"}, "inwardIssue": {"key": "OUT-1", "fields": {"status": {"name": "To Do"}}}}
+	]}}
+]`
+
+func getSprintIssues(t *testing.T) []*jira.Issue {
+	var issues []*jira.Issue
+	assert.NoError(t, json.Unmarshal([]byte(sprintJSON), &issues))
+	return issues
+}
+
+func blockedKeys(blocked []BlockedIssue) map[string][]string {
+	out := make(map[string][]string)
+	for _, b := range blocked {
+		for _, by := range b.By {
+			out[b.Issue.Key] = append(out[b.Issue.Key], by.Key)
+		}
+	}
+	return out
+}
+
+func TestBlockers(t *testing.T) {
+	r := Blockers(getSprintIssues(t), "blocks", nil, workflow.Default())
+
+	assert.Equal(t, 7, r.Total)
+	assert.Equal(t, map[string][]string{"SP-2": {"OUT-1"}}, blockedKeys(r.External))
+	assert.Equal(t, map[string][]string{"SP-2": {"SP-1", "OUT-1"}}, blockedKeys(r.InProgress))
+	assert.Equal(t, []string{"SP-1", "SP-2", "SP-3"}, r.CriticalPath)
+	assert.Equal(t, 3.0, r.CriticalWeight)
+	assert.Equal(t, [][]string{{"SP-5", "SP-6"}}, r.Cycles)
+
+	out, ok := r.Graph.Node("OUT-1")
+	assert.True(t, ok)
+	assert.Equal(t, "Out", out.Summary)
+}
+
+func TestBlockersWithPoints(t *testing.T) {
+	points := map[string]float64{"SP-1": 1, "SP-2": 2, "SP-3": 3, "SP-4": 8}
+
+	r := Blockers(getSprintIssues(t), "Blocks", points, workflow.Default())
+
+	assert.Equal(t, []string{"SP-4", "SP-3"}, r.CriticalPath)
+	assert.Equal(t, 11.0, r.CriticalWeight)
+}
+
+func TestBlockersNoLinks(t *testing.T) {
+	r := Blockers(getSprintIssues(t), "Dependency", nil, workflow.Default())
+
+	assert.Empty(t, r.External)
+	assert.Empty(t, r.InProgress)
+	assert.Empty(t, r.CriticalPath)
+	assert.Empty(t, r.Cycles)
+}
+
+func TestBlockersDoneCategory(t *testing.T) {
+	const issuesJSON = `[
+		{"key": "SP-1", "fields": {"summary": "One", "status": {"name": "In Progress", "statusCategory": {"key": "indeterminate"}}, "issueLinks": [
+			{"type": {"name": "Blocks", "inward": "is blocked by", "outward": "blocks"}, "inwardIssue": {"key": "OUT-1", "fields": {"status": {"name": "Closed", "statusCategory": {"key": "done"}}}}},
+			{"type": {"name": "Blocks", "inward": "is blocked by", "outward": "blocks"}, "inwardIssue": {"key": "OUT-2", "fields": {"status": {"name": "Won't Do", "statusCategory": {"key": "done"}}}}}
+		]}}
+	]`
+
+	var issues []*jira.Issue
+	assert.NoError(t, json.Unmarshal([]byte(issuesJSON), &issues))
+
+	r := Blockers(issues, "Blocks", nil, workflow.Default())
+
+	assert.Empty(t, r.External)
+	assert.Empty(t, r.InProgress)
+
+	out, ok := r.Graph.Node("OUT-1")
+	assert.True(t, ok)
+	assert.Equal(t, "done", out.StatusCategory)
+}
@@ -0,0 +1,309 @@
+// Package graph builds dependency graphs of issues from their links,
+// subtasks, parents and epic children.
+package graph
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// Relation types that are not issue links.
+const (
+	// TypeSubtask relates a parent to its subtasks.
+	TypeSubtask = "Subtask"
+	// TypeEpic relates an epic to its children.
+	TypeEpic = "Epic"
+	// TypeBlocks is the name of the default blocking link type.
+	TypeBlocks = "Blocks"
+)
+
+// Fetcher fetches the issues a graph is built from.
+type Fetcher interface {
+	// Issue fetches an issue with its links, subtasks and parent.
+	Issue(key string) (*jira.Issue, error)
+	// EpicChildren fetches issues in an epic.
+	EpicChildren(key string) ([]*jira.Issue, error)
+}
+
+// Options control how far and along which relations a graph is built.
+type Options struct {
+	// Depth is the number of hops from the root to follow.
+	Depth int
+	// Types restricts relations to the given link types, eg: blocks or relates.
+	// Use subtask and epic for subtasks, parents and epic children. Empty means all.
+	Types []string
+}
+
+// Node is an issue in a graph.
+type Node struct {
+	Key     string
+	Summary string
+	Type    string
+	Status  string
+	// StatusCategory is the key of the category of the status, eg: done.
+	StatusCategory string
+	// Depth is the number of hops from the root.
+	Depth int
+}
+
+// Edge is a directed relation between two issues.
+type Edge struct {
+	From string
+	To   string
+	// Type is the name of the relation, eg: Blocks.
+	Type string
+	// Outward describes the relation from From, eg: blocks.
+	Outward string
+	// Inward describes the relation from To, eg: is blocked by.
+	Inward string
+}
+
+// Graph is a dependency graph of issues.
+type Graph struct {
+	Root  string
+	Nodes []*Node
+	Edges []Edge
+	// Unreachable are issues that couldn't be fetched and thus weren't expanded.
+	Unreachable []string
+
+	index map[string]*Node
+	edges map[Edge]bool
+}
+
+// Build walks relations of the root issue breadth-first up to opts.Depth hops.
+func Build(f Fetcher, root string, opts Options) (*Graph, error) {
+	g := &Graph{Root: root, index: make(map[string]*Node), edges: make(map[Edge]bool)}
+
+	queue := []string{root}
+	g.node(root, 0)
+
+	for len(queue) > 0 {
+		key := queue[0]
+		queue = queue[1:]
+
+		// Issues at the last hop are already described by the relation they were found through.
+		n := g.index[key]
+		if n.Depth >= opts.Depth && key != root {
+			continue
+		}
+
+		iss, err := f.Issue(key)
+		if err != nil {
+			if key == root {
+				return nil, err
+			}
+			g.Unreachable = append(g.Unreachable, key)
+			continue
+		}
+		n.fill(iss)
+
+		if n.Depth >= opts.Depth {
+			continue
+		}
+
+		queue = append(queue, g.expand(f, iss, n.Depth+1, opts)...)
+	}
+
+	return g, nil
+}
+
+// expand adds relations of iss to the graph and returns keys of newly discovered issues.
+func (g *Graph) expand(f Fetcher, iss *jira.Issue, depth int, opts Options) []string {
+	var discovered []string
+
+	visit := func(other *jira.Issue) {
+		if _, ok := g.index[other.Key]; !ok {
+			discovered = append(discovered, other.Key)
+		}
+		g.node(other.Key, depth).fill(other)
+	}
+
+	for _, link := range iss.Fields.IssueLinks {
+		lt := link.LinkType
+		if !wants(opts.Types, lt.Name, lt.Inward, lt.Outward) {
+			continue
+		}
+		switch {
+		case link.OutwardIssue != nil:
+			visit(link.OutwardIssue)
+			g.edge(Edge{From: iss.Key, To: link.OutwardIssue.Key, Type: lt.Name, Outward: lt.Outward, Inward: lt.Inward})
+		case link.InwardIssue != nil:
+			visit(link.InwardIssue)
+			g.edge(Edge{From: link.InwardIssue.Key, To: iss.Key, Type: lt.Name, Outward: lt.Outward, Inward: lt.Inward})
+		}
+	}
+
+	if wants(opts.Types, TypeSubtask) {
+		for i := range iss.Fields.Subtasks {
+			sub := &iss.Fields.Subtasks[i]
+			visit(sub)
+			g.edge(subtaskEdge(iss.Key, sub.Key))
+		}
+		if p := iss.Fields.Parent; p != nil && p.Key != "" {
+			visit(&jira.Issue{Key: p.Key})
+			g.edge(subtaskEdge(p.Key, iss.Key))
+		}
+	}
+
+	if wants(opts.Types, TypeEpic) && strings.EqualFold(iss.Fields.IssueType.Name, TypeEpic) {
+		children, err := f.EpicChildren(iss.Key)
+		if err != nil {
+			g.Unreachable = append(g.Unreachable, fmt.Sprintf("%s (epic children)", iss.Key))
+		}
+		for _, child := range children {
+			visit(child)
+			g.edge(Edge{From: iss.Key, To: child.Key, Type: TypeEpic, Outward: "contains", Inward: "is in epic"})
+		}
+	}
+
+	return discovered
+}
+
+func subtaskEdge(parent, sub string) Edge {
+	return Edge{From: parent, To: sub, Type: TypeSubtask, Outward: "has subtask", Inward: "is subtask of"}
+}
+
+// node returns the node of key, adding it at depth if it doesn't exist.
+func (g *Graph) node(key string, depth int) *Node {
+	if n, ok := g.index[key]; ok {
+		return n
+	}
+	n := &Node{Key: key, Depth: depth}
+	g.index[key] = n
+	g.Nodes = append(g.Nodes, n)
+	return n
+}
+
+func (g *Graph) edge(e Edge) {
+	if g.edges[e] {
+		return
+	}
+	g.edges[e] = true
+	g.Edges = append(g.Edges, e)
+}
+
+// fill copies issue details to the node. Details already set are kept
+// if the issue doesn't have them, eg: a parent reference without fields.
+func (n *Node) fill(iss *jira.Issue) {
+	if s := iss.Fields.Summary; s != "" {
+		n.Summary = s
+	}
+	if t := iss.Fields.IssueType.Name; t != "" {
+		n.Type = t
+	}
+	if s := iss.Fields.Status.Name; s != "" {
+		n.Status = s
+	}
+	if c := iss.Fields.Status.StatusCategory.Key; c != "" {
+		n.StatusCategory = c
+	}
+}
+
+// Node returns the node of key.
+func (g *Graph) Node(key string) (*Node, bool) {
+	if g.index == nil {
+		g.index = make(map[string]*Node, len(g.Nodes))
+		for _, n := range g.Nodes {
+			g.index[n.Key] = n
+		}
+	}
+	n, ok := g.index[key]
+	return n, ok
+}
+
+// Cycles returns cycles along edges of the given type, eg: issues that
+// block each other. Each cycle starts with its smallest key and doesn't
+// repeat it at the end.
+func (g *Graph) Cycles(typ string) [][]string {
+	adj := make(map[string][]string)
+	for _, e := range g.Edges {
+		if strings.EqualFold(e.Type, typ) {
+			adj[e.From] = append(adj[e.From], e.To)
+		}
+	}
+
+	const (
+		unvisited = iota
+		active
+		finished
+	)
+
+	var (
+		state  = make(map[string]int)
+		stack  []string
+		cycles [][]string
+		seen   = make(map[string]bool)
+	)
+
+	var visit func(key string)
+	visit = func(key string) {
+		state[key] = active
+		stack = append(stack, key)
+
+		for _, next := range adj[key] {
+			switch state[next] {
+			case unvisited:
+				visit(next)
+			case active:
+				cycle := canonical(stack[slices.Index(stack, next):])
+				if id := strings.Join(cycle, " "); !seen[id] {
+					seen[id] = true
+					cycles = append(cycles, cycle)
+				}
+			}
+		}
+
+		stack = stack[:len(stack)-1]
+		state[key] = finished
+	}
+
+	for _, n := range g.Nodes {
+		if state[n.Key] == unvisited {
+			visit(n.Key)
+		}
+	}
+	return cycles
+}
+
+// InCycle tells if the edge is part of any of the cycles.
+func InCycle(e Edge, cycles [][]string) bool {
+	for _, c := range cycles {
+		for i, key := range c {
+			if key == e.From && c[(i+1)%len(c)] == e.To {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// canonical rotates a cycle to start with its smallest key.
+func canonical(cycle []string) []string {
+	start := 0
+	for i, key := range cycle {
+		if key < cycle[start] {
+			start = i
+		}
+	}
+	out := make([]string, 0, len(cycle))
+	out = append(out, cycle[start:]...)
+	return append(out, cycle[:start]...)
+}
+
+// wants tells if any of names is in types. All names are wanted if types is empty.
+func wants(types []string, names ...string) bool {
+	if len(types) == 0 {
+		return true
+	}
+	for _, t := range types {
+		for _, n := range names {
+			if strings.EqualFold(strings.TrimSpace(t), n) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,160 @@
+package graph
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// TEST-1 blocks TEST-2, TEST-2 blocks TEST-3 and TEST-3 blocks TEST-1.
+// TEST-1 relates to TEST-4 and has subtask TEST-5. EPIC-1 contains TEST-1.
+const issuesJSON = `{
+	"TEST-1": {"key": "TEST-1", "fields": {
+		"summary": "Root", "status": {"name": "In Progress"}, "issueType": {"name": "Story"},
+		"parent": {"key": "EPIC-1"},
+		"issueLinks": [
+			{"type": {"name": "Blocks", "inward": "is blocked by", "outward": "blocks"}, "outwardIssue": {"key": "TEST-2", "fields": {"summary": "Second", "status": {"name": "To Do"}}}},
+			{"type": {"name": "Blocks", "inward": "is blocked by", "outward": "blocks"}, "inwardIssue": {"key": "TEST-3", "fields": {"summary": "Third", "status": {"name": "Blocked"}}}},
+			{"type": {"name": "Relates", "inward": "relates to", "outward": "relates to"}, "outwardIssue": {"key": "TEST-4", "fields": {"summary": "Fourth"}}}
+		],
+		"subtasks": [{"key": "TEST-5", "fields": {"summary": "Sub", "status": {"name": "Done"}}}]
+	}},
+	"TEST-2": {"key": "TEST-2", "fields": {
+		"summary": "Second", "status": {"name": "To Do"},
+		"issueLinks": [
+			{"type": {"name": "Blocks", "inward": "is blocked by", "outward": "blocks"}, "inwardIssue": {"key": "TEST-1", "fields": {"summary": "Root"}}},
+			{"type": {"name": "Blocks", "inward": "is blocked by", "outward": "blocks"}, "outwardIssue": {"key": "TEST-3", "fields": {"summary": "Third"}}}
+		]
+	}},
+	"TEST-3": {"key": "TEST-3", "fields": {
+		"summary": "Third", "status": {"name": "Blocked"},
+		"issueLinks": [
+			{"type": {"name": "Blocks", "inward": "is blocked by", "outward": "blocks"}, "outwardIssue": {"key": "TEST-1", "fields": {"summary": "Root"}}},
+			{"type": {"name": "Blocks", "inward": "is blocked by", "outward": "blocks"}, "inwardIssue": {"key": "TEST-2", "fields": {"summary": "Second"}}}
+		]
+	}},
+	"TEST-5": {"key": "TEST-5", "fields": {"summary": "Sub", "status": {"name": "Done"}, "parent": {"key": "TEST-1"}}},
+	"EPIC-1": {"key": "EPIC-1", "fields": {"summary": "Epic", "status": {"name": "In Progress"}, "issueType": {"name": "Epic"}}}
+}`
+
+type fakeFetcher struct {
+	issues  map[string]*jira.Issue
+	fetched []string
+}
+
+func newFakeFetcher(t *testing.T) *fakeFetcher {
+	f := fakeFetcher{}
+	assert.NoError(t, json.Unmarshal([]byte(issuesJSON), &f.issues))
+	return &f
+}
+
+func (f *fakeFetcher) Issue(key string) (*jira.Issue, error) {
+	f.fetched = append(f.fetched, key)
+	if iss, ok := f.issues[key]; ok {
+		return iss, nil
+	}
+	return nil, fmt.Errorf("issue %s not found", key)
+}
+
+func (f *fakeFetcher) EpicChildren(key string) ([]*jira.Issue, error) {
+	if key != "EPIC-1" {
+		return nil, nil
+	}
+	return []*jira.Issue{f.issues["TEST-1"]}, nil
+}
+
+func keys(g *Graph) []string {
+	out := make([]string, 0, len(g.Nodes))
+	for _, n := range g.Nodes {
+		out = append(out, n.Key)
+	}
+	return out
+}
+
+func TestBuild(t *testing.T) {
+	f := newFakeFetcher(t)
+
+	g, err := Build(f, "TEST-1", Options{Depth: 1})
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"TEST-1", "TEST-2", "TEST-3", "TEST-4", "TEST-5", "EPIC-1"}, keys(g))
+	assert.Equal(t, []string{"TEST-1"}, f.fetched)
+	assert.Equal(t, []Edge{
+		{From: "TEST-1", To: "TEST-2", Type: "Blocks", Outward: "blocks", Inward: "is blocked by"},
+		{From: "TEST-3", To: "TEST-1", Type: "Blocks", Outward: "blocks", Inward: "is blocked by"},
+		{From: "TEST-1", To: "TEST-4", Type: "Relates", Outward: "relates to", Inward: "relates to"},
+		{From: "TEST-1", To: "TEST-5", Type: TypeSubtask, Outward: "has subtask", Inward: "is subtask of"},
+		{From: "EPIC-1", To: "TEST-1", Type: TypeSubtask, Outward: "has subtask", Inward: "is subtask of"},
+	}, g.Edges)
+
+	n, ok := g.Node("TEST-2")
+	assert.True(t, ok)
+	assert.Equal(t, &Node{Key: "TEST-2", Summary: "Second", Status: "To Do", Depth: 1}, n)
+
+	assert.Empty(t, g.Cycles(TypeBlocks))
+}
+
+func TestBuildDepth(t *testing.T) {
+	f := newFakeFetcher(t)
+
+	g, err := Build(f, "TEST-1", Options{Depth: 2})
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"TEST-1", "TEST-2", "TEST-3", "TEST-4", "TEST-5", "EPIC-1"}, keys(g))
+	assert.Equal(t, []string{"TEST-1", "TEST-2", "TEST-3", "TEST-4", "TEST-5", "EPIC-1"}, f.fetched)
+	assert.Equal(t, []string{"TEST-4"}, g.Unreachable)
+
+	// The edge between TEST-2 and TEST-3 is found from both sides but added once.
+	assert.Len(t, g.Edges, 7)
+	assert.Contains(t, g.Edges, Edge{From: "TEST-2", To: "TEST-3", Type: "Blocks", Outward: "blocks", Inward: "is blocked by"})
+	assert.Contains(t, g.Edges, Edge{From: "EPIC-1", To: "TEST-1", Type: TypeEpic, Outward: "contains", Inward: "is in epic"})
+
+	epic, _ := g.Node("EPIC-1")
+	assert.Equal(t, "Epic", epic.Summary)
+}
+
+func TestBuildTypes(t *testing.T) {
+	f := newFakeFetcher(t)
+
+	g, err := Build(f, "TEST-1", Options{Depth: 3, Types: []string{"blocks"}})
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"TEST-1", "TEST-2", "TEST-3"}, keys(g))
+	assert.Equal(t, [][]string{{"TEST-1", "TEST-2", "TEST-3"}}, g.Cycles(TypeBlocks))
+
+	g, err = Build(newFakeFetcher(t), "TEST-1", Options{Depth: 3, Types: []string{"relates to", "Subtask"}})
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"TEST-1", "TEST-4", "TEST-5", "EPIC-1"}, keys(g))
+}
+
+func TestBuildRootNotFound(t *testing.T) {
+	_, err := Build(newFakeFetcher(t), "TEST-9", Options{Depth: 1})
+	assert.Error(t, err)
+}
+
+func TestCycles(t *testing.T) {
+	g := &Graph{Edges: []Edge{
+		{From: "C", To: "A", Type: "Blocks"},
+		{From: "A", To: "B", Type: "Blocks"},
+		{From: "B", To: "C", Type: "Blocks"},
+		{From: "B", To: "D", Type: "Blocks"},
+		{From: "D", To: "D", Type: "Blocks"},
+		{From: "D", To: "A", Type: "Relates"},
+	}}
+	for _, k := range []string{"C", "A", "B", "D"} {
+		g.Nodes = append(g.Nodes, &Node{Key: k})
+	}
+
+	cycles := g.Cycles("blocks")
+	assert.Equal(t, [][]string{{"A", "B", "C"}, {"D"}}, cycles)
+
+	assert.True(t, InCycle(g.Edges[0], cycles))
+	assert.True(t, InCycle(g.Edges[4], cycles))
+	assert.False(t, InCycle(g.Edges[3], cycles))
+	assert.False(t, InCycle(g.Edges[5], cycles))
+}
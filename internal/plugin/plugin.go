@@ -0,0 +1,157 @@
+// Package plugin discovers external `jira-<name>` executables that extend the cli.
+package plugin
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+)
+
+// Prefix is the prefix of plugin executable names.
+const Prefix = "jira-"
+
+// Plugin is an external executable that is run as a jira subcommand.
+type Plugin struct {
+	Name string
+	Path string
+	// Shadows lists plugins with the same name that are ignored because
+	// they appear later in the search path.
+	Shadows []string
+}
+
+// Dirs returns directories plugins are searched in: the plugins folder
+// in the jira-cli config directory followed by the directories in PATH.
+func Dirs() []string {
+	var dirs []string
+	if home, err := cmdutil.GetConfigHome(); err == nil {
+		dirs = append(dirs, filepath.Join(home, jiraConfig.Dir, "plugins"))
+	}
+	return append(dirs, filepath.SplitList(os.Getenv("PATH"))...)
+}
+
+// Lookup finds a plugin with the given name in the given directories.
+func Lookup(name string, dirs []string) (*Plugin, bool) {
+	if name == "" || strings.ContainsAny(name, `/\`) {
+		return nil, false
+	}
+	for _, dir := range dirs {
+		if dir == "" {
+			continue
+		}
+		for _, file := range candidates(name) {
+			path := filepath.Join(dir, file)
+			if isExecutable(path) {
+				return &Plugin{Name: name, Path: path}, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// Find lists all plugins in the given directories sorted by name.
+// The first plugin found for a name wins, later ones are recorded as shadowed.
+func Find(dirs []string) []*Plugin {
+	var (
+		plugins []*Plugin
+		byName  = make(map[string]*Plugin)
+		seen    = make(map[string]bool)
+	)
+
+	for _, dir := range dirs {
+		if dir == "" || seen[dir] {
+			continue
+		}
+		seen[dir] = true
+
+		files, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		for _, f := range files {
+			name, ok := pluginName(f.Name())
+			if !ok || f.IsDir() {
+				continue
+			}
+			path := filepath.Join(dir, f.Name())
+			if !isExecutable(path) {
+				continue
+			}
+			if p, ok := byName[name]; ok {
+				p.Shadows = append(p.Shadows, path)
+				continue
+			}
+			p := Plugin{Name: name, Path: path}
+			byName[name] = &p
+			plugins = append(plugins, &p)
+		}
+	}
+
+	sort.Slice(plugins, func(i, j int) bool {
+		return plugins[i].Name < plugins[j].Name
+	})
+	return plugins
+}
+
+// Env returns the environment passed to plugins. Variables in vars
+// override the ones in base, empty values are skipped.
+func Env(base []string, vars map[string]string) []string {
+	env := make([]string, 0, len(base)+len(vars))
+	for _, e := range base {
+		k, _, _ := strings.Cut(e, "=")
+		if _, ok := vars[k]; ok {
+			continue
+		}
+		env = append(env, e)
+	}
+
+	keys := make([]string, 0, len(vars))
+	for k := range vars {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		if vars[k] != "" {
+			env = append(env, k+"="+vars[k])
+		}
+	}
+	return env
+}
+
+func pluginName(file string) (string, bool) {
+	if !strings.HasPrefix(file, Prefix) {
+		return "", false
+	}
+	name := strings.TrimPrefix(file, Prefix)
+	if runtime.GOOS == "windows" {
+		ext := filepath.Ext(name)
+		if !strings.EqualFold(ext, ".exe") {
+			return "", false
+		}
+		name = strings.TrimSuffix(name, ext)
+	}
+	return name, name != ""
+}
+
+func candidates(name string) []string {
+	if runtime.GOOS == "windows" {
+		return []string{Prefix + name + ".exe"}
+	}
+	return []string{Prefix + name}
+}
+
+func isExecutable(path string) bool {
+	info, err := os.Stat(path)
+	if err != nil || info.IsDir() {
+		return false
+	}
+	if runtime.GOOS == "windows" {
+		return true
+	}
+	return info.Mode()&0o111 != 0
+}
@@ -0,0 +1,68 @@
+package plugin
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func writeFile(t *testing.T, dir, name string, perm os.FileMode) string {
+	t.Helper()
+
+	path := filepath.Join(dir, name)
+	assert.NoError(t, os.WriteFile(path, []byte("#!/bin/sh\n"), perm))
+	return path
+}
+
+func TestFindAndLookup(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("plugins are detected by file extension on windows")
+	}
+
+	first, second := t.TempDir(), t.TempDir()
+
+	deploy := writeFile(t, first, "jira-deploy", 0o755)
+	shadowed := writeFile(t, second, "jira-deploy", 0o755)
+	triage := writeFile(t, second, "jira-triage", 0o755)
+	writeFile(t, second, "jira-notes", 0o644)
+	writeFile(t, second, "other-tool", 0o755)
+
+	dirs := []string{first, second, first, filepath.Join(first, "missing")}
+
+	plugins := Find(dirs)
+	assert.Equal(t, []*Plugin{
+		{Name: "deploy", Path: deploy, Shadows: []string{shadowed}},
+		{Name: "triage", Path: triage},
+	}, plugins)
+
+	p, ok := Lookup("deploy", dirs)
+	assert.True(t, ok)
+	assert.Equal(t, deploy, p.Path)
+
+	_, ok = Lookup("notes", dirs)
+	assert.False(t, ok)
+
+	_, ok = Lookup("../jira-deploy", dirs)
+	assert.False(t, ok)
+}
+
+func TestEnv(t *testing.T) {
+	t.Parallel()
+
+	base := []string{"HOME=/home/jon", "JIRA_PROJECT=OLD", "PATH=/usr/bin"}
+	vars := map[string]string{
+		"JIRA_PROJECT": "TEST",
+		"JIRA_SERVER":  "https://jira.local",
+		"JIRA_BOARD":   "",
+	}
+
+	assert.Equal(t, []string{
+		"HOME=/home/jon",
+		"PATH=/usr/bin",
+		"JIRA_PROJECT=TEST",
+		"JIRA_SERVER=https://jira.local",
+	}, Env(base, vars))
+}
@@ -0,0 +1,80 @@
+package view
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// CacheStatsOption is a functional option to wrap cache stats properties.
+type CacheStatsOption func(*CacheStats)
+
+// CacheStats is a http response cache summary view.
+type CacheStats struct {
+	dir    string
+	data   *jira.CacheStats
+	writer io.Writer
+	json   bool
+}
+
+// NewCacheStats initializes a cache stats view.
+func NewCacheStats(dir string, data *jira.CacheStats, opts ...CacheStatsOption) *CacheStats {
+	c := CacheStats{
+		dir:    dir,
+		data:   data,
+		writer: os.Stdout,
+	}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return &c
+}
+
+// WithCacheStatsWriter sets a writer for the cache stats view.
+func WithCacheStatsWriter(w io.Writer) CacheStatsOption {
+	return func(c *CacheStats) {
+		c.writer = w
+	}
+}
+
+// WithCacheStatsJSON renders cache stats as JSON.
+func WithCacheStatsJSON(jsonOut bool) CacheStatsOption {
+	return func(c *CacheStats) {
+		c.json = jsonOut
+	}
+}
+
+// Render renders the cache stats view.
+func (c CacheStats) Render() error {
+	if c.json {
+		out, err := json.MarshalIndent(c.data, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(c.writer, string(out))
+		return err
+	}
+
+	w := tabwriter.NewWriter(c.writer, 0, tabWidth, 1, '\t', 0)
+
+	printTableHeader(w, []string{"ENDPOINT", "TTL", "ENTRIES", "FRESH", "SIZE"})
+	for _, r := range c.data.Rules {
+		ttl := "disabled"
+		if r.TTL > 0 {
+			ttl = r.TTL.String()
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%s\n", r.Name, ttl, r.Entries, r.Fresh, cmdutil.FormatBytes(r.Size))
+	}
+	_, _ = fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%s\n", "TOTAL", "", c.data.Entries, c.data.Fresh, cmdutil.FormatBytes(c.data.Size))
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintf(c.writer, "\nCache directory: %s\n", c.dir)
+	return err
+}
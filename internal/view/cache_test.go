@@ -0,0 +1,37 @@
+package view
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestCacheStatsRender(t *testing.T) {
+	var b bytes.Buffer
+
+	data := &jira.CacheStats{
+		Entries: 3,
+		Fresh:   2,
+		Size:    2560,
+		Rules: []*jira.CacheRuleStats{
+			{Name: "fields", TTL: 24 * time.Hour, Entries: 2, Fresh: 2, Size: 2048},
+			{Name: "transitions", TTL: 5 * time.Minute, Entries: 1, Size: 512},
+			{Name: "me"},
+		},
+	}
+	assert.NoError(t, NewCacheStats("/tmp/jira-cli", data, WithCacheStatsWriter(&b)).Render())
+
+	expected := `ENDPOINT	TTL		ENTRIES	FRESH	SIZE
+fields		24h0m0s		2	2	2.0 KiB
+transitions	5m0s		1	0	512 B
+me		disabled	0	0	0 B
+TOTAL				3	2	2.5 KiB
+
+Cache directory: /tmp/jira-cli
+`
+	assert.Equal(t, expected, b.String())
+}
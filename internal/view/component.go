@@ -0,0 +1,118 @@
+package view
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/tui"
+)
+
+// ComponentOption is a functional option to wrap component properties.
+type ComponentOption func(*Component)
+
+// Component is a project component view.
+type Component struct {
+	data   []*jira.Component
+	writer io.Writer
+	buf    *bytes.Buffer
+	plain  bool
+	json   bool
+}
+
+// NewComponent initializes a component view.
+func NewComponent(data []*jira.Component, opts ...ComponentOption) *Component {
+	c := Component{
+		data: data,
+		buf:  new(bytes.Buffer),
+	}
+	c.writer = tabwriter.NewWriter(c.buf, 0, tabWidth, 1, '\t', 0)
+
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return &c
+}
+
+// WithComponentWriter sets a writer for the component view.
+func WithComponentWriter(w io.Writer) ComponentOption {
+	return func(c *Component) {
+		c.writer = w
+	}
+}
+
+// WithComponentPlain prints the output directly to stdout instead of the pager.
+func WithComponentPlain(plain bool) ComponentOption {
+	return func(c *Component) {
+		c.plain = plain
+	}
+}
+
+// WithComponentJSON renders components as a JSON array.
+func WithComponentJSON(jsonOut bool) ComponentOption {
+	return func(c *Component) {
+		c.json = jsonOut
+	}
+}
+
+// Render renders the component view.
+func (c Component) Render() error {
+	if c.json {
+		out, err := json.MarshalIndent(c.data, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, _ = fmt.Fprintln(c.writer, string(out))
+	} else {
+		c.printHeader()
+
+		for _, d := range c.data {
+			var lead string
+			if d.Lead != nil {
+				lead = d.Lead.DisplayName
+			}
+			_, _ = fmt.Fprintf(
+				c.writer, "%s\t%s\t%s\t%s\t%s\n",
+				d.ID, prepareTitle(d.Name), lead, d.AssigneeType, prepareTitle(d.Description),
+			)
+		}
+	}
+	if _, ok := c.writer.(*tabwriter.Writer); ok {
+		err := c.writer.(*tabwriter.Writer).Flush()
+		if err != nil {
+			return err
+		}
+	}
+
+	if c.plain || c.json {
+		_, err := fmt.Fprint(os.Stdout, c.buf.String())
+		return err
+	}
+	return tui.PagerOut(c.buf.String())
+}
+
+func (c Component) header() []string {
+	return []string{
+		"ID",
+		"NAME",
+		"LEAD",
+		"ASSIGNEE TYPE",
+		"DESCRIPTION",
+	}
+}
+
+func (c Component) printHeader() {
+	headers := c.header()
+	end := len(headers) - 1
+	for i, h := range headers {
+		_, _ = fmt.Fprintf(c.writer, "%s", h)
+		if i != end {
+			_, _ = fmt.Fprintf(c.writer, "\t")
+		}
+	}
+	_, _ = fmt.Fprintln(c.writer)
+}
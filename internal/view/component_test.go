@@ -0,0 +1,55 @@
+package view
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestComponentRender(t *testing.T) {
+	var b bytes.Buffer
+
+	data := []*jira.Component{
+		{
+			ID:           "10000",
+			Name:         "Backend",
+			Description:  "Server side services",
+			Lead:         &jira.User{DisplayName: "Jane Doe"},
+			AssigneeType: jira.AssigneeTypeComponentLead,
+		},
+		{ID: "10001", Name: "Frontend", AssigneeType: jira.AssigneeTypeProjectDefault},
+	}
+	component := NewComponent(data, WithComponentWriter(&b))
+	assert.NoError(t, component.Render())
+
+	expected := `ID	NAME	LEAD	ASSIGNEE TYPE	DESCRIPTION
+10000	Backend	Jane Doe	COMPONENT_LEAD	Server side services
+10001	Frontend		PROJECT_DEFAULT	
+`
+	assert.Equal(t, expected, b.String())
+}
+
+func TestComponentRenderJSON(t *testing.T) {
+	var b bytes.Buffer
+
+	data := []*jira.Component{
+		{ID: "10001", Name: "Frontend", Project: "TEST", ProjectID: 10000},
+	}
+	component := NewComponent(data, WithComponentWriter(&b), WithComponentJSON(true))
+	assert.NoError(t, component.Render())
+
+	expected := `[
+  {
+    "id": "10001",
+    "name": "Frontend",
+    "isAssigneeTypeValid": false,
+    "project": "TEST",
+    "projectId": 10000
+  }
+]
+`
+	assert.Equal(t, expected, b.String())
+}
@@ -0,0 +1,86 @@
+package view
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ankitpokhrel/jira-cli/internal/feed"
+)
+
+// FeedOption is a functional option to wrap feed properties.
+type FeedOption func(*Feed)
+
+// Feed is a chronological activity stream view.
+type Feed struct {
+	data     []feed.Event
+	writer   io.Writer
+	timezone string
+	json     bool
+}
+
+// NewFeed initializes a feed view.
+func NewFeed(data []feed.Event, opts ...FeedOption) *Feed {
+	f := Feed{
+		data:   data,
+		writer: os.Stdout,
+	}
+	for _, opt := range opts {
+		opt(&f)
+	}
+	return &f
+}
+
+// WithFeedWriter sets a writer for the feed view.
+func WithFeedWriter(w io.Writer) FeedOption {
+	return func(f *Feed) {
+		f.writer = w
+	}
+}
+
+// WithFeedTimezone sets a timezone activity time is displayed in.
+func WithFeedTimezone(tz string) FeedOption {
+	return func(f *Feed) {
+		f.timezone = tz
+	}
+}
+
+// WithFeedJSON renders the feed as JSON.
+func WithFeedJSON(jsonOut bool) FeedOption {
+	return func(f *Feed) {
+		f.json = jsonOut
+	}
+}
+
+// Render renders the feed view.
+func (f Feed) Render() error {
+	if f.json {
+		data := f.data
+		if data == nil {
+			data = []feed.Event{}
+		}
+		out, err := json.MarshalIndent(data, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(f.writer, string(out))
+		return err
+	}
+
+	w := tabwriter.NewWriter(f.writer, 0, tabWidth, 1, '\t', 0)
+
+	printTableHeader(w, []string{"WHEN", "ACTIVITY", "SUMMARY"})
+	for _, e := range f.data {
+		t := e.Time
+		if f.timezone == "" {
+			t = t.Local()
+		}
+		when := formatDateTime(t.Format(time.RFC3339), time.RFC3339, f.timezone)
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", when, e, e.Summary)
+	}
+
+	return w.Flush()
+}
@@ -0,0 +1,55 @@
+package view
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/internal/feed"
+)
+
+func TestFeedRender(t *testing.T) {
+	data := []feed.Event{
+		{
+			Time: time.Date(2024, 5, 1, 10, 30, 0, 0, time.UTC), Kind: feed.Commented,
+			Key: "ABC-9", Summary: "Flaky test", Author: "Bob",
+		},
+		{
+			Time: time.Date(2024, 5, 1, 11, 0, 0, 0, time.UTC), Kind: feed.Changed,
+			Key: "ABC-1", Summary: "Fix login", Author: "Alice", Field: "status", From: "In Progress", To: "Review",
+		},
+	}
+
+	var b bytes.Buffer
+	assert.NoError(t, NewFeed(data, WithFeedWriter(&b), WithFeedTimezone("Asia/Kathmandu")).Render())
+
+	expected := `WHEN			ACTIVITY			SUMMARY
+2024-05-01 16:15:00	Bob commented on ABC-9		Flaky test
+2024-05-01 16:45:00	Alice moved ABC-1 to Review	Fix login
+`
+	assert.Equal(t, expected, b.String())
+
+	b.Reset()
+	assert.NoError(t, NewFeed(data[1:], WithFeedWriter(&b), WithFeedJSON(true)).Render())
+
+	expectedJSON := `[
+  {
+    "time": "2024-05-01T11:00:00Z",
+    "kind": "change",
+    "key": "ABC-1",
+    "summary": "Fix login",
+    "author": "Alice",
+    "field": "status",
+    "from": "In Progress",
+    "to": "Review"
+  }
+]
+`
+	assert.Equal(t, expectedJSON, b.String())
+
+	b.Reset()
+	assert.NoError(t, NewFeed(nil, WithFeedWriter(&b), WithFeedJSON(true)).Render())
+	assert.Equal(t, "[]\n", b.String())
+}
@@ -0,0 +1,167 @@
+package view
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/tui"
+)
+
+// FilterDiffOption is a functional option to wrap filter diff properties.
+type FilterDiffOption func(*FilterDiff)
+
+// FilterDiff is a view of issues that differ between results of two saved filters.
+type FilterDiff struct {
+	left, right *jira.SavedFilter
+	onlyLeft    []*jira.Issue
+	onlyRight   []*jira.Issue
+	both        []*jira.Issue
+	writer      io.Writer
+	buf         *bytes.Buffer
+	common      bool
+	plain       bool
+	json        bool
+}
+
+// NewFilterDiff initializes a filter diff view from results of the two filters.
+func NewFilterDiff(left, right *jira.SavedFilter, leftIssues, rightIssues []*jira.Issue, opts ...FilterDiffOption) *FilterDiff {
+	fd := FilterDiff{
+		left:  left,
+		right: right,
+		buf:   new(bytes.Buffer),
+	}
+	fd.writer = tabwriter.NewWriter(fd.buf, 0, tabWidth, 1, '\t', 0)
+
+	inRight := make(map[string]struct{}, len(rightIssues))
+	for _, iss := range rightIssues {
+		inRight[iss.Key] = struct{}{}
+	}
+	inLeft := make(map[string]struct{}, len(leftIssues))
+	for _, iss := range leftIssues {
+		inLeft[iss.Key] = struct{}{}
+		if _, ok := inRight[iss.Key]; ok {
+			fd.both = append(fd.both, iss)
+		} else {
+			fd.onlyLeft = append(fd.onlyLeft, iss)
+		}
+	}
+	for _, iss := range rightIssues {
+		if _, ok := inLeft[iss.Key]; !ok {
+			fd.onlyRight = append(fd.onlyRight, iss)
+		}
+	}
+
+	for _, opt := range opts {
+		opt(&fd)
+	}
+	return &fd
+}
+
+// WithFilterDiffWriter sets a writer for the filter diff view.
+func WithFilterDiffWriter(w io.Writer) FilterDiffOption {
+	return func(fd *FilterDiff) {
+		fd.writer = w
+	}
+}
+
+// WithFilterDiffCommon includes issues that are in the results of both filters.
+func WithFilterDiffCommon(common bool) FilterDiffOption {
+	return func(fd *FilterDiff) {
+		fd.common = common
+	}
+}
+
+// WithFilterDiffPlain prints the output directly to stdout instead of the pager.
+func WithFilterDiffPlain(plain bool) FilterDiffOption {
+	return func(fd *FilterDiff) {
+		fd.plain = plain
+	}
+}
+
+// WithFilterDiffJSON renders issue keys of each set as JSON.
+func WithFilterDiffJSON(jsonOut bool) FilterDiffOption {
+	return func(fd *FilterDiff) {
+		fd.json = jsonOut
+	}
+}
+
+// Render renders the filter diff view.
+func (fd FilterDiff) Render() error {
+	if fd.json {
+		if err := fd.printJSON(); err != nil {
+			return err
+		}
+	} else {
+		printTableHeader(fd.writer, []string{"IN", "KEY", "TYPE", "STATUS", "SUMMARY"})
+
+		fd.printRows("only "+fd.left.ID, fd.onlyLeft)
+		fd.printRows("only "+fd.right.ID, fd.onlyRight)
+		if fd.common {
+			fd.printRows("both", fd.both)
+		}
+
+		_, _ = fmt.Fprintf(
+			fd.writer, "\n%d only in %s (%s), %d only in %s (%s), %d in both\n",
+			len(fd.onlyLeft), fd.left.ID, fd.left.Name, len(fd.onlyRight), fd.right.ID, fd.right.Name, len(fd.both),
+		)
+	}
+	if tw, ok := fd.writer.(*tabwriter.Writer); ok {
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+	}
+
+	if fd.plain || fd.json {
+		_, err := fmt.Fprint(os.Stdout, fd.buf.String())
+		return err
+	}
+	return tui.PagerOut(fd.buf.String())
+}
+
+func (fd FilterDiff) printRows(in string, issues []*jira.Issue) {
+	for _, iss := range issues {
+		_, _ = fmt.Fprintf(
+			fd.writer, "%s\t%s\t%s\t%s\t%s\n",
+			in, iss.Key, iss.Fields.IssueType.Name, iss.Fields.Status.Name, prepareTitle(iss.Fields.Summary),
+		)
+	}
+}
+
+func (fd FilterDiff) printJSON() error {
+	keys := func(issues []*jira.Issue) []string {
+		out := make([]string, 0, len(issues))
+		for _, iss := range issues {
+			out = append(out, iss.Key)
+		}
+		return out
+	}
+
+	type filter struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+		JQL  string `json:"jql"`
+	}
+	out, err := json.MarshalIndent(struct {
+		Left      filter   `json:"left"`
+		Right     filter   `json:"right"`
+		OnlyLeft  []string `json:"onlyLeft"`
+		OnlyRight []string `json:"onlyRight"`
+		Both      []string `json:"both"`
+	}{
+		Left:      filter{ID: fd.left.ID, Name: fd.left.Name, JQL: fd.left.JQL},
+		Right:     filter{ID: fd.right.ID, Name: fd.right.Name, JQL: fd.right.JQL},
+		OnlyLeft:  keys(fd.onlyLeft),
+		OnlyRight: keys(fd.onlyRight),
+		Both:      keys(fd.both),
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(fd.writer, string(out))
+	return err
+}
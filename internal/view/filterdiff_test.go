@@ -0,0 +1,91 @@
+package view
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func filterDiffIssue(key, status, summary string) *jira.Issue {
+	iss := jira.Issue{Key: key}
+	iss.Fields.IssueType.Name = "Bug"
+	iss.Fields.Status.Name = status
+	iss.Fields.Summary = summary
+	return &iss
+}
+
+func filterDiffData() (*jira.SavedFilter, *jira.SavedFilter, []*jira.Issue, []*jira.Issue) {
+	left := &jira.SavedFilter{ID: "10000", Name: "My bugs", JQL: "type = Bug AND assignee = currentUser()"}
+	right := &jira.SavedFilter{ID: "10001", Name: "Open bugs", JQL: "type = Bug AND status != Done"}
+
+	leftIssues := []*jira.Issue{
+		filterDiffIssue("ABC-1", "Done", "Login fails"),
+		filterDiffIssue("ABC-2", "To Do", "Crash on save"),
+	}
+	rightIssues := []*jira.Issue{
+		filterDiffIssue("ABC-2", "To Do", "Crash on save"),
+		filterDiffIssue("ABC-3", "In Progress", "Slow search"),
+	}
+	return left, right, leftIssues, rightIssues
+}
+
+func TestFilterDiffRender(t *testing.T) {
+	left, right, leftIssues, rightIssues := filterDiffData()
+
+	var b bytes.Buffer
+	assert.NoError(t, NewFilterDiff(left, right, leftIssues, rightIssues, WithFilterDiffWriter(&b)).Render())
+
+	expected := `IN	KEY	TYPE	STATUS	SUMMARY
+only 10000	ABC-1	Bug	Done	Login fails
+only 10001	ABC-3	Bug	In Progress	Slow search
+
+1 only in 10000 (My bugs), 1 only in 10001 (Open bugs), 1 in both
+`
+	assert.Equal(t, expected, b.String())
+
+	b.Reset()
+	assert.NoError(t, NewFilterDiff(left, right, leftIssues, rightIssues, WithFilterDiffWriter(&b), WithFilterDiffCommon(true)).Render())
+	assert.Contains(t, b.String(), "both\tABC-2\tBug\tTo Do\tCrash on save\n")
+}
+
+func TestFilterDiffRenderJSON(t *testing.T) {
+	left, right, leftIssues, rightIssues := filterDiffData()
+
+	var b bytes.Buffer
+	assert.NoError(t, NewFilterDiff(left, right, leftIssues, rightIssues, WithFilterDiffWriter(&b), WithFilterDiffJSON(true)).Render())
+
+	var out struct {
+		Left      struct{ ID string } `json:"left"`
+		OnlyLeft  []string            `json:"onlyLeft"`
+		OnlyRight []string            `json:"onlyRight"`
+		Both      []string            `json:"both"`
+	}
+	assert.NoError(t, json.Unmarshal(b.Bytes(), &out))
+	assert.Equal(t, "10000", out.Left.ID)
+	assert.Equal(t, []string{"ABC-1"}, out.OnlyLeft)
+	assert.Equal(t, []string{"ABC-3"}, out.OnlyRight)
+	assert.Equal(t, []string{"ABC-2"}, out.Both)
+}
+
+func TestFilterPermissionRender(t *testing.T) {
+	var data []*jira.FilterPermission
+	assert.NoError(t, json.Unmarshal([]byte(`[
+		{"id": 10010, "type": "group", "group": {"name": "jira-developers"}},
+		{"id": 10011, "type": "project", "project": {"id": "10000", "key": "ABC", "name": "Alpha"}},
+		{"id": 10013, "type": "user", "user": {"displayName": "Jane Doe"}}
+	]`), &data))
+
+	var b bytes.Buffer
+	assert.NoError(t, NewFilterPermission(data, WithFilterPermissionWriter(&b)).Render())
+
+	expected := `ID	TYPE	SHARED WITH
+10010	group	jira-developers
+10011	project	ABC
+10013	user	Jane Doe
+`
+	assert.Equal(t, expected, b.String())
+}
@@ -0,0 +1,60 @@
+package view
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// FilterPermissionOption is a functional option to wrap filter permission properties.
+type FilterPermissionOption func(*FilterPermission)
+
+// FilterPermission is a view of share permissions of a saved filter.
+type FilterPermission struct {
+	data   []*jira.FilterPermission
+	writer io.Writer
+	buf    *bytes.Buffer
+}
+
+// NewFilterPermission initializes a filter permission view.
+func NewFilterPermission(data []*jira.FilterPermission, opts ...FilterPermissionOption) *FilterPermission {
+	fp := FilterPermission{
+		data: data,
+		buf:  new(bytes.Buffer),
+	}
+	fp.writer = tabwriter.NewWriter(fp.buf, 0, tabWidth, 1, '\t', 0)
+
+	for _, opt := range opts {
+		opt(&fp)
+	}
+	return &fp
+}
+
+// WithFilterPermissionWriter sets a writer for the filter permission view.
+func WithFilterPermissionWriter(w io.Writer) FilterPermissionOption {
+	return func(fp *FilterPermission) {
+		fp.writer = w
+	}
+}
+
+// Render renders the filter permission view.
+func (fp FilterPermission) Render() error {
+	printTableHeader(fp.writer, []string{"ID", "TYPE", "SHARED WITH"})
+
+	for _, p := range fp.data {
+		_, _ = fmt.Fprintf(fp.writer, "%s\t%s\t%s\n", strconv.Itoa(p.ID), p.Type, p.With())
+	}
+	if tw, ok := fp.writer.(*tabwriter.Writer); ok {
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+	}
+
+	_, err := fmt.Fprint(os.Stdout, fp.buf.String())
+	return err
+}
@@ -0,0 +1,320 @@
+package view
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/fatih/color"
+
+	"github.com/ankitpokhrel/jira-cli/internal/graph"
+	"github.com/ankitpokhrel/jira-cli/internal/workflow"
+)
+
+// Supported issue graph formats.
+const (
+	IssueGraphFormatASCII   = "ascii"
+	IssueGraphFormatDot     = "dot"
+	IssueGraphFormatMermaid = "mermaid"
+)
+
+// Summaries in graph nodes are shortened to keep them readable, +1 for the ellipsis.
+const graphSummaryLength = 41
+
+// Fill colors of graph nodes by workflow state, the same that Jira uses for lozenges.
+var graphStateColors = map[workflow.State]string{
+	workflow.Todo:    "#dfe1e6",
+	workflow.Doing:   "#deebff",
+	workflow.Review:  "#eae6ff",
+	workflow.Done:    "#e3fcef",
+	workflow.Blocked: "#ffebe6",
+}
+
+var graphStateTermColors = map[workflow.State]color.Attribute{
+	workflow.Doing:   color.FgBlue,
+	workflow.Review:  color.FgMagenta,
+	workflow.Done:    color.FgGreen,
+	workflow.Blocked: color.FgRed,
+}
+
+var (
+	mermaidIDReplacer = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
+	dotReplacer     = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+	mermaidReplacer = strings.NewReplacer(`#`, "#35;", `"`, "#quot;", `|`, "#124;", `<`, "#lt;", `>`, "#gt;", `\`, "#92;")
+)
+
+// IssueGraphOption is a functional option to wrap issue graph properties.
+type IssueGraphOption func(*IssueGraph)
+
+// IssueGraph is a dependency graph view.
+type IssueGraph struct {
+	graph    *graph.Graph
+	cycles   [][]string
+	format   string
+	workflow workflow.Workflow
+	writer   io.Writer
+}
+
+// NewIssueGraph initializes an issue graph view.
+func NewIssueGraph(g *graph.Graph, opts ...IssueGraphOption) *IssueGraph {
+	ig := IssueGraph{
+		graph:    g,
+		cycles:   g.Cycles(graph.TypeBlocks),
+		format:   IssueGraphFormatASCII,
+		workflow: workflow.Default(),
+		writer:   os.Stdout,
+	}
+
+	for _, opt := range opts {
+		opt(&ig)
+	}
+	return &ig
+}
+
+// WithIssueGraphWriter sets a writer for the issue graph.
+func WithIssueGraphWriter(w io.Writer) IssueGraphOption {
+	return func(ig *IssueGraph) {
+		ig.writer = w
+	}
+}
+
+// WithIssueGraphFormat sets the output format of the issue graph.
+func WithIssueGraphFormat(format string) IssueGraphOption {
+	return func(ig *IssueGraph) {
+		ig.format = format
+	}
+}
+
+// WithIssueGraphWorkflow sets the workflow used to color statuses.
+func WithIssueGraphWorkflow(wf workflow.Workflow) IssueGraphOption {
+	return func(ig *IssueGraph) {
+		ig.workflow = wf
+	}
+}
+
+// Cycles returns issues that block each other.
+func (ig IssueGraph) Cycles() [][]string {
+	return ig.cycles
+}
+
+// Render renders the issue graph.
+func (ig IssueGraph) Render() error {
+	var out string
+
+	switch ig.format {
+	case IssueGraphFormatASCII:
+		out = ig.ascii()
+	case IssueGraphFormatDot:
+		out = ig.dot()
+	case IssueGraphFormatMermaid:
+		out = ig.mermaid()
+	default:
+		return fmt.Errorf(
+			"invalid format %q, valid formats are: %s, %s, %s",
+			ig.format, IssueGraphFormatASCII, IssueGraphFormatDot, IssueGraphFormatMermaid,
+		)
+	}
+
+	_, err := io.WriteString(ig.writer, out)
+	return err
+}
+
+func (ig IssueGraph) dot() string {
+	var s strings.Builder
+
+	s.WriteString("digraph issues {\n")
+	s.WriteString("  rankdir=LR;\n")
+	s.WriteString("  node [shape=box, style=\"rounded,filled\", fontname=\"Helvetica\"];\n")
+	s.WriteString("  edge [fontname=\"Helvetica\", fontsize=10];\n")
+
+	for _, n := range ig.graph.Nodes {
+		attrs := fmt.Sprintf("label=%s, fillcolor=%q", dotQuote(nodeLabel(n, "\n")), ig.fillColor(n))
+		if n.Key == ig.graph.Root {
+			attrs += ", penwidth=2"
+		}
+		s.WriteString(fmt.Sprintf("  %s [%s];\n", dotQuote(n.Key), attrs))
+	}
+
+	for _, e := range ig.graph.Edges {
+		attrs := "label=" + dotQuote(e.Outward)
+		switch {
+		case graph.InCycle(e, ig.cycles):
+			attrs += ", color=\"#de350b\", fontcolor=\"#de350b\", penwidth=2"
+		case e.Type == graph.TypeSubtask || e.Type == graph.TypeEpic:
+			attrs += ", style=dashed"
+		}
+		s.WriteString(fmt.Sprintf("  %s -> %s [%s];\n", dotQuote(e.From), dotQuote(e.To), attrs))
+	}
+
+	s.WriteString("}\n")
+	return s.String()
+}
+
+func (ig IssueGraph) mermaid() string {
+	var s strings.Builder
+
+	s.WriteString("graph LR\n")
+
+	for _, n := range ig.graph.Nodes {
+		label := strings.ReplaceAll(mermaidText(nodeLabel(n, "\n")), "\n", "<br/>")
+		s.WriteString(fmt.Sprintf("  %s[\"%s\"]\n", mermaidID(n.Key), label))
+	}
+
+	var cycleLinks []int
+	for i, e := range ig.graph.Edges {
+		arrow := "-->"
+		if e.Type == graph.TypeSubtask || e.Type == graph.TypeEpic {
+			arrow = "-.->"
+		}
+		s.WriteString(fmt.Sprintf("  %s %s|%s| %s\n", mermaidID(e.From), arrow, mermaidText(e.Outward), mermaidID(e.To)))
+		if graph.InCycle(e, ig.cycles) {
+			cycleLinks = append(cycleLinks, i)
+		}
+	}
+
+	for _, st := range workflow.States {
+		s.WriteString(fmt.Sprintf("  classDef %s fill:%s,stroke:#42526e\n", st, graphStateColors[st]))
+	}
+	for _, n := range ig.graph.Nodes {
+		if st, ok := ig.workflow.StateOf(n.Status); ok {
+			s.WriteString(fmt.Sprintf("  class %s %s\n", mermaidID(n.Key), st))
+		}
+	}
+	for _, i := range cycleLinks {
+		s.WriteString(fmt.Sprintf("  linkStyle %d stroke:#de350b,stroke-width:2px\n", i))
+	}
+
+	return s.String()
+}
+
+// ascii renders the graph as a tree rooted at the root issue. Issues are expanded
+// under the relation closest to the root, other relations to them are only listed.
+func (ig IssueGraph) ascii() string {
+	type neighbour struct {
+		key, label string
+		edge       int
+	}
+
+	adj := make(map[string][]neighbour)
+	for i, e := range ig.graph.Edges {
+		adj[e.From] = append(adj[e.From], neighbour{key: e.To, label: e.Outward, edge: i})
+		adj[e.To] = append(adj[e.To], neighbour{key: e.From, label: e.Inward, edge: i})
+	}
+
+	// Find the tree edges breadth-first, so that issues show up at their shortest distance.
+	tree := make(map[int]bool)
+	seen := map[string]bool{ig.graph.Root: true}
+	for queue := []string{ig.graph.Root}; len(queue) > 0; queue = queue[1:] {
+		for _, nb := range adj[queue[0]] {
+			if !seen[nb.key] {
+				seen[nb.key] = true
+				tree[nb.edge] = true
+				queue = append(queue, nb.key)
+			}
+		}
+	}
+
+	var (
+		s       strings.Builder
+		printed = make(map[int]bool)
+	)
+
+	root, _ := ig.graph.Node(ig.graph.Root)
+	s.WriteString(ig.asciiNode(root) + "\n")
+
+	var walk func(key, prefix string)
+	walk = func(key, prefix string) {
+		var children []neighbour
+		for _, nb := range adj[key] {
+			if !printed[nb.edge] {
+				printed[nb.edge] = true
+				children = append(children, nb)
+			}
+		}
+
+		for i, c := range children {
+			branch, indent := "├── ", "│   "
+			if i == len(children)-1 {
+				branch, indent = "└── ", "    "
+			}
+
+			label := c.label
+			if graph.InCycle(ig.graph.Edges[c.edge], ig.cycles) {
+				label = coloredOut(label, color.FgRed, color.Bold)
+			}
+
+			n, _ := ig.graph.Node(c.key)
+			line := fmt.Sprintf("%s%s%s %s", prefix, branch, label, ig.asciiNode(n))
+			if !tree[c.edge] {
+				s.WriteString(line + " " + gray("(repeated)") + "\n")
+				continue
+			}
+			s.WriteString(line + "\n")
+			walk(c.key, prefix+indent)
+		}
+	}
+	walk(ig.graph.Root, "")
+
+	if len(ig.cycles) > 0 {
+		s.WriteString("\n" + coloredOut("Blocking cycles:", color.FgRed, color.Bold) + "\n")
+		for _, c := range ig.cycles {
+			s.WriteString(fmt.Sprintf("  %s → %s\n", strings.Join(c, " → "), c[0]))
+		}
+	}
+
+	return s.String()
+}
+
+func (ig IssueGraph) asciiNode(n *graph.Node) string {
+	out := coloredOut(n.Key, color.FgGreen, color.Bold)
+	if n.Summary != "" {
+		out += " " + n.Summary
+	}
+	if n.Status != "" {
+		status := "[" + n.Status + "]"
+		if st, ok := ig.workflow.StateOf(n.Status); ok {
+			if clr, ok := graphStateTermColors[st]; ok {
+				status = coloredOut(status, clr)
+			}
+		}
+		out += " " + status
+	}
+	return out
+}
+
+func (ig IssueGraph) fillColor(n *graph.Node) string {
+	if st, ok := ig.workflow.StateOf(n.Status); ok {
+		return graphStateColors[st]
+	}
+	return "#ffffff"
+}
+
+func nodeLabel(n *graph.Node, sep string) string {
+	label := n.Key
+	if summary := n.Summary; summary != "" {
+		if len(summary) > graphSummaryLength {
+			summary = shortenAndPad(summary, graphSummaryLength)
+		}
+		label += sep + summary
+	}
+	if n.Status != "" {
+		label += sep + n.Status
+	}
+	return label
+}
+
+func dotQuote(s string) string {
+	return `"` + dotReplacer.Replace(s) + `"`
+}
+
+// mermaidText escapes characters that end a mermaid label using entity codes.
+func mermaidText(s string) string {
+	return mermaidReplacer.Replace(s)
+}
+
+func mermaidID(key string) string {
+	return mermaidIDReplacer.ReplaceAllString(key, "_")
+}
@@ -0,0 +1,116 @@
+package view
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/internal/graph"
+)
+
+func getIssueGraph() *graph.Graph {
+	return &graph.Graph{
+		Root: "TEST-1",
+		Nodes: []*graph.Node{
+			{Key: "TEST-1", Summary: `Ship "v2"`, Status: "In Progress"},
+			{Key: "TEST-2", Summary: "Write docs", Status: "Done", Depth: 1},
+			{Key: "TEST-3", Summary: "Review", Depth: 1},
+		},
+		Edges: []graph.Edge{
+			{From: "TEST-1", To: "TEST-2", Type: "Blocks", Outward: "blocks", Inward: "is blocked by"},
+			{From: "TEST-2", To: "TEST-1", Type: "Blocks", Outward: "blocks", Inward: "is blocked by"},
+			{From: "TEST-1", To: "TEST-3", Type: graph.TypeSubtask, Outward: "has subtask", Inward: "is subtask of"},
+		},
+	}
+}
+
+func TestIssueGraphDot(t *testing.T) {
+	var b bytes.Buffer
+
+	g := getIssueGraph()
+	v := NewIssueGraph(g, WithIssueGraphFormat(IssueGraphFormatDot), WithIssueGraphWriter(&b))
+	assert.NoError(t, v.Render())
+
+	expected := `digraph issues {
+  rankdir=LR;
+  node [shape=box, style="rounded,filled", fontname="Helvetica"];
+  edge [fontname="Helvetica", fontsize=10];
+  "TEST-1" [label="TEST-1\nShip \"v2\"\nIn Progress", fillcolor="#deebff", penwidth=2];
+  "TEST-2" [label="TEST-2\nWrite docs\nDone", fillcolor="#e3fcef"];
+  "TEST-3" [label="TEST-3\nReview", fillcolor="#ffffff"];
+  "TEST-1" -> "TEST-2" [label="blocks", color="#de350b", fontcolor="#de350b", penwidth=2];
+  "TEST-2" -> "TEST-1" [label="blocks", color="#de350b", fontcolor="#de350b", penwidth=2];
+  "TEST-1" -> "TEST-3" [label="has subtask", style=dashed];
+}
+`
+	assert.Equal(t, expected, b.String())
+	assert.Equal(t, [][]string{{"TEST-1", "TEST-2"}}, v.Cycles())
+}
+
+func TestIssueGraphMermaid(t *testing.T) {
+	var b bytes.Buffer
+
+	v := NewIssueGraph(getIssueGraph(), WithIssueGraphFormat(IssueGraphFormatMermaid), WithIssueGraphWriter(&b))
+	assert.NoError(t, v.Render())
+
+	expected := `graph LR
+  TEST_1["TEST-1<br/>Ship #quot;v2#quot;<br/>In Progress"]
+  TEST_2["TEST-2<br/>Write docs<br/>Done"]
+  TEST_3["TEST-3<br/>Review"]
+  TEST_1 -->|blocks| TEST_2
+  TEST_2 -->|blocks| TEST_1
+  TEST_1 -.->|has subtask| TEST_3
+  classDef todo fill:#dfe1e6,stroke:#42526e
+  classDef doing fill:#deebff,stroke:#42526e
+  classDef review fill:#eae6ff,stroke:#42526e
+  classDef done fill:#e3fcef,stroke:#42526e
+  classDef blocked fill:#ffebe6,stroke:#42526e
+  class TEST_1 doing
+  class TEST_2 done
+  linkStyle 0 stroke:#de350b,stroke-width:2px
+  linkStyle 1 stroke:#de350b,stroke-width:2px
+`
+	assert.Equal(t, expected, b.String())
+}
+
+func TestIssueGraphEscaping(t *testing.T) {
+	g := &graph.Graph{
+		Root: "TEST-1",
+		Nodes: []*graph.Node{
+			{Key: "TEST-1", Summary: `C:\tmp "a|b" <#1>`},
+			{Key: "TEST-2"},
+		},
+		Edges: []graph.Edge{
+			{From: "TEST-1", To: "TEST-2", Type: "Custom", Outward: `is "x|y" of \`},
+		},
+	}
+
+	var dot bytes.Buffer
+	assert.NoError(t, NewIssueGraph(g, WithIssueGraphFormat(IssueGraphFormatDot), WithIssueGraphWriter(&dot)).Render())
+	assert.Contains(t, dot.String(), `"TEST-1" [label="TEST-1\nC:\\tmp \"a|b\" <#1>", `)
+	assert.Contains(t, dot.String(), `"TEST-1" -> "TEST-2" [label="is \"x|y\" of \\"`)
+
+	var mermaid bytes.Buffer
+	assert.NoError(t, NewIssueGraph(g, WithIssueGraphFormat(IssueGraphFormatMermaid), WithIssueGraphWriter(&mermaid)).Render())
+	assert.Contains(t, mermaid.String(), `TEST_1["TEST-1<br/>C:#92;tmp #quot;a#124;b#quot; #lt;#35;1#gt;"]`)
+	assert.Contains(t, mermaid.String(), `TEST_1 -->|is #quot;x#124;y#quot; of #92;| TEST_2`)
+}
+
+func TestIssueGraphASCII(t *testing.T) {
+	var b bytes.Buffer
+
+	v := NewIssueGraph(getIssueGraph(), WithIssueGraphWriter(&b))
+	assert.NoError(t, v.Render())
+
+	out := b.String()
+	assert.Contains(t, out, "TEST-1 Ship \"v2\" [In Progress]\n├── blocks TEST-2 Write docs [Done]\n├── is blocked by TEST-2 Write docs [Done] ")
+	assert.Contains(t, out, "(repeated)")
+	assert.Contains(t, out, "└── has subtask TEST-3 Review\n")
+	assert.Contains(t, out, "Blocking cycles:\n  TEST-1 → TEST-2 → TEST-1\n")
+}
+
+func TestIssueGraphInvalidFormat(t *testing.T) {
+	v := NewIssueGraph(getIssueGraph(), WithIssueGraphFormat("svg"))
+	assert.EqualError(t, v.Render(), `invalid format "svg", valid formats are: ascii, dot, mermaid`)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/fatih/color"
 
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/watch"
 	"github.com/ankitpokhrel/jira-cli/pkg/adf"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
 	"github.com/ankitpokhrel/jira-cli/pkg/md"
@@ -51,6 +52,7 @@ type Issue struct {
 	Data    *jira.Issue
 	Display DisplayFormat
 	Options IssueOption
+	SLAs    []*jira.SLA
 }
 
 // Render renders the view.
@@ -69,6 +71,27 @@ func (i Issue) Render() error {
 	return tui.PagerOut(out)
 }
 
+// RenderWatch renders the issue in watch mode. The screen is cleared and the
+// issue is printed below a status line and the fields that changed.
+func (i Issue) RenderWatch(w io.Writer, status string, changes []watch.FieldChange) error {
+	_, _ = fmt.Fprint(w, "\033[H\033[2J")
+	_, _ = fmt.Fprintf(w, " %s\n", coloredOut(status, color.FgCyan))
+	for _, c := range changes {
+		_, _ = fmt.Fprintf(w, " %s\n", coloredOut("● "+c.String(), color.FgYellow, color.Bold))
+	}
+
+	r, err := MDRenderer()
+	if err != nil {
+		return err
+	}
+	out, err := i.RenderedOut(r)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprint(w, out)
+	return err
+}
+
 // RenderedOut translates raw data to the format we want to display in.
 func (i Issue) RenderedOut(renderer *glamour.TermRenderer) (string, error) {
 	var res strings.Builder
@@ -109,6 +132,9 @@ func (i Issue) String() string {
 	if len(i.Data.Fields.IssueLinks) > 0 {
 		s.WriteString(fmt.Sprintf("\n\n%s\n\n%s\n", i.separator("Linked Issues"), i.linkedIssues()))
 	}
+	if len(i.SLAs) > 0 {
+		s.WriteString(fmt.Sprintf("\n\n%s\n\n%s\n", i.separator("SLA"), i.slas()))
+	}
 	total := i.Data.Fields.Comment.Total
 	if total > 0 && i.Options.NumComments > 0 {
 		sep := fmt.Sprintf("%d Comments", total)
@@ -160,6 +186,17 @@ func (i Issue) fragments() []fragment {
 		)
 	}
 
+	if len(i.SLAs) > 0 {
+		scraps = append(
+			scraps,
+			newBlankFragment(1),
+			fragment{Body: i.separator("SLA")},
+			newBlankFragment(2),
+			fragment{Body: i.slas()},
+			newBlankFragment(1),
+		)
+	}
+
 	if i.Data.Fields.Comment.Total > 0 && i.Options.NumComments > 0 {
 		scraps = append(
 			scraps,
@@ -378,6 +415,49 @@ func (i Issue) linkedIssues() string {
 	return linked.String()
 }
 
+func (i Issue) slas() string {
+	var (
+		out        strings.Builder
+		maxNameLen int
+	)
+
+	for _, sla := range i.SLAs {
+		maxNameLen = max(len(sla.Name), maxNameLen)
+	}
+
+	for _, sla := range i.SLAs {
+		var state, remaining string
+
+		switch {
+		case sla.OngoingCycle != nil:
+			remaining = sla.OngoingCycle.RemainingTime.Friendly
+			state = "running"
+			if sla.OngoingCycle.Paused {
+				state = "paused"
+			}
+			if sla.OngoingCycle.Breached {
+				state = "breached"
+			}
+		case len(sla.CompletedCycles) > 0:
+			last := sla.CompletedCycles[len(sla.CompletedCycles)-1]
+			remaining = last.RemainingTime.Friendly
+			state = "met"
+			if last.Breached {
+				state = "breached"
+			}
+		default:
+			continue
+		}
+
+		if state == "breached" && !i.Display.Plain {
+			state = coloredOut(state, color.FgRed, color.Bold)
+		}
+		out.WriteString(fmt.Sprintf("  %s • %s • %s\n", pad(sla.Name, maxNameLen), state, remaining))
+	}
+
+	return out.String()
+}
+
 func (i Issue) comments() []issueComment {
 	total := i.Data.Fields.Comment.Total
 	comments := make([]issueComment, 0, total)
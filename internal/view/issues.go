@@ -1,13 +1,17 @@
 package view
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"os"
 	"strings"
 	"text/tabwriter"
+	"time"
 
 	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/watch"
+	"github.com/ankitpokhrel/jira-cli/internal/workflow"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira/filter/issue"
 	"github.com/ankitpokhrel/jira-cli/pkg/tui"
@@ -35,6 +39,13 @@ type IssueList struct {
 	Display    DisplayFormat
 	Refresh    tui.RefreshFunc
 	FooterText string
+
+	// Watch is called every WatchInterval in watch mode. It returns
+	// the latest issues and the changes since the last call.
+	Watch         func() ([]*jira.Issue, []watch.Change, error)
+	WatchInterval time.Duration
+	// WatchContext stops the watch mode when it is done.
+	WatchContext context.Context
 }
 
 // Render renders the view.
@@ -52,7 +63,13 @@ func (l *IssueList) Render() error {
 			delimeter = l.Display.Delimiter
 		}
 		w := tabwriter.NewWriter(os.Stdout, 0, tabWidth, 1, '\t', 0)
-		return l.renderPlain(w, delimeter)
+		if err := l.renderPlain(w, delimeter); err != nil {
+			return err
+		}
+		if l.Watch != nil {
+			return l.watchPlain(os.Stdout)
+		}
+		return nil
 	}
 
 	renderer, err := MDRenderer()
@@ -61,8 +78,44 @@ func (l *IssueList) Render() error {
 	}
 
 	data := l.data()
-	if l.FooterText == "" {
-		l.FooterText = fmt.Sprintf("Showing %d results for project %q", len(data)-1, l.Project)
+
+	footer := func(n int) string {
+		return fmt.Sprintf("Showing %d results for project %q", n, l.Project)
+	}
+	if l.FooterText != "" {
+		ft := l.FooterText
+		footer = func(int) string { return ft }
+	}
+	l.FooterText = footer(len(l.Data))
+
+	var watchOpt tui.TableOption = func(*tui.Table) {}
+	if l.Watch != nil {
+		l.FooterText += fmt.Sprintf("  •  Watching every %s", l.WatchInterval)
+
+		// The watch func runs in the background, the list and the table data
+		// shared with other callbacks are only updated on the UI goroutine.
+		watchOpt = tui.WithWatchFunc(l.WatchInterval, func() (*tui.WatchResult, error) {
+			issues, changes, err := l.Watch()
+			if err != nil {
+				return nil, err
+			}
+
+			res := tui.WatchResult{
+				Footer: fmt.Sprintf(
+					"%s  •  Watching every %s, checked at %s: %s",
+					footer(len(issues)), l.WatchInterval, time.Now().Format(time.Kitchen), watch.Summary(changes),
+				),
+			}
+			if len(changes) > 0 {
+				next := l.tableData(issues)
+
+				res.Data, res.Changed = next, changedCells(next, changes)
+				res.Apply = func() {
+					l.Data, data = issues, next
+				}
+			}
+			return &res, nil
+		})
 	}
 
 	view := tui.NewTable(
@@ -94,19 +147,29 @@ func (l *IssueList) Render() error {
 				client := api.DefaultClient(false)
 				transitions, _ := api.ProxyTransitions(client, key)
 
+				// Order actions the way the issue flows through the configured workflow.
+				wf, err := workflow.FromConfig()
+				if err != nil {
+					wf = workflow.Default()
+				}
+				transitions = wf.Sort(transitions)
+
 				var actions []string
 				for _, t := range transitions {
 					actions = append(actions, t.Name)
 				}
 
-				actionHandler := func(state string) error {
-					var tr *jira.Transition
+				findTransition := func(name string) *jira.Transition {
 					for _, t := range transitions {
-						if strings.EqualFold(t.Name, state) {
-							tr = t
-							break
+						if strings.EqualFold(t.Name, name) {
+							return t
 						}
 					}
+					return nil
+				}
+
+				actionHandler := func(state string) error {
+					tr := findTransition(state)
 					if tr == nil {
 						return fmt.Errorf("transition '%s' not found", state)
 					}
@@ -123,6 +186,9 @@ func (l *IssueList) Render() error {
 				currentStatus := data.Get(r, statusFieldIdx)
 
 				return key, actions, actionHandler, currentStatus, func(r, c int, val string) {
+					if tr := findTransition(val); tr != nil && tr.To != nil {
+						val = tr.To.Name
+					}
 					data.Update(r, statusFieldIdx, val)
 				}
 			}
@@ -130,6 +196,7 @@ func (l *IssueList) Render() error {
 		}),
 		tui.WithRefreshFunc(l.Refresh),
 		tui.WithFixedColumns(l.Display.FixedColumns),
+		watchOpt,
 	)
 
 	return view.Paint(data)
@@ -140,6 +207,60 @@ func (l *IssueList) renderPlain(w io.Writer, delimeter string) error {
 	return renderPlain(w, l.data(), delimeter)
 }
 
+// watchPlain polls for changes and prints them as they happen
+// until the watch context is done.
+func (l *IssueList) watchPlain(w io.Writer) error {
+	ctx := l.WatchContext
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	ticker := time.NewTicker(l.WatchInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		_, changes, err := l.Watch()
+		if err != nil {
+			return err
+		}
+		for _, c := range changes {
+			_, _ = fmt.Fprintf(w, "%s %s\n", time.Now().Format(time.TimeOnly), c)
+		}
+	}
+}
+
+// changedCells maps rows of changed issues to columns of changed fields.
+func changedCells(data tui.TableData, changes []watch.Change) map[int][]int {
+	rows := make(map[string]int, len(data))
+	if ki := data.GetIndex(fieldKey); ki != -1 {
+		for r := 1; r < len(data); r++ {
+			rows[data[r][ki]] = r
+		}
+	}
+
+	out := make(map[int][]int)
+	for _, c := range changes {
+		r, ok := rows[c.Issue.Key]
+		if !ok {
+			continue
+		}
+		cols := []int{}
+		for _, f := range c.Fields {
+			if i := data.GetIndex(f.Field); i != -1 {
+				cols = append(cols, i)
+			}
+		}
+		out[r] = cols
+	}
+	return out
+}
+
 // renderCSV renders issues in csv format.
 func (l *IssueList) renderCSV(w io.Writer) error {
 	return renderCSV(w, l.data())
@@ -191,13 +312,17 @@ func (l *IssueList) header() []string {
 }
 
 func (l *IssueList) data() tui.TableData {
+	return l.tableData(l.Data)
+}
+
+func (l *IssueList) tableData(issues []*jira.Issue) tui.TableData {
 	var data tui.TableData
 
 	headers := l.header()
 	if (!l.Display.Plain && !l.Display.CSV) || !l.Display.NoHeaders {
 		data = append(data, headers)
 	}
-	for _, iss := range l.Data {
+	for _, iss := range issues {
 		data = append(data, l.assignColumns(headers, iss))
 	}
 
@@ -2,10 +2,13 @@ package view
 
 import (
 	"bytes"
+	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/ankitpokhrel/jira-cli/internal/watch"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
 	"github.com/ankitpokhrel/jira-cli/pkg/tui"
 )
@@ -210,9 +213,7 @@ func getIssues() []*jira.Issue {
 				Reporter: struct {
 					Name string `json:"displayName"`
 				}{Name: "Person Z"},
-				Status: struct {
-					Name string `json:"name"`
-				}{Name: "Done"},
+				Status:  jira.IssueStatus{Name: "Done"},
 				Created: "2020-12-13T14:05:20.974+0100",
 				Updated: "2020-12-13T14:07:20.974+0100",
 				Labels:  []string{"krakatit"},
@@ -229,9 +230,7 @@ func getIssues() []*jira.Issue {
 				Reporter: struct {
 					Name string `json:"displayName"`
 				}{Name: "Person A"},
-				Status: struct {
-					Name string `json:"name"`
-				}{Name: "Open"},
+				Status:  jira.IssueStatus{Name: "Open"},
 				Created: "2020-12-13T14:05:20.974+0100",
 				Updated: "2020-12-13T14:07:20.974+0100",
 				Labels:  []string{"pat", "mat"},
@@ -239,3 +238,40 @@ func getIssues() []*jira.Issue {
 		},
 	}
 }
+
+func TestIssueChangedCells(t *testing.T) {
+	issue := IssueList{Project: "TEST", Data: getIssues()}
+	data := issue.data()
+
+	changes := []watch.Change{
+		{Kind: watch.Updated, Issue: &jira.Issue{Key: "TEST-2"}, Fields: []watch.FieldChange{
+			{Field: "status", From: "To Do", To: "Open"},
+			{Field: "comments", From: "0", To: "1"},
+			{Field: "assignee", From: "Person A", To: ""},
+		}},
+		{Kind: watch.Added, Issue: &jira.Issue{Key: "TEST-1"}},
+		{Kind: watch.Removed, Issue: &jira.Issue{Key: "TEST-3"}},
+	}
+
+	assert.Equal(t, map[int][]int{1: {}, 2: {3, 4}}, changedCells(data, changes))
+}
+
+func TestIssueWatchPlainStopsOnContextDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	issue := IssueList{
+		WatchInterval: time.Millisecond,
+		WatchContext:  ctx,
+		Watch: func() ([]*jira.Issue, []watch.Change, error) {
+			cancel()
+
+			iss := &jira.Issue{Key: "TEST-1", Fields: jira.IssueFields{Summary: "New"}}
+			return []*jira.Issue{iss}, []watch.Change{{Kind: watch.Added, Issue: iss}}, nil
+		},
+	}
+
+	var b bytes.Buffer
+	assert.NoError(t, issue.watchPlain(&b))
+	assert.Contains(t, b.String(), "TEST-1 added: New\n")
+}
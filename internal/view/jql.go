@@ -0,0 +1,136 @@
+package view
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/tui"
+)
+
+// htmlTags matches markup that Jira uses to highlight the matched part of suggestions.
+var htmlTags = regexp.MustCompile(`</?[a-zA-Z]+>`)
+
+// JQLOption is a functional option to wrap JQL view properties.
+type JQLOption func(*JQL)
+
+// JQL is a view of JQL fields, functions or field value suggestions.
+type JQL struct {
+	headers []string
+	rows    [][]string
+	data    any
+	writer  io.Writer
+	buf     *bytes.Buffer
+	plain   bool
+	json    bool
+}
+
+// NewJQLFields initializes a view of fields that can be used in JQL.
+func NewJQLFields(data []*jira.JQLField, opts ...JQLOption) *JQL {
+	rows := make([][]string, 0, len(data))
+	for _, f := range data {
+		rows = append(rows, []string{f.Value, f.DisplayName, strings.Join(f.Operators, ", ")})
+	}
+	return newJQL([]string{"FIELD", "NAME", "OPERATORS"}, rows, data, opts...)
+}
+
+// NewJQLFunctions initializes a view of functions that can be used in JQL.
+func NewJQLFunctions(data []*jira.JQLFunction, opts ...JQLOption) *JQL {
+	rows := make([][]string, 0, len(data))
+	for _, f := range data {
+		types := make([]string, 0, len(f.Types))
+		for _, t := range f.Types {
+			types = append(types, t[strings.LastIndex(t, ".")+1:])
+		}
+		list := "no"
+		if f.IsList == "true" {
+			list = "yes"
+		}
+		rows = append(rows, []string{f.Value, list, strings.Join(types, ", ")})
+	}
+	return newJQL([]string{"FUNCTION", "LIST", "TYPES"}, rows, data, opts...)
+}
+
+// NewJQLSuggestions initializes a view of suggested values of a JQL field.
+func NewJQLSuggestions(data []*jira.JQLSuggestion, opts ...JQLOption) *JQL {
+	rows := make([][]string, 0, len(data))
+	for _, s := range data {
+		rows = append(rows, []string{s.Value, SuggestionName(s)})
+	}
+	return newJQL([]string{"VALUE", "NAME"}, rows, data, opts...)
+}
+
+func newJQL(headers []string, rows [][]string, data any, opts ...JQLOption) *JQL {
+	j := JQL{
+		headers: headers,
+		rows:    rows,
+		data:    data,
+		buf:     new(bytes.Buffer),
+	}
+	j.writer = tabwriter.NewWriter(j.buf, 0, tabWidth, 1, '\t', 0)
+
+	for _, opt := range opts {
+		opt(&j)
+	}
+	return &j
+}
+
+// WithJQLWriter sets a writer for the JQL view.
+func WithJQLWriter(w io.Writer) JQLOption {
+	return func(j *JQL) {
+		j.writer = w
+	}
+}
+
+// WithJQLPlain sets the plain output mode of the JQL view.
+func WithJQLPlain(plain bool) JQLOption {
+	return func(j *JQL) {
+		j.plain = plain
+	}
+}
+
+// WithJQLJSON renders the JQL view as JSON.
+func WithJQLJSON(jsonOut bool) JQLOption {
+	return func(j *JQL) {
+		j.json = jsonOut
+	}
+}
+
+// SuggestionName returns the display name of a suggestion without highlight markup.
+func SuggestionName(s *jira.JQLSuggestion) string {
+	return html.UnescapeString(htmlTags.ReplaceAllString(s.DisplayName, ""))
+}
+
+// Render renders the JQL view.
+func (j JQL) Render() error {
+	if j.json {
+		out, err := json.MarshalIndent(j.data, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, _ = fmt.Fprintln(j.writer, string(out))
+	} else {
+		printTableHeader(j.writer, j.headers)
+		for _, row := range j.rows {
+			_, _ = fmt.Fprintln(j.writer, strings.Join(row, "\t"))
+		}
+	}
+	if tw, ok := j.writer.(*tabwriter.Writer); ok {
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+	}
+
+	if j.plain || j.json {
+		_, err := fmt.Fprint(os.Stdout, j.buf.String())
+		return err
+	}
+	return tui.PagerOut(j.buf.String())
+}
@@ -0,0 +1,62 @@
+package view
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestJQLFieldsRender(t *testing.T) {
+	data := []*jira.JQLField{
+		{Value: "status", DisplayName: "status", Operators: []string{"=", "!=", "in"}},
+		{Value: "cf[10010]", DisplayName: "Story Points - cf[10010]", Operators: []string{"=", ">"}},
+	}
+
+	var b bytes.Buffer
+	assert.NoError(t, NewJQLFields(data, WithJQLWriter(&b)).Render())
+
+	expected := `FIELD	NAME	OPERATORS
+status	status	=, !=, in
+cf[10010]	Story Points - cf[10010]	=, >
+`
+	assert.Equal(t, expected, b.String())
+}
+
+func TestJQLFunctionsRender(t *testing.T) {
+	data := []*jira.JQLFunction{
+		{Value: "currentUser()", Types: []string{"com.atlassian.jira.user.ApplicationUser"}},
+		{Value: "membersOf()", IsList: "true", Types: []string{"com.atlassian.jira.user.ApplicationUser"}},
+	}
+
+	var b bytes.Buffer
+	assert.NoError(t, NewJQLFunctions(data, WithJQLWriter(&b)).Render())
+
+	expected := `FUNCTION	LIST	TYPES
+currentUser()	no	ApplicationUser
+membersOf()	yes	ApplicationUser
+`
+	assert.Equal(t, expected, b.String())
+}
+
+func TestJQLSuggestionsRender(t *testing.T) {
+	data := []*jira.JQLSuggestion{
+		{Value: `"In Progress"`, DisplayName: "<b>In P</b>rogress"},
+		{Value: "557058:f58131cb", DisplayName: "<b>Jane</b> O&#39;Neil"},
+	}
+
+	var b bytes.Buffer
+	assert.NoError(t, NewJQLSuggestions(data, WithJQLWriter(&b), WithJQLJSON(false)).Render())
+
+	expected := `VALUE	NAME
+"In Progress"	In Progress
+557058:f58131cb	Jane O'Neil
+`
+	assert.Equal(t, expected, b.String())
+
+	b.Reset()
+	assert.NoError(t, NewJQLSuggestions(data[:1], WithJQLWriter(&b), WithJQLJSON(true)).Render())
+	assert.JSONEq(t, `[{"value": "\"In Progress\"", "displayName": "<b>In P</b>rogress"}]`, b.String())
+}
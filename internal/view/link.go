@@ -0,0 +1,162 @@
+package view
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/tui"
+)
+
+// LinkOption is a functional option to wrap link properties.
+type LinkOption func(*linkView)
+
+type linkView struct {
+	writer io.Writer
+	buf    *bytes.Buffer
+	plain  bool
+	json   bool
+}
+
+func newLinkView(opts []LinkOption) linkView {
+	l := linkView{buf: new(bytes.Buffer)}
+	l.writer = tabwriter.NewWriter(l.buf, 0, tabWidth, 1, '\t', 0)
+
+	for _, opt := range opts {
+		opt(&l)
+	}
+	return l
+}
+
+// WithLinkWriter sets a writer for the link view.
+func WithLinkWriter(w io.Writer) LinkOption {
+	return func(l *linkView) {
+		l.writer = w
+	}
+}
+
+// WithLinkPlain prints the output directly to stdout instead of the pager.
+func WithLinkPlain(plain bool) LinkOption {
+	return func(l *linkView) {
+		l.plain = plain
+	}
+}
+
+// WithLinkJSON renders links as a JSON array.
+func WithLinkJSON(jsonOut bool) LinkOption {
+	return func(l *linkView) {
+		l.json = jsonOut
+	}
+}
+
+func (l linkView) render(data any, header []string, rows [][]string) error {
+	if l.json {
+		out, err := json.MarshalIndent(data, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, _ = fmt.Fprintln(l.writer, string(out))
+	} else {
+		for _, row := range append([][]string{header}, rows...) {
+			for i, col := range row {
+				if i > 0 {
+					_, _ = fmt.Fprint(l.writer, "\t")
+				}
+				_, _ = fmt.Fprint(l.writer, col)
+			}
+			_, _ = fmt.Fprintln(l.writer)
+		}
+	}
+	if tw, ok := l.writer.(*tabwriter.Writer); ok {
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+	}
+
+	if l.plain || l.json {
+		_, err := fmt.Fprint(os.Stdout, l.buf.String())
+		return err
+	}
+	return tui.PagerOut(l.buf.String())
+}
+
+// IssueLinks is a view for links of an issue.
+type IssueLinks struct {
+	linkView
+	data []*jira.IssueLink
+}
+
+type issueLinkJSON struct {
+	ID        string `json:"id"`
+	Type      string `json:"type"`
+	Direction string `json:"direction"`
+	Relation  string `json:"relation"`
+	Key       string `json:"key"`
+	Summary   string `json:"summary"`
+	Status    string `json:"status"`
+	IssueType string `json:"issueType"`
+}
+
+// NewIssueLinks initializes an issue links view.
+func NewIssueLinks(data []*jira.IssueLink, opts ...LinkOption) *IssueLinks {
+	return &IssueLinks{linkView: newLinkView(opts), data: data}
+}
+
+// Render renders the issue links view.
+func (l IssueLinks) Render() error {
+	items := make([]issueLinkJSON, 0, len(l.data))
+	rows := make([][]string, 0, len(l.data))
+
+	for _, d := range l.data {
+		iss, relation := d.Linked()
+		if iss == nil {
+			continue
+		}
+		direction := "outward"
+		if d.InwardIssue != nil {
+			direction = "inward"
+		}
+		item := issueLinkJSON{
+			ID:        d.ID,
+			Type:      d.Type.Name,
+			Direction: direction,
+			Relation:  relation,
+			Key:       iss.Key,
+			Summary:   iss.Fields.Summary,
+			Status:    iss.Fields.Status.Name,
+			IssueType: iss.Fields.IssueType.Name,
+		}
+		items = append(items, item)
+		rows = append(rows, []string{
+			item.ID, item.Type, item.Relation, item.Key, prepareTitle(item.Summary), item.Status,
+		})
+	}
+
+	return l.render(items, []string{"ID", "TYPE", "RELATION", "KEY", "SUMMARY", "STATUS"}, rows)
+}
+
+// RemoteLinks is a view for remote links of an issue.
+type RemoteLinks struct {
+	linkView
+	data []*jira.RemoteLink
+}
+
+// NewRemoteLinks initializes a remote links view.
+func NewRemoteLinks(data []*jira.RemoteLink, opts ...LinkOption) *RemoteLinks {
+	return &RemoteLinks{linkView: newLinkView(opts), data: data}
+}
+
+// Render renders the remote links view.
+func (l RemoteLinks) Render() error {
+	rows := make([][]string, 0, len(l.data))
+	for _, d := range l.data {
+		rows = append(rows, []string{
+			fmt.Sprintf("%d", d.ID), prepareTitle(d.Object.Title), d.Object.URL, d.Relationship, d.GlobalID,
+		})
+	}
+	return l.render(l.data, []string{"ID", "TITLE", "URL", "RELATIONSHIP", "GLOBAL ID"}, rows)
+}
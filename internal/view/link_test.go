@@ -0,0 +1,86 @@
+package view
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func getIssueLinks() []*jira.IssueLink {
+	blocks := jira.IssueLinkType{Name: "Blocks", Inward: "is blocked by", Outward: "blocks"}
+
+	second := jira.Issue{Key: "TEST-2"}
+	second.Fields.Summary = "Second"
+	second.Fields.Status.Name = "To Do"
+	second.Fields.IssueType.Name = "Story"
+
+	third := jira.Issue{Key: "TEST-3"}
+	third.Fields.Summary = "Third"
+	third.Fields.Status.Name = "Done"
+	third.Fields.IssueType.Name = "Bug"
+
+	return []*jira.IssueLink{
+		{ID: "10001", Type: blocks, OutwardIssue: &second},
+		{ID: "10002", Type: blocks, InwardIssue: &third},
+	}
+}
+
+func TestIssueLinksRender(t *testing.T) {
+	var b bytes.Buffer
+
+	v := NewIssueLinks(getIssueLinks(), WithLinkWriter(&b))
+	assert.NoError(t, v.Render())
+
+	expected := `ID	TYPE	RELATION	KEY	SUMMARY	STATUS
+10001	Blocks	blocks	TEST-2	Second	To Do
+10002	Blocks	is blocked by	TEST-3	Third	Done
+`
+	assert.Equal(t, expected, b.String())
+}
+
+func TestIssueLinksRenderJSON(t *testing.T) {
+	var b bytes.Buffer
+
+	v := NewIssueLinks(getIssueLinks()[1:], WithLinkWriter(&b), WithLinkJSON(true))
+	assert.NoError(t, v.Render())
+
+	expected := `[
+  {
+    "id": "10002",
+    "type": "Blocks",
+    "direction": "inward",
+    "relation": "is blocked by",
+    "key": "TEST-3",
+    "summary": "Third",
+    "status": "Done",
+    "issueType": "Bug"
+  }
+]
+`
+	assert.Equal(t, expected, b.String())
+}
+
+func TestRemoteLinksRender(t *testing.T) {
+	var b bytes.Buffer
+
+	data := []*jira.RemoteLink{
+		{
+			ID:           10000,
+			GlobalID:     "build-42",
+			Relationship: "built by",
+			Object:       jira.RemoteLinkObject{URL: "https://ci.example.com/42", Title: "Build #42"},
+		},
+		{ID: 10001, Object: jira.RemoteLinkObject{URL: "https://example.com", Title: "Example"}},
+	}
+	v := NewRemoteLinks(data, WithLinkWriter(&b))
+	assert.NoError(t, v.Render())
+
+	expected := `ID	TITLE	URL	RELATIONSHIP	GLOBAL ID
+10000	Build #42	https://ci.example.com/42	built by	build-42
+10001	Example	https://example.com		
+`
+	assert.Equal(t, expected, b.String())
+}
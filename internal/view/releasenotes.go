@@ -0,0 +1,324 @@
+package view
+
+import (
+	"encoding/json"
+	"fmt"
+	htmlTemplate "html/template"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// Supported release notes formats.
+const (
+	ReleaseNotesFormatMarkdown   = "md"
+	ReleaseNotesFormatHTML       = "html"
+	ReleaseNotesFormatConfluence = "confluence-wiki"
+	ReleaseNotesFormatJSON       = "json"
+)
+
+// Supported release notes groupings.
+const (
+	ReleaseNotesGroupByType      = "type"
+	ReleaseNotesGroupByComponent = "component"
+	ReleaseNotesGroupByLabel     = "label"
+)
+
+const (
+	releaseNotesTmplMarkdown = `# {{ .Version }}
+{{- with .Description }}
+
+{{ . }}
+{{- end }}
+{{ range .Groups }}
+## {{ .Name }}
+
+{{ range .Issues -}}
+- [{{ .Key }}]({{ .URL }}) {{ .Summary }}
+{{ end -}}
+{{ end -}}
+`
+	releaseNotesTmplHTML = `<h1>{{ .Version }}</h1>
+{{- with .Description }}
+<p>{{ . }}</p>
+{{- end }}
+{{- range .Groups }}
+<h2>{{ .Name }}</h2>
+<ul>
+{{- range .Issues }}
+  <li><a href="{{ .URL }}">{{ .Key }}</a> {{ .Summary }}</li>
+{{- end }}
+</ul>
+{{- end }}
+`
+	releaseNotesTmplConfluence = `h1. {{ .Version }}
+{{- with .Description }}
+
+{{ wiki . }}
+{{- end }}
+{{ range .Groups }}
+h2. {{ wiki .Name }}
+
+{{ range .Issues -}}
+* [{{ .Key }}|{{ .URL }}] {{ wiki .Summary }}
+{{ end -}}
+{{ end -}}
+`
+)
+
+// ReleaseNotesIssue is an issue entry in the release notes.
+type ReleaseNotesIssue struct {
+	Key        string   `json:"key"`
+	Summary    string   `json:"summary"`
+	Type       string   `json:"type"`
+	Status     string   `json:"status"`
+	Components []string `json:"components"`
+	Labels     []string `json:"labels"`
+	URL        string   `json:"url"`
+}
+
+// ReleaseNotesGroup is a group of issues in the release notes.
+type ReleaseNotesGroup struct {
+	Name   string              `json:"name"`
+	Issues []ReleaseNotesIssue `json:"issues"`
+}
+
+// ReleaseNotesData is the data passed to the release notes template.
+type ReleaseNotesData struct {
+	Version     string              `json:"version"`
+	Description string              `json:"description,omitempty"`
+	Total       int                 `json:"total"`
+	Groups      []ReleaseNotesGroup `json:"groups"`
+}
+
+// ReleaseNotesOption is a functional option to wrap release notes properties.
+type ReleaseNotesOption func(*ReleaseNotes)
+
+// ReleaseNotes is a release notes view.
+type ReleaseNotes struct {
+	server   string
+	version  *jira.ProjectVersion
+	issues   []*jira.Issue
+	format   string
+	groupBy  string
+	template string
+	writer   io.Writer
+}
+
+// NewReleaseNotes initializes a release notes view.
+func NewReleaseNotes(server string, version *jira.ProjectVersion, issues []*jira.Issue, opts ...ReleaseNotesOption) *ReleaseNotes {
+	rn := ReleaseNotes{
+		server:  server,
+		version: version,
+		issues:  issues,
+		format:  ReleaseNotesFormatMarkdown,
+		groupBy: ReleaseNotesGroupByType,
+		writer:  os.Stdout,
+	}
+
+	for _, opt := range opts {
+		opt(&rn)
+	}
+	return &rn
+}
+
+// WithReleaseNotesWriter sets a writer for the release notes.
+func WithReleaseNotesWriter(w io.Writer) ReleaseNotesOption {
+	return func(rn *ReleaseNotes) {
+		rn.writer = w
+	}
+}
+
+// WithReleaseNotesFormat sets output format of the release notes.
+func WithReleaseNotesFormat(format string) ReleaseNotesOption {
+	return func(rn *ReleaseNotes) {
+		if format != "" {
+			rn.format = format
+		}
+	}
+}
+
+// WithReleaseNotesGroupBy sets the field to group issues by.
+func WithReleaseNotesGroupBy(groupBy string) ReleaseNotesOption {
+	return func(rn *ReleaseNotes) {
+		if groupBy != "" {
+			rn.groupBy = groupBy
+		}
+	}
+}
+
+// WithReleaseNotesTemplate sets a custom go template to render the release notes.
+func WithReleaseNotesTemplate(tmpl string) ReleaseNotesOption {
+	return func(rn *ReleaseNotes) {
+		rn.template = tmpl
+	}
+}
+
+// Data builds template data for the release notes.
+func (rn ReleaseNotes) Data() (*ReleaseNotesData, error) {
+	var keysFn func(*jira.Issue) []string
+
+	switch rn.groupBy {
+	case ReleaseNotesGroupByType:
+		keysFn = func(iss *jira.Issue) []string {
+			return []string{iss.Fields.IssueType.Name}
+		}
+	case ReleaseNotesGroupByComponent:
+		keysFn = func(iss *jira.Issue) []string {
+			comps := make([]string, 0, len(iss.Fields.Components))
+			for _, c := range iss.Fields.Components {
+				comps = append(comps, c.Name)
+			}
+			return comps
+		}
+	case ReleaseNotesGroupByLabel:
+		keysFn = func(iss *jira.Issue) []string {
+			return iss.Fields.Labels
+		}
+	default:
+		return nil, fmt.Errorf(
+			"invalid group %q, valid groups are: %s, %s, %s",
+			rn.groupBy, ReleaseNotesGroupByType, ReleaseNotesGroupByComponent, ReleaseNotesGroupByLabel,
+		)
+	}
+
+	var (
+		names   []string
+		grouped = make(map[string][]ReleaseNotesIssue)
+		other   = fmt.Sprintf("No %s", rn.groupBy)
+	)
+
+	for _, iss := range rn.issues {
+		entry := rn.entry(iss)
+
+		keys := keysFn(iss)
+		if len(keys) == 0 || (len(keys) == 1 && keys[0] == "") {
+			keys = []string{other}
+		}
+		for _, k := range keys {
+			if _, ok := grouped[k]; !ok {
+				names = append(names, k)
+			}
+			grouped[k] = append(grouped[k], entry)
+		}
+	}
+
+	// Issues without a group are always listed last.
+	sort.SliceStable(names, func(i, j int) bool {
+		if names[i] == other || names[j] == other {
+			return names[j] == other && names[i] != other
+		}
+		return names[i] < names[j]
+	})
+
+	data := ReleaseNotesData{
+		Version: rn.version.Name,
+		Total:   len(rn.issues),
+		Groups:  make([]ReleaseNotesGroup, 0, len(names)),
+	}
+	if rn.version.Description != nil {
+		data.Description = fmt.Sprint(rn.version.Description)
+	}
+	for _, n := range names {
+		data.Groups = append(data.Groups, ReleaseNotesGroup{Name: n, Issues: grouped[n]})
+	}
+
+	return &data, nil
+}
+
+// Render renders the release notes.
+func (rn ReleaseNotes) Render() error {
+	data, err := rn.Data()
+	if err != nil {
+		return err
+	}
+
+	if rn.format == ReleaseNotesFormatJSON && rn.template == "" {
+		out, err := json.MarshalIndent(data, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(rn.writer, string(out))
+		return err
+	}
+
+	tmpl, err := rn.parse()
+	if err != nil {
+		return err
+	}
+	return tmpl.Execute(rn.writer, data)
+}
+
+type templateExecutor interface {
+	Execute(io.Writer, any) error
+}
+
+func (rn ReleaseNotes) parse() (templateExecutor, error) {
+	body := rn.template
+
+	if body == "" {
+		switch rn.format {
+		case ReleaseNotesFormatMarkdown:
+			body = releaseNotesTmplMarkdown
+		case ReleaseNotesFormatHTML:
+			body = releaseNotesTmplHTML
+		case ReleaseNotesFormatConfluence:
+			body = releaseNotesTmplConfluence
+		default:
+			return nil, fmt.Errorf(
+				"invalid format %q, valid formats are: %s, %s, %s, %s",
+				rn.format,
+				ReleaseNotesFormatMarkdown, ReleaseNotesFormatHTML,
+				ReleaseNotesFormatConfluence, ReleaseNotesFormatJSON,
+			)
+		}
+	}
+
+	funcs := map[string]any{
+		"join":  strings.Join,
+		"upper": strings.ToUpper,
+		"lower": strings.ToLower,
+		"wiki":  escapeWiki,
+	}
+
+	// HTML output is escaped contextually to keep summaries from breaking the markup.
+	if rn.format == ReleaseNotesFormatHTML {
+		return htmlTemplate.New("release-notes").Funcs(funcs).Parse(body)
+	}
+	return template.New("release-notes").Funcs(funcs).Parse(body)
+}
+
+func (rn ReleaseNotes) entry(iss *jira.Issue) ReleaseNotesIssue {
+	comps := make([]string, 0, len(iss.Fields.Components))
+	for _, c := range iss.Fields.Components {
+		comps = append(comps, c.Name)
+	}
+	labels := iss.Fields.Labels
+	if labels == nil {
+		labels = []string{}
+	}
+
+	return ReleaseNotesIssue{
+		Key:        iss.Key,
+		Summary:    iss.Fields.Summary,
+		Type:       iss.Fields.IssueType.Name,
+		Status:     iss.Fields.Status.Name,
+		Components: comps,
+		Labels:     labels,
+		URL:        cmdutil.GenerateServerBrowseURL(rn.server, iss.Key),
+	}
+}
+
+func escapeWiki(s string) string {
+	r := strings.NewReplacer(
+		"[", `\[`, "]", `\]`,
+		"{", `\{`, "}", `\}`,
+		"|", `\|`, "*", `\*`,
+	)
+	return r.Replace(s)
+}
@@ -0,0 +1,132 @@
+package view
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func getReleaseNotesIssues() []*jira.Issue {
+	type component = struct {
+		Name string `json:"name"`
+	}
+
+	bug := &jira.Issue{Key: "TEST-1"}
+	bug.Fields.Summary = "Fix [crash] on login"
+	bug.Fields.IssueType.Name = "Bug"
+	bug.Fields.Status.Name = "Done"
+	bug.Fields.Labels = []string{"auth"}
+	bug.Fields.Components = []component{{Name: "Backend"}}
+
+	story := &jira.Issue{Key: "TEST-2"}
+	story.Fields.Summary = "Add <dark> mode"
+	story.Fields.IssueType.Name = "Story"
+	story.Fields.Status.Name = "Done"
+	story.Fields.Components = []component{{Name: "Frontend"}, {Name: "Backend"}}
+
+	task := &jira.Issue{Key: "TEST-3"}
+	task.Fields.Summary = "Bump dependencies"
+	task.Fields.IssueType.Name = "Bug"
+	task.Fields.Status.Name = "Done"
+
+	return []*jira.Issue{bug, story, task}
+}
+
+func TestReleaseNotesMarkdown(t *testing.T) {
+	var b bytes.Buffer
+
+	version := &jira.ProjectVersion{ID: "1000", Name: "v1.0.0", Description: "First release"}
+	rn := NewReleaseNotes("https://test.local", version, getReleaseNotesIssues(), WithReleaseNotesWriter(&b))
+	assert.NoError(t, rn.Render())
+
+	expected := `# v1.0.0
+
+First release
+
+## Bug
+
+- [TEST-1](https://test.local/browse/TEST-1) Fix [crash] on login
+- [TEST-3](https://test.local/browse/TEST-3) Bump dependencies
+
+## Story
+
+- [TEST-2](https://test.local/browse/TEST-2) Add <dark> mode
+`
+	assert.Equal(t, expected, b.String())
+}
+
+func TestReleaseNotesGroupByComponent(t *testing.T) {
+	version := &jira.ProjectVersion{ID: "1000", Name: "v1.0.0"}
+	rn := NewReleaseNotes(
+		"https://test.local", version, getReleaseNotesIssues(),
+		WithReleaseNotesGroupBy(ReleaseNotesGroupByComponent),
+	)
+
+	data, err := rn.Data()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, data.Total)
+	assert.Len(t, data.Groups, 3)
+
+	assert.Equal(t, "Backend", data.Groups[0].Name)
+	assert.Len(t, data.Groups[0].Issues, 2)
+	assert.Equal(t, "Frontend", data.Groups[1].Name)
+	assert.Equal(t, "No component", data.Groups[2].Name)
+	assert.Equal(t, "TEST-3", data.Groups[2].Issues[0].Key)
+
+	_, err = NewReleaseNotes("", version, nil, WithReleaseNotesGroupBy("assignee")).Data()
+	assert.Error(t, err)
+}
+
+func TestReleaseNotesHTMLAndConfluence(t *testing.T) {
+	var b bytes.Buffer
+
+	version := &jira.ProjectVersion{ID: "1000", Name: "v1.0.0"}
+	issues := getReleaseNotesIssues()[1:2]
+
+	rn := NewReleaseNotes(
+		"https://test.local", version, issues,
+		WithReleaseNotesWriter(&b), WithReleaseNotesFormat(ReleaseNotesFormatHTML),
+	)
+	assert.NoError(t, rn.Render())
+
+	expected := `<h1>v1.0.0</h1>
+<h2>Story</h2>
+<ul>
+  <li><a href="https://test.local/browse/TEST-2">TEST-2</a> Add &lt;dark&gt; mode</li>
+</ul>
+`
+	assert.Equal(t, expected, b.String())
+
+	b.Reset()
+
+	rn = NewReleaseNotes(
+		"https://test.local", version, getReleaseNotesIssues()[:1],
+		WithReleaseNotesWriter(&b), WithReleaseNotesFormat(ReleaseNotesFormatConfluence),
+	)
+	assert.NoError(t, rn.Render())
+
+	expected = `h1. v1.0.0
+
+h2. Bug
+
+* [TEST-1|https://test.local/browse/TEST-1] Fix \[crash\] on login
+`
+	assert.Equal(t, expected, b.String())
+}
+
+func TestReleaseNotesCustomTemplate(t *testing.T) {
+	var b bytes.Buffer
+
+	version := &jira.ProjectVersion{ID: "1000", Name: "v1.0.0"}
+	tmpl := `{{ .Version }} ({{ .Total }}){{ range .Groups }} {{ upper .Name }}:{{ range .Issues }} {{ .Key }}{{ end }}{{ end }}`
+
+	rn := NewReleaseNotes(
+		"https://test.local", version, getReleaseNotesIssues(),
+		WithReleaseNotesWriter(&b), WithReleaseNotesTemplate(tmpl),
+	)
+	assert.NoError(t, rn.Render())
+	assert.Equal(t, "v1.0.0 (3) BUG: TEST-1 TEST-3 STORY: TEST-2", b.String())
+}
@@ -0,0 +1,203 @@
+package view
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/tui"
+)
+
+// ServiceDeskOption is a functional option to wrap service desk properties.
+type ServiceDeskOption func(*ServiceDesk)
+
+// ServiceDesk is a service desk view.
+type ServiceDesk struct {
+	data   []*jira.ServiceDesk
+	writer io.Writer
+	buf    *bytes.Buffer
+}
+
+// NewServiceDesk initializes a service desk view.
+func NewServiceDesk(data []*jira.ServiceDesk, opts ...ServiceDeskOption) *ServiceDesk {
+	sd := ServiceDesk{
+		data: data,
+		buf:  new(bytes.Buffer),
+	}
+	sd.writer = tabwriter.NewWriter(sd.buf, 0, tabWidth, 1, '\t', 0)
+
+	for _, opt := range opts {
+		opt(&sd)
+	}
+	return &sd
+}
+
+// WithServiceDeskWriter sets a writer for the service desk view.
+func WithServiceDeskWriter(w io.Writer) ServiceDeskOption {
+	return func(sd *ServiceDesk) {
+		sd.writer = w
+	}
+}
+
+// Render renders the service desk view.
+func (sd ServiceDesk) Render() error {
+	printTableHeader(sd.writer, []string{"ID", "KEY", "NAME"})
+
+	for _, d := range sd.data {
+		_, _ = fmt.Fprintf(sd.writer, "%s\t%s\t%s\n", d.ID, d.ProjectKey, prepareTitle(d.ProjectName))
+	}
+	return flushAndPage(sd.writer, sd.buf)
+}
+
+// QueueOption is a functional option to wrap queue properties.
+type QueueOption func(*Queue)
+
+// Queue is a service desk queue view.
+type Queue struct {
+	data   []*jira.Queue
+	writer io.Writer
+	buf    *bytes.Buffer
+}
+
+// NewQueue initializes a queue view.
+func NewQueue(data []*jira.Queue, opts ...QueueOption) *Queue {
+	q := Queue{
+		data: data,
+		buf:  new(bytes.Buffer),
+	}
+	q.writer = tabwriter.NewWriter(q.buf, 0, tabWidth, 1, '\t', 0)
+
+	for _, opt := range opts {
+		opt(&q)
+	}
+	return &q
+}
+
+// WithQueueWriter sets a writer for the queue view.
+func WithQueueWriter(w io.Writer) QueueOption {
+	return func(q *Queue) {
+		q.writer = w
+	}
+}
+
+// Render renders the queue view.
+func (q Queue) Render() error {
+	printTableHeader(q.writer, []string{"ID", "NAME", "ISSUES", "JQL"})
+
+	for _, d := range q.data {
+		_, _ = fmt.Fprintf(q.writer, "%s\t%s\t%d\t%s\n", d.ID, prepareTitle(d.Name), d.IssueCount, prepareTitle(d.JQL))
+	}
+	return flushAndPage(q.writer, q.buf)
+}
+
+// RequestTypeOption is a functional option to wrap request type properties.
+type RequestTypeOption func(*RequestType)
+
+// RequestType is a customer request type view.
+type RequestType struct {
+	data   []*jira.RequestType
+	writer io.Writer
+	buf    *bytes.Buffer
+}
+
+// NewRequestType initializes a request type view.
+func NewRequestType(data []*jira.RequestType, opts ...RequestTypeOption) *RequestType {
+	rt := RequestType{
+		data: data,
+		buf:  new(bytes.Buffer),
+	}
+	rt.writer = tabwriter.NewWriter(rt.buf, 0, tabWidth, 1, '\t', 0)
+
+	for _, opt := range opts {
+		opt(&rt)
+	}
+	return &rt
+}
+
+// WithRequestTypeWriter sets a writer for the request type view.
+func WithRequestTypeWriter(w io.Writer) RequestTypeOption {
+	return func(rt *RequestType) {
+		rt.writer = w
+	}
+}
+
+// Render renders the request type view.
+func (rt RequestType) Render() error {
+	printTableHeader(rt.writer, []string{"ID", "NAME", "DESCRIPTION"})
+
+	for _, d := range rt.data {
+		_, _ = fmt.Fprintf(rt.writer, "%s\t%s\t%s\n", d.ID, prepareTitle(d.Name), prepareTitle(d.Description))
+	}
+	return flushAndPage(rt.writer, rt.buf)
+}
+
+// ApprovalOption is a functional option to wrap approval properties.
+type ApprovalOption func(*Approval)
+
+// Approval is a customer request approval view.
+type Approval struct {
+	data   []*jira.Approval
+	writer io.Writer
+	buf    *bytes.Buffer
+}
+
+// NewApproval initializes an approval view.
+func NewApproval(data []*jira.Approval, opts ...ApprovalOption) *Approval {
+	a := Approval{
+		data: data,
+		buf:  new(bytes.Buffer),
+	}
+	a.writer = tabwriter.NewWriter(a.buf, 0, tabWidth, 1, '\t', 0)
+
+	for _, opt := range opts {
+		opt(&a)
+	}
+	return &a
+}
+
+// WithApprovalWriter sets a writer for the approval view.
+func WithApprovalWriter(w io.Writer) ApprovalOption {
+	return func(a *Approval) {
+		a.writer = w
+	}
+}
+
+// Render renders the approval view.
+func (a Approval) Render() error {
+	printTableHeader(a.writer, []string{"ID", "NAME", "DECISION", "APPROVERS"})
+
+	for _, d := range a.data {
+		approvers := make([]string, 0, len(d.Approvers))
+		for _, ap := range d.Approvers {
+			approvers = append(approvers, fmt.Sprintf("%s (%s)", ap.Approver.DisplayName, ap.ApproverDecision))
+		}
+		_, _ = fmt.Fprintf(
+			a.writer, "%s\t%s\t%s\t%s\n",
+			d.ID, prepareTitle(d.Name), d.FinalDecision, strings.Join(approvers, ", "),
+		)
+	}
+	return flushAndPage(a.writer, a.buf)
+}
+
+func printTableHeader(w io.Writer, headers []string) {
+	end := len(headers) - 1
+	for i, h := range headers {
+		_, _ = fmt.Fprintf(w, "%s", h)
+		if i != end {
+			_, _ = fmt.Fprintf(w, "\t")
+		}
+	}
+	_, _ = fmt.Fprintln(w)
+}
+
+func flushAndPage(w io.Writer, buf *bytes.Buffer) error {
+	if tw, ok := w.(*tabwriter.Writer); ok {
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+	}
+	return tui.PagerOut(buf.String())
+}
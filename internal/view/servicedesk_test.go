@@ -0,0 +1,67 @@
+package view
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestServiceDeskRender(t *testing.T) {
+	var b bytes.Buffer
+
+	data := []*jira.ServiceDesk{
+		{ID: "1", ProjectKey: "ITH", ProjectName: "IT Help Desk"},
+		{ID: "2", ProjectKey: "HR", ProjectName: "HR Services"},
+	}
+	sd := NewServiceDesk(data, WithServiceDeskWriter(&b))
+	assert.NoError(t, sd.Render())
+
+	expected := `ID	KEY	NAME
+1	ITH	IT Help Desk
+2	HR	HR Services
+`
+	assert.Equal(t, expected, b.String())
+}
+
+func TestQueueRender(t *testing.T) {
+	var b bytes.Buffer
+
+	data := []*jira.Queue{
+		{ID: "10", Name: "Unassigned issues", IssueCount: 7, JQL: "assignee is EMPTY"},
+		{ID: "20", Name: "All open", IssueCount: 0, JQL: "resolution = Unresolved"},
+	}
+	q := NewQueue(data, WithQueueWriter(&b))
+	assert.NoError(t, q.Render())
+
+	expected := `ID	NAME	ISSUES	JQL
+10	Unassigned issues	7	assignee is EMPTY
+20	All open	0	resolution = Unresolved
+`
+	assert.Equal(t, expected, b.String())
+}
+
+func TestApprovalRender(t *testing.T) {
+	var b bytes.Buffer
+
+	data := []*jira.Approval{
+		{
+			ID:            "5",
+			Name:          "Manager approval",
+			FinalDecision: "pending",
+			Approvers: []jira.Approver{
+				{Approver: jira.User{DisplayName: "Jane Doe"}, ApproverDecision: "pending"},
+				{Approver: jira.User{DisplayName: "John Doe"}, ApproverDecision: "approved"},
+			},
+		},
+	}
+	a := NewApproval(data, WithApprovalWriter(&b))
+	assert.NoError(t, a.Render())
+
+	expected := `ID	NAME	DECISION	APPROVERS
+5	Manager approval	pending	Jane Doe (pending), John Doe (approved)
+`
+	assert.Equal(t, expected, b.String())
+}
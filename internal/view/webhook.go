@@ -0,0 +1,96 @@
+package view
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/tui"
+)
+
+// WebhookOption is a functional option to wrap webhook properties.
+type WebhookOption func(*Webhook)
+
+// Webhook is a registered webhooks view.
+type Webhook struct {
+	data   []*jira.Webhook
+	writer io.Writer
+	buf    *bytes.Buffer
+	plain  bool
+	json   bool
+}
+
+// NewWebhook initializes a webhook view.
+func NewWebhook(data []*jira.Webhook, opts ...WebhookOption) *Webhook {
+	w := Webhook{
+		data: data,
+		buf:  new(bytes.Buffer),
+	}
+	w.writer = tabwriter.NewWriter(w.buf, 0, tabWidth, 1, '\t', 0)
+
+	for _, opt := range opts {
+		opt(&w)
+	}
+	return &w
+}
+
+// WithWebhookWriter sets a writer for the webhook view.
+func WithWebhookWriter(w io.Writer) WebhookOption {
+	return func(wh *Webhook) {
+		wh.writer = w
+	}
+}
+
+// WithWebhookPlain prints the output directly to stdout instead of the pager.
+func WithWebhookPlain(plain bool) WebhookOption {
+	return func(wh *Webhook) {
+		wh.plain = plain
+	}
+}
+
+// WithWebhookJSON renders webhooks as a JSON array.
+func WithWebhookJSON(jsonOut bool) WebhookOption {
+	return func(wh *Webhook) {
+		wh.json = jsonOut
+	}
+}
+
+// Render renders the webhook view.
+func (w Webhook) Render() error {
+	if w.json {
+		out, err := json.MarshalIndent(w.data, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, _ = fmt.Fprintln(w.writer, string(out))
+	} else {
+		printTableHeader(w.writer, []string{"ID", "NAME", "URL", "EVENTS", "JQL", "ENABLED"})
+
+		for _, d := range w.data {
+			enabled := "no"
+			if d.Enabled {
+				enabled = "yes"
+			}
+			_, _ = fmt.Fprintf(
+				w.writer, "%s\t%s\t%s\t%s\t%s\t%s\n",
+				d.ID(), d.Name, d.URL, strings.Join(d.Events, ","), d.JQL(), enabled,
+			)
+		}
+	}
+	if tw, ok := w.writer.(*tabwriter.Writer); ok {
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+	}
+
+	if w.plain || w.json {
+		_, err := fmt.Fprint(os.Stdout, w.buf.String())
+		return err
+	}
+	return tui.PagerOut(w.buf.String())
+}
@@ -0,0 +1,33 @@
+package view
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestWebhookRender(t *testing.T) {
+	var b bytes.Buffer
+
+	data := []*jira.Webhook{
+		{
+			Self:    "https://jira.example.com/rest/webhooks/1.0/webhook/7",
+			Name:    "Local automation",
+			URL:     "https://hooks.example.com/jira",
+			Events:  []string{jira.WebhookIssueUpdated, jira.WebhookCommentCreated},
+			Filters: map[string]string{jira.WebhookJQLFilter: "project = ABC"},
+			Enabled: true,
+		},
+		{Self: "https://jira.example.com/rest/webhooks/1.0/webhook/8", Name: "Sprints", URL: "https://hooks.example.com/sprint"},
+	}
+	assert.NoError(t, NewWebhook(data, WithWebhookWriter(&b)).Render())
+
+	expected := `ID	NAME	URL	EVENTS	JQL	ENABLED
+7	Local automation	https://hooks.example.com/jira	jira:issue_updated,comment_created	project = ABC	yes
+8	Sprints	https://hooks.example.com/sprint			no
+`
+	assert.Equal(t, expected, b.String())
+}
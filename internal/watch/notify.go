@@ -0,0 +1,43 @@
+package watch
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// Notifier sends a desktop notification about changes by running a shell command.
+//
+// The title and the message are passed to the command as positional parameters
+// and as JIRA_WATCH_TITLE and JIRA_WATCH_MESSAGE environment variables, eg:
+//
+//	notify-send "$1" "$2"
+//	osascript -e "display notification \"$JIRA_WATCH_MESSAGE\" with title \"$JIRA_WATCH_TITLE\""
+type Notifier struct {
+	Command string
+}
+
+// Notify runs the notifier command. It does nothing if the command is empty.
+func (n Notifier) Notify(title, message string) error {
+	if strings.TrimSpace(n.Command) == "" {
+		return nil
+	}
+
+	c := exec.Command("sh", "-c", n.Command, "jira", title, message)
+	c.Env = append(os.Environ(), "JIRA_WATCH_TITLE="+title, "JIRA_WATCH_MESSAGE="+message)
+
+	return c.Run()
+}
+
+// NotifyChanges notifies about changes, if any.
+func (n Notifier) NotifyChanges(title string, changes []Change) error {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	lines := make([]string, 0, len(changes))
+	for _, c := range changes {
+		lines = append(lines, c.String())
+	}
+	return n.Notify(title+": "+Summary(changes), strings.Join(lines, "\n"))
+}
@@ -0,0 +1,244 @@
+// Package watch polls Jira for issues that changed and describes the changes.
+package watch
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	// DefaultInterval is the poll interval used when no interval is given.
+	DefaultInterval = 30 * time.Second
+	// MinInterval is the shortest allowed poll interval.
+	MinInterval = 5 * time.Second
+)
+
+// Kind is a kind of change.
+type Kind string
+
+// Kinds of changes.
+const (
+	Added   Kind = "added"
+	Updated Kind = "updated"
+	Removed Kind = "removed"
+)
+
+// FieldChange is a change of a single field of an issue.
+type FieldChange struct {
+	Field string
+	From  string
+	To    string
+}
+
+// String returns a description of the change, eg: "status: To Do → Done".
+func (f FieldChange) String() string {
+	return fmt.Sprintf("%s: %s → %s", f.Field, orNone(f.From), orNone(f.To))
+}
+
+// Change is a change of an issue between two polls.
+type Change struct {
+	Kind Kind
+	// Issue is the latest known version of the issue.
+	Issue *jira.Issue
+	// Fields are the fields that changed. It is empty for added and removed
+	// issues, and may be empty for updated issues if only hidden fields changed.
+	Fields []FieldChange
+}
+
+// String returns a one line description of the change, eg: "ISSUE-1 status: To Do → Done".
+func (c Change) String() string {
+	if c.Kind != Updated || len(c.Fields) == 0 {
+		return fmt.Sprintf("%s %s: %s", c.Issue.Key, c.Kind, c.Issue.Fields.Summary)
+	}
+
+	parts := make([]string, 0, len(c.Fields))
+	for _, f := range c.Fields {
+		parts = append(parts, f.String())
+	}
+	return fmt.Sprintf("%s %s", c.Issue.Key, strings.Join(parts, ", "))
+}
+
+// Summary returns a short description of changes, eg: "1 added, 2 updated".
+func Summary(changes []Change) string {
+	counts := make(map[Kind]int)
+	for _, c := range changes {
+		counts[c.Kind]++
+	}
+
+	var parts []string
+	for _, k := range []Kind{Added, Updated, Removed} {
+		if counts[k] > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", counts[k], k))
+		}
+	}
+	if len(parts) == 0 {
+		return "no changes"
+	}
+	return strings.Join(parts, ", ")
+}
+
+// Diff returns fields that differ between two versions of an issue.
+func Diff(old, cur *jira.Issue) []FieldChange {
+	var out []FieldChange
+
+	add := func(field, from, to string) {
+		if from != to {
+			out = append(out, FieldChange{Field: field, From: from, To: to})
+		}
+	}
+
+	o, c := old.Fields, cur.Fields
+
+	add("summary", o.Summary, c.Summary)
+	add("status", o.Status.Name, c.Status.Name)
+	add("assignee", o.Assignee.Name, c.Assignee.Name)
+	add("reporter", o.Reporter.Name, c.Reporter.Name)
+	add("priority", o.Priority.Name, c.Priority.Name)
+	add("resolution", o.Resolution.Name, c.Resolution.Name)
+	add("type", o.IssueType.Name, c.IssueType.Name)
+	add("labels", strings.Join(o.Labels, ","), strings.Join(c.Labels, ","))
+	add("comments", fmt.Sprint(o.Comment.Total), fmt.Sprint(c.Comment.Total))
+
+	return out
+}
+
+// SearchFunc searches issues using a JQL.
+type SearchFunc func(jql string) ([]*jira.Issue, error)
+
+// Poller finds issues of a query that changed since the last poll.
+type Poller struct {
+	// JQL is the watched query. It may end with an ORDER BY clause.
+	JQL string
+	// Search runs a query in the scope of the watched list, eg: a sprint.
+	Search SearchFunc
+	// Lookup runs a query outside of the scope. It is used to find issues
+	// that no longer match the watched query. Removals are not detected if nil.
+	Lookup SearchFunc
+
+	last time.Time
+	now  func() time.Time
+}
+
+// NewPoller creates a poller that looks for changes made from now on.
+func NewPoller(jql string, search, lookup SearchFunc) *Poller {
+	return &Poller{JQL: jql, Search: search, Lookup: lookup, last: time.Now(), now: time.Now}
+}
+
+var orderBy = regexp.MustCompile(`(?i)\s*\bORDER\s+BY\b`)
+
+// Since returns the watched query restricted to issues updated since the last poll.
+//
+// Jira compares absolute dates in the timezone of the user with a precision of a
+// minute, so a relative period rounded up to the next minute is used instead.
+// Issues seen twice are filtered out by comparing their update time.
+func (p *Poller) Since() string {
+	since := p.updated()
+
+	q, order := p.JQL, ""
+	if loc := orderBy.FindStringIndex(q); loc != nil {
+		q, order = q[:loc[0]], " "+strings.TrimSpace(q[loc[0]:])
+	}
+	if strings.TrimSpace(q) == "" {
+		return since + order
+	}
+	return fmt.Sprintf("(%s) AND %s%s", q, since, order)
+}
+
+// Poll fetches issues that changed since the last poll and applies them to
+// issues. New issues are added at the top of the list.
+func (p *Poller) Poll(issues []*jira.Issue) ([]*jira.Issue, []Change, error) {
+	jql, polled := p.Since(), p.now()
+
+	found, err := p.Search(jql)
+	if err != nil {
+		return issues, nil, err
+	}
+
+	index := make(map[string]int, len(issues))
+	for i, iss := range issues {
+		index[iss.Key] = i
+	}
+
+	var (
+		changes []Change
+		added   []*jira.Issue
+		seen    = make(map[string]bool, len(found))
+		out     = append([]*jira.Issue(nil), issues...)
+	)
+
+	for _, iss := range found {
+		seen[iss.Key] = true
+
+		i, ok := index[iss.Key]
+		if !ok {
+			added = append(added, iss)
+			changes = append(changes, Change{Kind: Added, Issue: iss})
+			continue
+		}
+		if out[i].Fields.Updated == iss.Fields.Updated {
+			continue
+		}
+		changes = append(changes, Change{Kind: Updated, Issue: iss, Fields: Diff(out[i], iss)})
+		out[i] = iss
+	}
+
+	if p.Lookup != nil && len(issues) > 0 {
+		removed, err := p.removed(issues, seen)
+		if err != nil {
+			return issues, nil, err
+		}
+		if len(removed) > 0 {
+			kept := out[:0]
+			for _, iss := range out {
+				if removed[iss.Key] {
+					changes = append(changes, Change{Kind: Removed, Issue: iss})
+					continue
+				}
+				kept = append(kept, iss)
+			}
+			out = kept
+		}
+	}
+
+	p.last = polled
+
+	return append(added, out...), changes, nil
+}
+
+func (p *Poller) updated() string {
+	minutes := int(math.Ceil(p.now().Sub(p.last).Minutes())) + 1
+	return fmt.Sprintf(`updated >= "-%dm"`, minutes)
+}
+
+// removed returns issues that were updated since the last poll but no longer match the query.
+func (p *Poller) removed(issues []*jira.Issue, seen map[string]bool) (map[string]bool, error) {
+	keys := make([]string, 0, len(issues))
+	for _, iss := range issues {
+		keys = append(keys, iss.Key)
+	}
+
+	updated, err := p.Lookup(fmt.Sprintf("key IN (%s) AND %s", strings.Join(keys, ", "), p.updated()))
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(map[string]bool)
+	for _, iss := range updated {
+		if !seen[iss.Key] {
+			out[iss.Key] = true
+		}
+	}
+	return out, nil
+}
+
+func orNone(s string) string {
+	if s == "" {
+		return "none"
+	}
+	return s
+}
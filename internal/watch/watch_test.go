@@ -0,0 +1,152 @@
+package watch
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func newIssue(key, status, updated string) *jira.Issue {
+	iss := jira.Issue{Key: key}
+	iss.Fields.Summary = "Summary of " + key
+	iss.Fields.Status.Name = status
+	iss.Fields.Updated = updated
+	return &iss
+}
+
+func newTestPoller(jql string, search, lookup SearchFunc) (*Poller, *time.Time) {
+	now := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	p := NewPoller(jql, search, lookup)
+	p.last = now
+	p.now = func() time.Time { return now }
+	return p, &now
+}
+
+func TestSince(t *testing.T) {
+	cases := []struct {
+		jql      string
+		elapsed  time.Duration
+		expected string
+	}{
+		{`project="TEST" ORDER BY created DESC`, 30 * time.Second, `(project="TEST") AND updated >= "-2m" ORDER BY created DESC`},
+		{`project="TEST" order by rank`, 3 * time.Minute, `(project="TEST") AND updated >= "-4m" order by rank`},
+		{`status = Done`, 0, `(status = Done) AND updated >= "-1m"`},
+		{`ORDER BY created`, time.Minute, `updated >= "-2m" ORDER BY created`},
+		{``, 0, `updated >= "-1m"`},
+	}
+
+	for _, tc := range cases {
+		p, now := newTestPoller(tc.jql, nil, nil)
+		*now = now.Add(tc.elapsed)
+		assert.Equal(t, tc.expected, p.Since())
+	}
+}
+
+func TestPoll(t *testing.T) {
+	var queries []string
+
+	current := []*jira.Issue{
+		newIssue("TEST-1", "To Do", "2024-05-01T09:00:00.000+0000"),
+		newIssue("TEST-2", "To Do", "2024-05-01T09:00:00.000+0000"),
+		newIssue("TEST-3", "To Do", "2024-05-01T09:00:00.000+0000"),
+	}
+
+	search := func(jql string) ([]*jira.Issue, error) {
+		queries = append(queries, jql)
+		return []*jira.Issue{
+			newIssue("TEST-4", "To Do", "2024-05-01T10:00:30.000+0000"),
+			newIssue("TEST-1", "In Progress", "2024-05-01T10:00:10.000+0000"),
+			newIssue("TEST-2", "To Do", "2024-05-01T09:00:00.000+0000"),
+		}, nil
+	}
+	lookup := func(jql string) ([]*jira.Issue, error) {
+		queries = append(queries, jql)
+		return []*jira.Issue{
+			newIssue("TEST-1", "In Progress", "2024-05-01T10:00:10.000+0000"),
+			newIssue("TEST-3", "Done", "2024-05-01T10:00:20.000+0000"),
+		}, nil
+	}
+
+	p, now := newTestPoller(`project="TEST" AND status != Done ORDER BY created DESC`, search, lookup)
+	*now = now.Add(time.Minute)
+
+	issues, changes, err := p.Poll(current)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{
+		`(project="TEST" AND status != Done) AND updated >= "-2m" ORDER BY created DESC`,
+		`key IN (TEST-1, TEST-2, TEST-3) AND updated >= "-2m"`,
+	}, queries)
+
+	keys := make([]string, 0, len(issues))
+	for _, iss := range issues {
+		keys = append(keys, iss.Key)
+	}
+	assert.Equal(t, []string{"TEST-4", "TEST-1", "TEST-2"}, keys)
+	assert.Equal(t, "In Progress", issues[1].Fields.Status.Name)
+
+	assert.Len(t, changes, 3)
+	assert.Equal(t, Added, changes[0].Kind)
+	assert.Equal(t, "TEST-4", changes[0].Issue.Key)
+	assert.Equal(t, Updated, changes[1].Kind)
+	assert.Equal(t, []FieldChange{{Field: "status", From: "To Do", To: "In Progress"}}, changes[1].Fields)
+	assert.Equal(t, Removed, changes[2].Kind)
+	assert.Equal(t, "TEST-3", changes[2].Issue.Key)
+
+	assert.Equal(t, "1 added, 1 updated, 1 removed", Summary(changes))
+	assert.Equal(t, "TEST-1 status: To Do → In Progress", changes[1].String())
+	assert.Equal(t, "TEST-4 added: Summary of TEST-4", changes[0].String())
+
+	// The original list is left untouched.
+	assert.Equal(t, "To Do", current[0].Fields.Status.Name)
+	assert.Len(t, current, 3)
+}
+
+func TestPollWithoutLookup(t *testing.T) {
+	current := []*jira.Issue{newIssue("TEST-1", "To Do", "2024-05-01T09:00:00.000+0000")}
+
+	p, _ := newTestPoller(`project="TEST"`, func(string) ([]*jira.Issue, error) { return nil, nil }, nil)
+
+	issues, changes, err := p.Poll(current)
+	assert.NoError(t, err)
+	assert.Equal(t, current, issues)
+	assert.Empty(t, changes)
+	assert.Equal(t, "no changes", Summary(changes))
+}
+
+func TestDiff(t *testing.T) {
+	old := newIssue("TEST-1", "To Do", "")
+	old.Fields.Labels = []string{"backend"}
+
+	cur := newIssue("TEST-1", "To Do", "")
+	cur.Fields.Assignee.Name = "Jane Doe"
+	cur.Fields.Labels = []string{"backend", "urgent"}
+	cur.Fields.Comment.Total = 2
+
+	assert.Equal(t, []FieldChange{
+		{Field: "assignee", From: "", To: "Jane Doe"},
+		{Field: "labels", From: "backend", To: "backend,urgent"},
+		{Field: "comments", From: "0", To: "2"},
+	}, Diff(old, cur))
+
+	assert.Empty(t, Diff(old, old))
+}
+
+func TestNotifier(t *testing.T) {
+	out := filepath.Join(t.TempDir(), "notification")
+
+	n := Notifier{Command: `printf '%s|%s|%s' "$1" "$2" "$JIRA_WATCH_TITLE" > ` + out}
+	changes := []Change{{Kind: Added, Issue: newIssue("TEST-1", "To Do", "")}}
+	assert.NoError(t, n.NotifyChanges("jira issue list", changes))
+
+	b, err := os.ReadFile(out)
+	assert.NoError(t, err)
+	assert.Equal(t, "jira issue list: 1 added|TEST-1 added: Summary of TEST-1|jira issue list: 1 added", string(b))
+
+	assert.NoError(t, Notifier{}.Notify("title", "message"))
+}
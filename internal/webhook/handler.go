@@ -0,0 +1,210 @@
+// Package webhook receives jira webhook events and dispatches them to
+// handlers configured in the `webhook` section of the config.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// Handler runs a shell command for events it matches. The raw event JSON
+// is passed to the command on stdin, eg:
+//
+//	webhook:
+//	  handlers:
+//	    - name: deploy-notes
+//	      events: [jira:issue_updated]
+//	      match:
+//	        project: ABC
+//	        changed: status
+//	        status: Done
+//	      run: jq -r .issue.key >> ~/done.txt
+type Handler struct {
+	Name string `mapstructure:"name"`
+	// Events are event names the handler runs for, eg: jira:issue_created.
+	// Glob patterns like `comment_*` are supported. All events match if empty.
+	Events []string `mapstructure:"events"`
+	// Match maps fields to values the event must have. Matching is case-insensitive
+	// and supports glob patterns. A value prefixed with `~` negates the match.
+	Match map[string]string `mapstructure:"match"`
+	// Run is the shell command to run.
+	Run string `mapstructure:"run"`
+}
+
+// matchFields are the fields a handler can match events with.
+var matchFields = []string{
+	"project", "key", "type", "status", "priority", "assignee", "reporter",
+	"label", "component", "changed", "user", "sprint", "sprint_state", "board",
+}
+
+// SecretFromConfig returns the secret payloads are signed with. It is read from
+// the JIRA_WEBHOOK_SECRET env var or the `webhook.secret` config.
+func SecretFromConfig() string {
+	if secret := os.Getenv("JIRA_WEBHOOK_SECRET"); secret != "" {
+		return secret
+	}
+	return viper.GetString("webhook.secret")
+}
+
+// HandlersFromConfig returns handlers defined in the `webhook.handlers` section of the config.
+func HandlersFromConfig() ([]Handler, error) {
+	var handlers []Handler
+	if err := viper.UnmarshalKey("webhook.handlers", &handlers); err != nil {
+		return nil, fmt.Errorf("invalid webhook config: %w", err)
+	}
+	for i, h := range handlers {
+		if err := h.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid webhook handler %s: %w", h.label(i), err)
+		}
+	}
+	return handlers, nil
+}
+
+// Validate checks if the handler is runnable and its patterns are valid.
+func (h Handler) Validate() error {
+	if strings.TrimSpace(h.Run) == "" {
+		return fmt.Errorf("missing command to run")
+	}
+	for _, e := range h.Events {
+		if _, err := path.Match(e, ""); err != nil {
+			return fmt.Errorf("invalid event pattern %q", e)
+		}
+	}
+	for field, value := range h.Match {
+		if !containsFold(matchFields, field) {
+			return fmt.Errorf("unknown match field %q, valid fields are: %s", field, strings.Join(matchFields, ", "))
+		}
+		if _, err := path.Match(strings.TrimPrefix(value, "~"), ""); err != nil {
+			return fmt.Errorf("invalid pattern %q for field %q", value, field)
+		}
+	}
+	return nil
+}
+
+// Matches tells if the handler should run for the event.
+func (h Handler) Matches(ev *jira.WebhookEvent) bool {
+	if len(h.Events) > 0 && !matchAny(h.Events, []string{ev.Event}) {
+		return false
+	}
+	for field, value := range h.Match {
+		want, negate := strings.CutPrefix(value, "~")
+		if matchAny([]string{want}, fieldValues(ev, strings.ToLower(field))) == negate {
+			return false
+		}
+	}
+	return true
+}
+
+// Exec runs the handler command with the raw event on stdin. Event details are
+// exported as JIRA_WEBHOOK_EVENT, JIRA_ISSUE_KEY and JIRA_SPRINT_ID environment variables.
+func (h Handler) Exec(ctx context.Context, ev *jira.WebhookEvent, body []byte) ([]byte, error) {
+	c := exec.CommandContext(ctx, "sh", "-c", h.Run)
+	c.Stdin = bytes.NewReader(body)
+	c.Env = append(os.Environ(), "JIRA_WEBHOOK_EVENT="+ev.Event, "JIRA_WEBHOOK_HANDLER="+h.Name)
+	if ev.Issue != nil {
+		c.Env = append(c.Env, "JIRA_ISSUE_KEY="+ev.Issue.Key)
+	}
+	if ev.Sprint != nil {
+		c.Env = append(c.Env, "JIRA_SPRINT_ID="+strconv.Itoa(ev.Sprint.ID))
+	}
+	return c.CombinedOutput()
+}
+
+func (h Handler) label(i int) string {
+	if h.Name != "" {
+		return strconv.Quote(h.Name)
+	}
+	return "#" + strconv.Itoa(i+1)
+}
+
+// fieldValues returns values of a field in the event. Fields with multiple
+// values, eg: labels, match if any of the values match.
+func fieldValues(ev *jira.WebhookEvent, field string) []string {
+	switch field {
+	case "user":
+		if ev.User != nil {
+			return []string{ev.User.DisplayName, ev.User.Name, ev.User.AccountID}
+		}
+		return nil
+	case "changed":
+		if ev.Changelog == nil {
+			return nil
+		}
+		out := make([]string, 0, len(ev.Changelog.Items))
+		for _, item := range ev.Changelog.Items {
+			out = append(out, item.Field)
+		}
+		return out
+	case "sprint", "sprint_state", "board":
+		if ev.Sprint == nil {
+			return nil
+		}
+		return map[string][]string{
+			"sprint":       {ev.Sprint.Name, strconv.Itoa(ev.Sprint.ID)},
+			"sprint_state": {ev.Sprint.Status},
+			"board":        {strconv.Itoa(ev.Sprint.BoardID)},
+		}[field]
+	}
+
+	iss := ev.Issue
+	if iss == nil {
+		return nil
+	}
+	switch field {
+	case "project":
+		project, _, _ := strings.Cut(iss.Key, "-")
+		return []string{project}
+	case "key":
+		return []string{iss.Key}
+	case "type":
+		return []string{iss.Fields.IssueType.Name}
+	case "status":
+		return []string{iss.Fields.Status.Name}
+	case "priority":
+		return []string{iss.Fields.Priority.Name}
+	case "assignee":
+		return []string{iss.Fields.Assignee.Name}
+	case "reporter":
+		return []string{iss.Fields.Reporter.Name}
+	case "label":
+		return iss.Fields.Labels
+	case "component":
+		out := make([]string, 0, len(iss.Fields.Components))
+		for _, c := range iss.Fields.Components {
+			out = append(out, c.Name)
+		}
+		return out
+	}
+	return nil
+}
+
+func matchAny(patterns, values []string) bool {
+	for _, p := range patterns {
+		p = strings.ToLower(p)
+		for _, v := range values {
+			if ok, _ := path.Match(p, strings.ToLower(v)); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func containsFold(list []string, s string) bool {
+	for _, v := range list {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
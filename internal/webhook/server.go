@@ -0,0 +1,159 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ankitpokhrel/jira-cli/internal/logger"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	// SignatureHeader is the header jira sends the payload signature in, eg: `sha256=<hex digest>`.
+	SignatureHeader = "X-Hub-Signature"
+
+	// DefaultTimeout is the time a handler command is allowed to run.
+	DefaultTimeout = time.Minute
+
+	maxBodySize = 10 << 20
+)
+
+var (
+	// ErrMissingSignature is returned if a signed payload is expected but the signature is missing.
+	ErrMissingSignature = errors.New("missing signature")
+	// ErrInvalidSignature is returned if the payload signature doesn't match the secret.
+	ErrInvalidSignature = errors.New("invalid signature")
+)
+
+// Sign returns the signature of the body that jira sends in the SignatureHeader.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks the signature of the body with the secret.
+func Verify(secret string, body []byte, signature string) error {
+	if signature == "" {
+		return ErrMissingSignature
+	}
+	if !strings.HasPrefix(signature, "sha256=") {
+		return ErrInvalidSignature
+	}
+	if !hmac.Equal([]byte(signature), []byte(Sign(secret, body))) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// Server receives webhook events and dispatches them to matching handlers.
+//
+// Events are acknowledged as soon as they are verified and decoded, handlers
+// run in the background so that slow commands don't make jira retry the delivery.
+type Server struct {
+	// Secret verifies payload signatures. Payloads are not verified if it is empty.
+	Secret   string
+	Handlers []Handler
+	// Timeout is the time a handler command is allowed to run.
+	Timeout time.Duration
+	Log     *logger.Logger
+
+	wg sync.WaitGroup
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize))
+	if err != nil {
+		http.Error(w, "unable to read body", http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	if s.Secret != "" {
+		if err := Verify(s.Secret, body, r.Header.Get(SignatureHeader)); err != nil {
+			s.Log.Warn("Rejected event from %s: %s", r.RemoteAddr, err)
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+	}
+
+	var ev jira.WebhookEvent
+	if err := json.Unmarshal(body, &ev); err != nil || ev.Event == "" {
+		s.Log.Warn("Rejected event from %s: invalid payload", r.RemoteAddr)
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+
+	handlers := s.match(&ev)
+	s.Log.Info("Received %s, %d handler(s) matched", describe(&ev), len(handlers))
+
+	w.WriteHeader(http.StatusAccepted)
+
+	for _, h := range handlers {
+		s.wg.Add(1)
+		go func(h Handler) {
+			defer s.wg.Done()
+			s.run(h, &ev, body)
+		}(h)
+	}
+}
+
+// Wait waits for running handlers to finish.
+func (s *Server) Wait() {
+	s.wg.Wait()
+}
+
+func (s *Server) match(ev *jira.WebhookEvent) []Handler {
+	var out []Handler
+	for _, h := range s.Handlers {
+		if h.Matches(ev) {
+			out = append(out, h)
+		}
+	}
+	return out
+}
+
+func (s *Server) run(h Handler, ev *jira.WebhookEvent, body []byte) {
+	timeout := s.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	out, err := h.Exec(ctx, ev, body)
+	if len(out) > 0 {
+		s.Log.Info("Handler %q output:\n%s", h.Name, strings.TrimRight(string(out), "\n"))
+	}
+	if err != nil {
+		s.Log.Error("Handler %q failed for %s: %s", h.Name, describe(ev), err)
+		return
+	}
+	s.Log.Info("Handler %q ran for %s", h.Name, describe(ev))
+}
+
+// describe returns a short description of the event for logs, eg: `jira:issue_updated ABC-1`.
+func describe(ev *jira.WebhookEvent) string {
+	switch {
+	case ev.Issue != nil:
+		return ev.Event + " " + ev.Issue.Key
+	case ev.Sprint != nil:
+		return ev.Event + " " + ev.Sprint.Name
+	}
+	return ev.Event
+}
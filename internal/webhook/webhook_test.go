@@ -0,0 +1,221 @@
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/internal/logger"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func loadEvent(t *testing.T, name string) ([]byte, *jira.WebhookEvent) {
+	t.Helper()
+
+	body, err := os.ReadFile(filepath.Join("testdata", name+".json"))
+	assert.NoError(t, err)
+
+	var ev jira.WebhookEvent
+	assert.NoError(t, json.Unmarshal(body, &ev))
+
+	return body, &ev
+}
+
+func TestDecodeRecordedEvents(t *testing.T) {
+	_, created := loadEvent(t, "issue_created")
+	assert.Equal(t, jira.WebhookIssueCreated, created.Event)
+	assert.Equal(t, "ABC-42", created.Issue.Key)
+	assert.Equal(t, "Bug", created.Issue.Fields.IssueType.Name)
+	assert.Equal(t, []string{"frontend", "regression"}, created.Issue.Fields.Labels)
+	assert.Equal(t, "Alice", created.User.DisplayName)
+
+	_, updated := loadEvent(t, "issue_updated")
+	item, ok := updated.Changed("status")
+	assert.True(t, ok)
+	assert.Equal(t, "To Do", item.FromString)
+	assert.Equal(t, "Done", item.ToString)
+
+	_, comment := loadEvent(t, "comment_created")
+	assert.Equal(t, "Carol", comment.Comment.Author.DisplayName)
+	assert.Equal(t, "Verified on Safari 17, thanks [~bob]!", comment.Comment.Body)
+	assert.Equal(t, "ABC-42", comment.Issue.Key)
+
+	_, sprint := loadEvent(t, "sprint_closed")
+	assert.Equal(t, 57, sprint.Sprint.ID)
+	assert.Equal(t, "closed", sprint.Sprint.Status)
+	assert.Equal(t, 4, sprint.Sprint.BoardID)
+	assert.Nil(t, sprint.Issue)
+}
+
+func TestHandlerMatches(t *testing.T) {
+	_, created := loadEvent(t, "issue_created")
+	_, updated := loadEvent(t, "issue_updated")
+	_, comment := loadEvent(t, "comment_created")
+	_, started := loadEvent(t, "sprint_started")
+
+	cases := []struct {
+		name    string
+		handler Handler
+		matches []*jira.WebhookEvent
+	}{
+		{
+			name:    "all events",
+			handler: Handler{},
+			matches: []*jira.WebhookEvent{created, updated, comment, started},
+		},
+		{
+			name:    "event glob",
+			handler: Handler{Events: []string{"jira:issue_*"}},
+			matches: []*jira.WebhookEvent{created, updated},
+		},
+		{
+			name:    "status change to done",
+			handler: Handler{Match: map[string]string{"changed": "status", "status": "done"}},
+			matches: []*jira.WebhookEvent{updated},
+		},
+		{
+			name:    "project and label",
+			handler: Handler{Match: map[string]string{"project": "ABC", "label": "front*"}},
+			matches: []*jira.WebhookEvent{created, updated},
+		},
+		{
+			name:    "negated status",
+			handler: Handler{Events: []string{jira.WebhookIssueCreated, jira.WebhookIssueUpdated}, Match: map[string]string{"status": "~Done"}},
+			matches: []*jira.WebhookEvent{created},
+		},
+		{
+			name:    "event user",
+			handler: Handler{Match: map[string]string{"user": "bob"}},
+			matches: []*jira.WebhookEvent{updated},
+		},
+		{
+			name:    "sprint of a board",
+			handler: Handler{Events: []string{"sprint_*"}, Match: map[string]string{"board": "4", "sprint_state": "active"}},
+			matches: []*jira.WebhookEvent{started},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var matched []*jira.WebhookEvent
+			for _, ev := range []*jira.WebhookEvent{created, updated, comment, started} {
+				if tc.handler.Matches(ev) {
+					matched = append(matched, ev)
+				}
+			}
+			assert.Equal(t, tc.matches, matched)
+		})
+	}
+}
+
+func TestHandlerValidate(t *testing.T) {
+	assert.NoError(t, Handler{Run: "cat", Events: []string{"comment_*"}, Match: map[string]string{"Status": "~Done"}}.Validate())
+	assert.EqualError(t, Handler{}.Validate(), "missing command to run")
+	assert.EqualError(t, Handler{Run: "cat", Events: []string{"[x"}}.Validate(), `invalid event pattern "[x"`)
+	assert.ErrorContains(t, Handler{Run: "cat", Match: map[string]string{"colour": "red"}}.Validate(), `unknown match field "colour"`)
+}
+
+func TestVerify(t *testing.T) {
+	body := []byte(`{"webhookEvent": "jira:issue_created"}`)
+	sig := Sign("s3cret", body)
+
+	assert.Equal(t, "sha256=", sig[:7])
+	assert.NoError(t, Verify("s3cret", body, sig))
+	assert.ErrorIs(t, Verify("other", body, sig), ErrInvalidSignature)
+	assert.ErrorIs(t, Verify("s3cret", body, sig[7:]), ErrInvalidSignature)
+	assert.ErrorIs(t, Verify("s3cret", body, ""), ErrMissingSignature)
+}
+
+func TestServer(t *testing.T) {
+	dir := t.TempDir()
+	out := filepath.Join(dir, "out")
+
+	s := &Server{
+		Secret: "s3cret",
+		Handlers: []Handler{
+			{
+				Name:   "done",
+				Events: []string{jira.WebhookIssueUpdated},
+				Match:  map[string]string{"changed": "status", "status": "Done"},
+				Run:    `echo "$JIRA_ISSUE_KEY" >> ` + out,
+			},
+			{
+				Name:   "sprint",
+				Events: []string{"sprint_*"},
+				Run:    `echo "$JIRA_WEBHOOK_EVENT $JIRA_SPRINT_ID" >> ` + filepath.Join(dir, "sprint"),
+			},
+		},
+		Log: logger.New(false),
+	}
+
+	post := func(body []byte, sig string) int {
+		req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body))
+		if sig != "" {
+			req.Header.Set(SignatureHeader, sig)
+		}
+		rec := httptest.NewRecorder()
+		s.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	updated, _ := loadEvent(t, "issue_updated")
+	created, _ := loadEvent(t, "issue_created")
+	started, _ := loadEvent(t, "sprint_started")
+
+	assert.Equal(t, http.StatusUnauthorized, post(updated, ""))
+	assert.Equal(t, http.StatusUnauthorized, post(updated, Sign("wrong", updated)))
+	assert.Equal(t, http.StatusBadRequest, post([]byte(`{}`), Sign("s3cret", []byte(`{}`))))
+
+	assert.Equal(t, http.StatusAccepted, post(created, Sign("s3cret", created)))
+	assert.Equal(t, http.StatusAccepted, post(started, Sign("s3cret", started)))
+	s.Wait()
+
+	_, err := os.Stat(out)
+	assert.True(t, os.IsNotExist(err))
+
+	b, err := os.ReadFile(filepath.Join(dir, "sprint"))
+	assert.NoError(t, err)
+	assert.Equal(t, "sprint_started 57\n", string(b))
+
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
+
+func TestServerRunsHandlerWithEventOnStdin(t *testing.T) {
+	out := filepath.Join(t.TempDir(), "out")
+
+	s := &Server{
+		Handlers: []Handler{{
+			Name:  "done",
+			Match: map[string]string{"changed": "status", "status": "Done"},
+			Run:   `printf '%s %s ' "$JIRA_WEBHOOK_EVENT" "$JIRA_ISSUE_KEY" > ` + out + ` && wc -c >> ` + out,
+		}},
+		Log: logger.New(false),
+	}
+
+	body, _ := loadEvent(t, "issue_updated")
+
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body)))
+	assert.Equal(t, http.StatusAccepted, rec.Code)
+	s.Wait()
+
+	b, err := os.ReadFile(out)
+	assert.NoError(t, err)
+
+	var size int
+	var event, key string
+	_, err = fmt.Sscan(string(b), &event, &key, &size)
+	assert.NoError(t, err)
+	assert.Equal(t, jira.WebhookIssueUpdated, event)
+	assert.Equal(t, "ABC-42", key)
+	assert.Equal(t, len(body), size)
+}
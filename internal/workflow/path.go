@@ -0,0 +1,176 @@
+package workflow
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	graphDirPerm  = 0o700
+	graphFilePerm = 0o600
+)
+
+// Hop is a transition from one status to another.
+type Hop struct {
+	Transition string `json:"transition"`
+	From       string `json:"from"`
+	To         string `json:"to"`
+	Category   string `json:"category,omitempty"`
+}
+
+// Graph holds transitions observed between statuses of a workflow keyed by status name.
+//
+// Jira only returns transitions available from the current status of an issue,
+// so the graph is learned as issues are moved and persisted between runs.
+type Graph map[string][]Hop
+
+// Learn records transitions available from the given status.
+func (g Graph) Learn(status string, transitions []*jira.Transition) {
+	hops := make([]Hop, 0, len(transitions))
+	for _, t := range transitions {
+		if t.To == nil || t.To.Name == "" {
+			continue
+		}
+		hops = append(hops, Hop{
+			Transition: t.Name,
+			From:       status,
+			To:         t.To.Name,
+			Category:   t.To.StatusCategory.Key,
+		})
+	}
+	g[statusKey(status)] = hops
+}
+
+// Path returns the shortest known path from a status to the target, where target
+// is either the name of a status or of the last transition. An empty path means that
+// the issue is already in the target status. It returns false if no path is known.
+func (g Graph) Path(from, target string) ([]Hop, bool) {
+	if strings.EqualFold(from, target) {
+		return []Hop{}, true
+	}
+
+	type node struct {
+		status string
+		path   []Hop
+	}
+
+	visited := map[string]bool{statusKey(from): true}
+	queue := []node{{status: from}}
+
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+
+		for _, h := range g[statusKey(n.status)] {
+			path := append(append([]Hop{}, n.path...), h)
+			if h.Matches(target) {
+				return path, true
+			}
+			if visited[statusKey(h.To)] {
+				continue
+			}
+			visited[statusKey(h.To)] = true
+			queue = append(queue, node{status: h.To, path: path})
+		}
+	}
+	return nil, false
+}
+
+// Matches checks if the hop leads to the target status or is the target transition.
+func (h Hop) Matches(target string) bool {
+	return strings.EqualFold(h.To, target) || strings.EqualFold(h.Transition, target)
+}
+
+// Matches checks if the transition leads to the target status or is the target transition.
+func Matches(t *jira.Transition, target string) bool {
+	if strings.EqualFold(t.Name, target) {
+		return true
+	}
+	return t.To != nil && strings.EqualFold(t.To.Name, target)
+}
+
+// Explore picks a transition to try when no known path leads to the target.
+//
+// Only transitions to statuses that were not visited yet are considered. In progress
+// statuses are preferred since intermediate steps of a workflow usually belong to that
+// category, then new statuses and done statuses are tried last as they tend to be final.
+func Explore(transitions []*jira.Transition, visited map[string]bool) (*jira.Transition, bool) {
+	rank := map[string]int{"indeterminate": 0, "new": 1, "done": 2}
+
+	var (
+		best     *jira.Transition
+		bestRank int
+	)
+	for _, t := range transitions {
+		if t.To == nil || visited[statusKey(t.To.Name)] {
+			continue
+		}
+		r, ok := rank[t.To.StatusCategory.Key]
+		if !ok {
+			r = len(rank)
+		}
+		if best == nil || r < bestRank {
+			best, bestRank = t, r
+		}
+	}
+	return best, best != nil
+}
+
+// Graphs holds learned graphs of workflows keyed by scope, see GraphScope.
+type Graphs map[string]Graph
+
+// GraphScope returns the key of the workflow used by issues of a project and type.
+func GraphScope(project, issueType string) string {
+	return strings.ToLower(project + "/" + issueType)
+}
+
+// Get returns the graph for the scope creating it if needed.
+func (g Graphs) Get(scope string) Graph {
+	if g[scope] == nil {
+		g[scope] = Graph{}
+	}
+	return g[scope]
+}
+
+// LoadGraphs reads learned graphs from a file. A missing file is not an error.
+func LoadGraphs(path string) (Graphs, error) {
+	b, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return Graphs{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	out := Graphs{}
+	if err := json.Unmarshal(b, &out); err != nil {
+		// A corrupt file only loses learned paths, start over.
+		return Graphs{}, nil
+	}
+	return out, nil
+}
+
+// Save writes learned graphs to a file.
+func (g Graphs) Save(path string) error {
+	b, err := json.Marshal(g)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), graphDirPerm); err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, b, graphFilePerm); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+func statusKey(status string) string {
+	return strings.ToLower(status)
+}
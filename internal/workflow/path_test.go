@@ -0,0 +1,101 @@
+package workflow
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func categorized(name, status, category string) *jira.Transition {
+	return &jira.Transition{
+		Name: name,
+		To:   &jira.TransitionStatus{Name: status, StatusCategory: jira.StatusCategory{Key: category}},
+	}
+}
+
+func TestGraphPath(t *testing.T) {
+	t.Parallel()
+
+	g := Graph{}
+	g.Learn("Backlog", []*jira.Transition{
+		categorized("Select", "Selected", "new"),
+		categorized("Won't Do", "Closed", "done"),
+	})
+	g.Learn("Selected", []*jira.Transition{
+		categorized("Start", "In Progress", "indeterminate"),
+		categorized("Back", "Backlog", "new"),
+	})
+	g.Learn("In Progress", []*jira.Transition{
+		categorized("Review", "In Review", "indeterminate"),
+	})
+	g.Learn("In Review", []*jira.Transition{
+		categorized("Resolve", "Done", "done"),
+		categorized("Reject", "In Progress", "indeterminate"),
+	})
+
+	path, ok := g.Path("backlog", "Done")
+	assert.True(t, ok)
+
+	var names []string
+	for _, h := range path {
+		names = append(names, h.Transition)
+	}
+	assert.Equal(t, []string{"Select", "Start", "Review", "Resolve"}, names)
+	assert.Equal(t, "Backlog", path[0].From)
+
+	path, ok = g.Path("In Progress", "reject")
+	assert.True(t, ok)
+	assert.Len(t, path, 2)
+
+	path, ok = g.Path("Done", "done")
+	assert.True(t, ok)
+	assert.Empty(t, path)
+
+	_, ok = g.Path("Done", "Backlog")
+	assert.False(t, ok)
+}
+
+func TestExplore(t *testing.T) {
+	t.Parallel()
+
+	transitions := []*jira.Transition{
+		categorized("Won't Do", "Closed", "done"),
+		categorized("Back", "Backlog", "new"),
+		categorized("Start", "In Progress", "indeterminate"),
+		{Name: "Unknown"},
+	}
+
+	tr, ok := Explore(transitions, map[string]bool{})
+	assert.True(t, ok)
+	assert.Equal(t, "Start", tr.Name)
+
+	tr, ok = Explore(transitions, map[string]bool{"in progress": true})
+	assert.True(t, ok)
+	assert.Equal(t, "Back", tr.Name)
+
+	_, ok = Explore(transitions, map[string]bool{"in progress": true, "backlog": true, "closed": true})
+	assert.False(t, ok)
+}
+
+func TestGraphsSaveAndLoad(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "jira-cli", "transitions.json")
+
+	graphs, err := LoadGraphs(path)
+	assert.NoError(t, err)
+	assert.Empty(t, graphs)
+
+	graphs.Get(GraphScope("TEST", "Story")).Learn("To Do", []*jira.Transition{
+		categorized("Start", "In Progress", "indeterminate"),
+	})
+	assert.NoError(t, graphs.Save(path))
+
+	loaded, err := LoadGraphs(path)
+	assert.NoError(t, err)
+	assert.Equal(t, graphs, loaded)
+	assert.Contains(t, loaded, "test/story")
+}
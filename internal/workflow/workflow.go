@@ -0,0 +1,273 @@
+// Package workflow maps semantic issue states to the status and transition
+// names used by a Jira workflow.
+package workflow
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// State is a semantic issue state.
+type State string
+
+// Supported states.
+const (
+	Todo    State = "todo"
+	Doing   State = "doing"
+	Review  State = "review"
+	Done    State = "done"
+	Blocked State = "blocked"
+)
+
+// States lists supported states in workflow order.
+var States = []State{Todo, Doing, Review, Done, Blocked}
+
+// ErrNoTransition is returned if none of the transitions moves an issue to the state.
+var ErrNoTransition = errors.New("no transition found")
+
+// Mapping maps a state to a workflow.
+type Mapping struct {
+	// Statuses are the names of statuses that belong to the state.
+	Statuses []string `mapstructure:"statuses"`
+	// Transitions are the names of transitions that move an issue to the state in order of preference.
+	Transitions []string `mapstructure:"transitions"`
+	// Category is the status category of the state, eg: To Do, In Progress or Done.
+	Category string `mapstructure:"category"`
+}
+
+// Workflow maps states to statuses and transitions.
+type Workflow map[State]Mapping
+
+// Default returns the mapping used if the config doesn't define one.
+func Default() Workflow {
+	return Workflow{
+		Todo: {
+			Statuses:    []string{"To Do"},
+			Transitions: []string{"To Do", "Stop Progress", "Reopen"},
+			Category:    "To Do",
+		},
+		Doing: {
+			Statuses:    []string{"In Progress"},
+			Transitions: []string{"In Progress", "Start Progress", "Start"},
+			Category:    "In Progress",
+		},
+		Review: {
+			Statuses:    []string{"In Review"},
+			Transitions: []string{"In Review", "Review", "Ready for Review"},
+		},
+		Done: {
+			Statuses:    []string{"Done"},
+			Transitions: []string{"Done", "Close", "Resolve"},
+			Category:    "Done",
+		},
+		Blocked: {
+			Statuses:    []string{"Blocked"},
+			Transitions: []string{"Blocked", "Block"},
+		},
+	}
+}
+
+// New returns the default workflow with the given states overridden.
+// Fields that are not set keep their default value.
+func New(overrides map[string]Mapping) (Workflow, error) {
+	w := Default()
+	for name, m := range overrides {
+		s := State(strings.ToLower(name))
+		if !slices.Contains(States, s) {
+			return nil, fmt.Errorf("unknown workflow state %q, valid states are: %s", name, joinStates())
+		}
+
+		d := w[s]
+		if len(m.Statuses) > 0 {
+			d.Statuses = m.Statuses
+		}
+		if len(m.Transitions) > 0 {
+			d.Transitions = m.Transitions
+		}
+		if m.Category != "" {
+			d.Category = m.Category
+		}
+		w[s] = d
+	}
+	return w, nil
+}
+
+// FromConfig returns the workflow defined in the `workflow` section of the config.
+func FromConfig() (Workflow, error) {
+	var overrides map[string]Mapping
+	if err := viper.UnmarshalKey("workflow", &overrides); err != nil {
+		return nil, fmt.Errorf("invalid workflow config: %w", err)
+	}
+	return New(overrides)
+}
+
+// Transition finds the transition that moves an issue to the given state.
+//
+// Transitions are matched by name first, then by target status. The status
+// category of the target status is used only if a single transition leads to
+// the category since, eg: both Done and Won't Do statuses are in the Done category.
+func (w Workflow) Transition(s State, transitions []*jira.Transition) (*jira.Transition, error) {
+	m := w[s]
+
+	for _, name := range m.Transitions {
+		for _, t := range transitions {
+			if strings.EqualFold(t.Name, name) {
+				return t, nil
+			}
+		}
+	}
+	for _, t := range transitions {
+		if t.To != nil && containsFold(m.Statuses, t.To.Name) {
+			return t, nil
+		}
+	}
+	if m.Category == "" {
+		return nil, ErrNoTransition
+	}
+
+	var candidates []*jira.Transition
+	for _, t := range transitions {
+		if t.To != nil && m.matchCategory(t.To.StatusCategory) {
+			candidates = append(candidates, t)
+		}
+	}
+	switch len(candidates) {
+	case 0:
+		return nil, ErrNoTransition
+	case 1:
+		return candidates[0], nil
+	}
+	return nil, fmt.Errorf(
+		"transitions %s all lead to %q category, set workflow.%s.transitions in the config to pick one",
+		transitionNames(candidates), m.Category, s,
+	)
+}
+
+// Move transitions an issue to the given state and returns the transition used.
+func (w Workflow) Move(client *jira.Client, key string, s State) (*jira.Transition, error) {
+	transitions, err := api.ProxyTransitions(client, key)
+	if err != nil {
+		return nil, err
+	}
+
+	tr, err := w.Transition(s, transitions)
+	if errors.Is(err, ErrNoTransition) {
+		return nil, fmt.Errorf(
+			"no transition to %q state found for issue %s, set workflow.%s.transitions in the config to one of: %s",
+			s, key, s, transitionNames(transitions),
+		)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to move issue %s to %q state: %w", key, s, err)
+	}
+
+	_, err = client.Transition(key, &jira.TransitionRequest{
+		Transition: &jira.TransitionRequestData{
+			ID:   tr.ID.String(),
+			Name: tr.Name,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tr, nil
+}
+
+// StateOf returns the state a status belongs to.
+func (w Workflow) StateOf(status string) (State, bool) {
+	for _, s := range States {
+		if containsFold(w[s].Statuses, status) {
+			return s, true
+		}
+	}
+	return "", false
+}
+
+// StateOfTransition returns the state a transition moves an issue to.
+func (w Workflow) StateOfTransition(t *jira.Transition) (State, bool) {
+	for _, s := range States {
+		if containsFold(w[s].Transitions, t.Name) {
+			return s, true
+		}
+	}
+	if t.To != nil {
+		return w.StateOf(t.To.Name)
+	}
+	return "", false
+}
+
+// Sort orders transitions by the state they move an issue to following the workflow order.
+// Transitions that don't belong to any state are kept at the end in their original order.
+func (w Workflow) Sort(transitions []*jira.Transition) []*jira.Transition {
+	rank := func(t *jira.Transition) int {
+		s, ok := w.StateOfTransition(t)
+		if !ok {
+			return len(States)
+		}
+		return slices.Index(States, s)
+	}
+
+	out := slices.Clone(transitions)
+	slices.SortStableFunc(out, func(a, b *jira.Transition) int {
+		return rank(a) - rank(b)
+	})
+	return out
+}
+
+// JQL returns a JQL condition that matches issues in the given state.
+//
+// Status names are used if configured, otherwise the status category.
+// It returns an empty string if the state has neither.
+func (w Workflow) JQL(s State) string {
+	m := w[s]
+
+	quoted := make([]string, 0, len(m.Statuses))
+	for _, st := range m.Statuses {
+		if st = strings.TrimSpace(st); st != "" {
+			quoted = append(quoted, fmt.Sprintf("%q", st))
+		}
+	}
+
+	switch {
+	case len(quoted) == 1:
+		return "status = " + quoted[0]
+	case len(quoted) > 1:
+		return fmt.Sprintf("status IN (%s)", strings.Join(quoted, ", "))
+	case m.Category != "":
+		return fmt.Sprintf("statusCategory = %q", m.Category)
+	}
+	return ""
+}
+
+func (m Mapping) matchCategory(c jira.StatusCategory) bool {
+	return strings.EqualFold(m.Category, c.Name) || strings.EqualFold(m.Category, c.Key)
+}
+
+func containsFold(list []string, v string) bool {
+	return slices.ContainsFunc(list, func(s string) bool {
+		return strings.EqualFold(s, v)
+	})
+}
+
+func transitionNames(transitions []*jira.Transition) string {
+	names := make([]string, 0, len(transitions))
+	for _, t := range transitions {
+		names = append(names, fmt.Sprintf("%q", t.Name))
+	}
+	return strings.Join(names, ", ")
+}
+
+func joinStates() string {
+	names := make([]string, 0, len(States))
+	for _, s := range States {
+		names = append(names, string(s))
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,127 @@
+package workflow
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func transition(name, status, category string) *jira.Transition {
+	t := &jira.Transition{Name: name}
+	if status != "" {
+		t.To = &jira.TransitionStatus{Name: status, StatusCategory: jira.StatusCategory{Name: category}}
+	}
+	return t
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	w, err := New(map[string]Mapping{
+		"Doing": {Transitions: []string{"Begin"}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, Mapping{
+		Statuses:    []string{"In Progress"},
+		Transitions: []string{"Begin"},
+		Category:    "In Progress",
+	}, w[Doing])
+	assert.Equal(t, Default()[Done], w[Done])
+
+	_, err = New(map[string]Mapping{"wip": {}})
+	assert.EqualError(t, err, `unknown workflow state "wip", valid states are: todo, doing, review, done, blocked`)
+}
+
+func TestTransition(t *testing.T) {
+	t.Parallel()
+
+	w, err := New(map[string]Mapping{
+		"doing":  {Transitions: []string{"Begin", "Pick up"}},
+		"review": {Statuses: []string{"Code Review"}},
+	})
+	assert.NoError(t, err)
+
+	transitions := []*jira.Transition{
+		transition("Pick up", "Working", "In Progress"),
+		transition("Begin", "Working", "In Progress"),
+		transition("Send", "Code Review", "In Progress"),
+		transition("Finish", "Closed", "Done"),
+	}
+
+	got, err := w.Transition(Doing, transitions)
+	assert.NoError(t, err)
+	assert.Equal(t, "Begin", got.Name)
+
+	got, err = w.Transition(Review, transitions)
+	assert.NoError(t, err)
+	assert.Equal(t, "Send", got.Name)
+
+	got, err = w.Transition(Done, transitions)
+	assert.NoError(t, err)
+	assert.Equal(t, "Finish", got.Name)
+
+	_, err = w.Transition(Blocked, transitions)
+	assert.ErrorIs(t, err, ErrNoTransition)
+}
+
+func TestTransitionAmbiguousCategory(t *testing.T) {
+	t.Parallel()
+
+	transitions := []*jira.Transition{
+		transition("Reject", "Rejected", "Done"),
+		transition("Won't Do", "Won't Do", "Done"),
+		transition("Finish", "Closed", "Done"),
+	}
+
+	_, err := Default().Transition(Done, transitions)
+	assert.EqualError(t, err, `transitions "Reject", "Won't Do", "Finish" all lead to "Done" category, set workflow.done.transitions in the config to pick one`)
+
+	transitions = append(transitions, transition("Complete", "Done", "Done"))
+
+	got, err := Default().Transition(Done, transitions)
+	assert.NoError(t, err)
+	assert.Equal(t, "Complete", got.Name)
+}
+
+func TestSort(t *testing.T) {
+	t.Parallel()
+
+	transitions := []*jira.Transition{
+		transition("Archive", "", ""),
+		transition("Done", "", ""),
+		transition("Start Progress", "", ""),
+		transition("Back", "To Do", "To Do"),
+	}
+
+	var names []string
+	for _, tr := range Default().Sort(transitions) {
+		names = append(names, tr.Name)
+	}
+	assert.Equal(t, []string{"Back", "Start Progress", "Done", "Archive"}, names)
+}
+
+func TestJQL(t *testing.T) {
+	t.Parallel()
+
+	w, err := New(map[string]Mapping{
+		"done": {Statuses: []string{"Done", "Won't Do"}},
+	})
+	assert.NoError(t, err)
+	w[Review] = Mapping{Category: "In Progress"}
+
+	assert.Equal(t, `status = "To Do"`, w.JQL(Todo))
+	assert.Equal(t, `status IN ("Done", "Won't Do")`, w.JQL(Done))
+	assert.Equal(t, `statusCategory = "In Progress"`, w.JQL(Review))
+
+	w[Blocked] = Mapping{Statuses: []string{""}}
+	assert.Equal(t, "", w.JQL(Blocked))
+
+	w[Blocked] = Mapping{Statuses: []string{" ", "Blocked"}}
+	assert.Equal(t, `status = "Blocked"`, w.JQL(Blocked))
+
+	s, ok := w.StateOf("won't do")
+	assert.True(t, ok)
+	assert.Equal(t, Done, s)
+}
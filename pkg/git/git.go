@@ -0,0 +1,162 @@
+// Package git is a thin wrapper around the git executable.
+package git
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+const (
+	// DefaultBranchPattern is the default pattern used to generate branch names.
+	DefaultBranchPattern = "{key}-{summary}"
+
+	maxSlugLength = 50
+)
+
+// ErrNotRepository is returned if the command is not run inside a git repository.
+var ErrNotRepository = fmt.Errorf("git: not a git repository")
+
+// issueKeyRegex matches upper case issue keys only so that words
+// like utf-8 or sha-256 are not mistaken for issue keys.
+var issueKeyRegex = regexp.MustCompile(`\b([A-Z][A-Z0-9_]+-\d+)\b`)
+
+// CurrentBranch returns name of the currently checked out branch.
+func CurrentBranch() (string, error) {
+	return run("rev-parse", "--abbrev-ref", "HEAD")
+}
+
+// BranchExists checks if a local branch exists.
+func BranchExists(name string) bool {
+	_, err := run("rev-parse", "--verify", "--quiet", "refs/heads/"+name)
+	return err == nil
+}
+
+// CreateBranch creates a new branch from base and checks it out.
+// The current HEAD is used if base is empty.
+func CreateBranch(name, base string) error {
+	args := []string{"checkout", "-b", name}
+	if base != "" {
+		args = append(args, base)
+	}
+	_, err := run(args...)
+	return err
+}
+
+// Checkout checks out an existing branch.
+func Checkout(name string) error {
+	_, err := run("checkout", name)
+	return err
+}
+
+// Slugify converts a text to a lower case, dash separated slug
+// that is safe to be used in a branch name.
+func Slugify(s string) string {
+	var b strings.Builder
+
+	dash := false
+	for _, r := range strings.ToLower(s) {
+		if r <= unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			b.WriteRune(r)
+			dash = false
+			continue
+		}
+		if !dash && b.Len() > 0 {
+			b.WriteRune('-')
+			dash = true
+		}
+	}
+
+	slug := strings.TrimRight(b.String(), "-")
+	if len(slug) > maxSlugLength {
+		slug = slug[:maxSlugLength]
+		if i := strings.LastIndex(slug, "-"); i > 0 {
+			slug = slug[:i]
+		}
+	}
+	return slug
+}
+
+// FormatBranch generates a branch name from the given pattern.
+//
+// Supported placeholders are {key}, {summary} and {type}.
+func FormatBranch(pattern, key, summary, issueType string) string {
+	if pattern == "" {
+		pattern = DefaultBranchPattern
+	}
+	r := strings.NewReplacer(
+		"{key}", key,
+		"{summary}", Slugify(summary),
+		"{type}", Slugify(issueType),
+	)
+	return strings.Trim(r.Replace(pattern), "-/")
+}
+
+// IssueKeys extracts all issue keys referenced in a text
+// in the order they appear.
+func IssueKeys(s string) []string {
+	return findKeys(issueKeyRegex, s)
+}
+
+// ProjectIssueKeys extracts keys of the given project referenced in a text.
+// Unlike IssueKeys, the project key is matched case-insensitively and the
+// keys are upper-cased.
+func ProjectIssueKeys(s, project string) []string {
+	re := regexp.MustCompile(`(?i)\b(` + regexp.QuoteMeta(project) + `-\d+)\b`)
+	return findKeys(re, s)
+}
+
+func findKeys(re *regexp.Regexp, s string) []string {
+	var (
+		keys []string
+		seen = make(map[string]struct{})
+	)
+	for _, m := range re.FindAllStringSubmatch(s, -1) {
+		k := strings.ToUpper(m[1])
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// IssueKeyFromBranch extracts issue key from a branch name.
+// Keys of the given project are preferred and matched in any case
+// if the project is not empty, eg: feature/test-12-fix-login.
+func IssueKeyFromBranch(branch, project string) string {
+	branch = strings.ReplaceAll(branch, "/", " ")
+	if project != "" {
+		if keys := ProjectIssueKeys(branch, project); len(keys) > 0 {
+			return keys[0]
+		}
+	}
+	if keys := IssueKeys(branch); len(keys) > 0 {
+		return keys[0]
+	}
+	return ""
+}
+
+func run(args ...string) (string, error) {
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.Command("git", args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		msg := strings.TrimSpace(stderr.String())
+		if strings.Contains(msg, "not a git repository") {
+			return "", ErrNotRepository
+		}
+		if msg == "" {
+			return "", err
+		}
+		return "", fmt.Errorf("git: %s", msg)
+	}
+	return strings.TrimSpace(stdout.String()), nil
+}
@@ -0,0 +1,76 @@
+package git
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlugify(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		input    string
+		expected string
+	}{
+		{"Fix login crash", "fix-login-crash"},
+		{"  [API] Return 404 for missing users!  ", "api-return-404-for-missing-users"},
+		{"Ünïcode — and/or slashes", "n-code-and-or-slashes"},
+		{"a very long summary that goes on and on and on until it is way too long", "a-very-long-summary-that-goes-on-and-on-and-on"},
+		{"", ""},
+	}
+
+	for _, tc := range cases {
+		assert.Equal(t, tc.expected, Slugify(tc.input))
+	}
+}
+
+func TestFormatBranch(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "TEST-1-fix-login-crash", FormatBranch("", "TEST-1", "Fix login crash", "Bug"))
+	assert.Equal(t, "bug/TEST-1-fix-login-crash", FormatBranch("{type}/{key}-{summary}", "TEST-1", "Fix login crash", "Bug"))
+	assert.Equal(t, "TEST-1", FormatBranch("{key}-{summary}", "TEST-1", "", "Bug"))
+}
+
+func TestIssueKeyFromBranch(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		branch   string
+		project  string
+		expected string
+	}{
+		{"TEST-12-fix-login", "", "TEST-12"},
+		{"feature/test-12-fix-login", "TEST", "TEST-12"},
+		{"feature/test-12-fix-login", "", ""},
+		{"bugfix/TEST-7", "TEST", "TEST-7"},
+		{"bugfix/ABC-7", "TEST", "ABC-7"},
+		{"release-2-TEST-7-hotfix", "TEST", "TEST-7"},
+		{"release-2-hotfix", "TEST", ""},
+		{"fix/utf-8-decoding", "", ""},
+		{"main", "TEST", ""},
+	}
+
+	for _, tc := range cases {
+		assert.Equal(t, tc.expected, IssueKeyFromBranch(tc.branch, tc.project), tc.branch)
+	}
+}
+
+func TestIssueKeys(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []string{"TEST-1", "ABC-22"}, IssueKeys("TEST-1 fix crash, see ABC-22 and TEST-1"))
+	assert.Nil(t, IssueKeys("no keys here"))
+
+	for _, s := range []string{"utf-8", "sha-256", "node-18", "python-3", "Python-3", "X-1"} {
+		assert.Nil(t, IssueKeys("upgrade to "+s), s)
+	}
+}
+
+func TestProjectIssueKeys(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []string{"TEST-1", "TEST-22"}, ProjectIssueKeys("TEST-1 fix crash, see test-22 and ABC-3", "TEST"))
+	assert.Nil(t, ProjectIssueKeys("bump node-18 and sha-256", "TEST"))
+}
@@ -0,0 +1,84 @@
+package git
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	hookPerm = 0o755
+	dirPerm  = 0o755
+
+	scissors = "# ------------------------ >8 ------------------------"
+)
+
+// ErrHookExists is returned if a hook that is not managed by the tool already exists.
+var ErrHookExists = errors.New("git: hook already exists")
+
+// HooksDir returns path of the git hooks directory of the current repository.
+func HooksDir() (string, error) {
+	dir, err := run("rev-parse", "--git-path", "hooks")
+	if err != nil {
+		return "", err
+	}
+	return filepath.Abs(dir)
+}
+
+// InstallHook writes a hook script to the hooks directory and returns its path.
+//
+// Existing hooks are only overwritten if they contain the marker or force is true.
+func InstallHook(name, script, marker string, force bool) (string, error) {
+	dir, err := HooksDir()
+	if err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, name)
+
+	existing, err := os.ReadFile(path)
+	if err == nil && !force && !strings.Contains(string(existing), marker) {
+		return path, fmt.Errorf("%w: %s", ErrHookExists, path)
+	}
+	if err := os.MkdirAll(dir, dirPerm); err != nil {
+		return "", err
+	}
+	return path, os.WriteFile(path, []byte(script), hookPerm)
+}
+
+// CleanMessage strips comments and the verbose diff that git adds to the commit message file.
+func CleanMessage(msg string) string {
+	if i := strings.Index(msg, scissors); i >= 0 {
+		msg = msg[:i]
+	}
+
+	lines := strings.Split(msg, "\n")
+	out := make([]string, 0, len(lines))
+	for _, l := range lines {
+		// Lines like "#done" are smart commit directives, not comments.
+		if l == "#" || strings.HasPrefix(l, "# ") || strings.HasPrefix(l, "#\t") {
+			continue
+		}
+		out = append(out, l)
+	}
+	return strings.TrimSpace(strings.Join(out, "\n"))
+}
+
+// IsGeneratedMessage checks if the message is generated by git, eg: merges, reverts and fixups.
+func IsGeneratedMessage(msg string) bool {
+	for _, p := range []string{"Merge ", "Revert \"", "fixup! ", "squash! ", "amend! "} {
+		if strings.HasPrefix(msg, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// PrefixSubject prefixes the subject line of the message with the issue key.
+func PrefixSubject(msg, key string) string {
+	if strings.TrimSpace(msg) == "" {
+		return key
+	}
+	return key + " " + msg
+}
@@ -0,0 +1,38 @@
+package git
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCleanMessage(t *testing.T) {
+	t.Parallel()
+
+	msg := `TEST-1 fix login crash
+
+#done
+# Please enter the commit message for your changes. Lines starting
+# with '#' will be ignored.
+#
+# ------------------------ >8 ------------------------
+diff --git a/main.go b/main.go
+`
+	assert.Equal(t, "TEST-1 fix login crash\n\n#done", CleanMessage(msg))
+}
+
+func TestIsGeneratedMessage(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, IsGeneratedMessage("Merge branch 'main' into TEST-1-fix"))
+	assert.True(t, IsGeneratedMessage(`Revert "TEST-1 fix login crash"`))
+	assert.True(t, IsGeneratedMessage("fixup! TEST-1 fix login crash"))
+	assert.False(t, IsGeneratedMessage("fix login crash"))
+}
+
+func TestPrefixSubject(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "TEST-1 fix login crash\n\nbody", PrefixSubject("fix login crash\n\nbody", "TEST-1"))
+	assert.Equal(t, "TEST-1", PrefixSubject("", "TEST-1"))
+}
@@ -0,0 +1,63 @@
+package git
+
+import (
+	"strings"
+	"time"
+)
+
+const (
+	fieldSeparator  = "\x1f"
+	recordSeparator = "\x1e"
+	logFields       = 5
+)
+
+// Commit holds git commit info.
+type Commit struct {
+	SHA         string
+	AuthorName  string
+	AuthorEmail string
+	Date        time.Time
+	Message     string
+}
+
+// Log returns commits in the given revision range in chronological order.
+// Commits reachable from HEAD are returned if the range is empty.
+func Log(rng string) ([]Commit, error) {
+	format := strings.Join([]string{"%H", "%an", "%ae", "%aI", "%B"}, fieldSeparator) + recordSeparator
+
+	args := []string{"log", "--reverse", "--format=" + format}
+	if rng != "" {
+		args = append(args, rng)
+	}
+	out, err := run(args...)
+	if err != nil {
+		return nil, err
+	}
+	return parseLog(out), nil
+}
+
+func parseLog(out string) []Commit {
+	records := strings.Split(out, recordSeparator)
+	commits := make([]Commit, 0, len(records))
+
+	for _, rec := range records {
+		rec = strings.TrimLeft(rec, "\n")
+		if rec == "" {
+			continue
+		}
+		parts := strings.SplitN(rec, fieldSeparator, logFields)
+		if len(parts) != logFields {
+			continue
+		}
+		date, _ := time.Parse(time.RFC3339, parts[3])
+
+		commits = append(commits, Commit{
+			SHA:         parts[0],
+			AuthorName:  parts[1],
+			AuthorEmail: parts[2],
+			Date:        date,
+			Message:     strings.TrimSpace(parts[4]),
+		})
+	}
+	return commits
+}
@@ -0,0 +1,100 @@
+package git
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Smart commit commands.
+const (
+	SmartCommandComment    = "comment"
+	SmartCommandTime       = "time"
+	SmartCommandTransition = "transition"
+)
+
+var (
+	smartCommandRegex = regexp.MustCompile(`(?:^|\s)#([a-zA-Z][a-zA-Z0-9_-]*)`)
+	durationRegex     = regexp.MustCompile(`^\d+(\.\d+)?[wdhm]$`)
+)
+
+// SmartCommit is a single smart commit directive.
+//
+// See https://support.atlassian.com/jira-software-cloud/docs/process-issues-with-smart-commits/
+type SmartCommit struct {
+	Keys []string
+	// Command is one of comment, time or transition.
+	Command string
+	// Comment is the comment text of the directive, if any.
+	Comment string
+	// TimeSpent is the time to log for the time command, eg: 1h 30m.
+	TimeSpent string
+	// Transition is the transition name for the transition command, eg: in progress.
+	Transition string
+}
+
+// ParseSmartCommits parses smart commit directives from a commit message.
+//
+// Directives apply to the issue keys referenced on the same line before the first
+// command. If a line doesn't reference any issue, keys from the subject line are used.
+func ParseSmartCommits(message string) []SmartCommit {
+	var (
+		out         []SmartCommit
+		subjectKeys []string
+	)
+
+	for i, line := range strings.Split(message, "\n") {
+		matches := smartCommandRegex.FindAllStringSubmatchIndex(line, -1)
+
+		prefix := line
+		if len(matches) > 0 {
+			prefix = line[:matches[0][0]]
+		}
+		keys := IssueKeys(prefix)
+		if i == 0 {
+			subjectKeys = keys
+		}
+		if len(keys) == 0 {
+			keys = subjectKeys
+		}
+		if len(matches) == 0 || len(keys) == 0 {
+			continue
+		}
+
+		for j, m := range matches {
+			end := len(line)
+			if j+1 < len(matches) {
+				end = matches[j+1][0]
+			}
+			cmd := strings.ToLower(line[m[2]:m[3]])
+			args := strings.TrimSpace(line[m[1]:end])
+
+			out = append(out, newSmartCommit(keys, cmd, args))
+		}
+	}
+	return out
+}
+
+func newSmartCommit(keys []string, cmd, args string) SmartCommit {
+	sc := SmartCommit{Keys: keys}
+
+	switch cmd {
+	case SmartCommandComment:
+		sc.Command = SmartCommandComment
+		sc.Comment = args
+	case SmartCommandTime:
+		sc.Command = SmartCommandTime
+
+		fields := strings.Fields(args)
+		n := 0
+		for n < len(fields) && durationRegex.MatchString(fields[n]) {
+			n++
+		}
+		sc.TimeSpent = strings.Join(fields[:n], " ")
+		sc.Comment = strings.Join(fields[n:], " ")
+	default:
+		sc.Command = SmartCommandTransition
+		sc.Transition = strings.ReplaceAll(cmd, "-", " ")
+		sc.Comment = args
+	}
+	return sc
+}
@@ -0,0 +1,94 @@
+package git
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSmartCommits(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		message  string
+		expected []SmartCommit
+	}{
+		{
+			name:     "it returns nothing for a message without commands",
+			message:  "TEST-1 fix login crash",
+			expected: nil,
+		},
+		{
+			name:     "it ignores commands without issue keys",
+			message:  "fix login crash #done",
+			expected: nil,
+		},
+		{
+			name:    "it parses comment",
+			message: "TEST-1 #comment Fixed the login crash",
+			expected: []SmartCommit{
+				{Keys: []string{"TEST-1"}, Command: SmartCommandComment, Comment: "Fixed the login crash"},
+			},
+		},
+		{
+			name:    "it parses time with an optional comment",
+			message: "TEST-1 #time 1w 2d 4h 30m Total work logged",
+			expected: []SmartCommit{
+				{Keys: []string{"TEST-1"}, Command: SmartCommandTime, TimeSpent: "1w 2d 4h 30m", Comment: "Total work logged"},
+			},
+		},
+		{
+			name:    "it parses multiple commands for multiple keys",
+			message: "TEST-1 TEST-2 fix crash #time 2h #in-progress started #comment done for today",
+			expected: []SmartCommit{
+				{Keys: []string{"TEST-1", "TEST-2"}, Command: SmartCommandTime, TimeSpent: "2h"},
+				{Keys: []string{"TEST-1", "TEST-2"}, Command: SmartCommandTransition, Transition: "in progress", Comment: "started"},
+				{Keys: []string{"TEST-1", "TEST-2"}, Command: SmartCommandComment, Comment: "done for today"},
+			},
+		},
+		{
+			name:    "it uses subject keys for body lines without keys",
+			message: "TEST-1 fix login crash\n\nThe session was not refreshed.\n#done\nTEST-9 #comment related",
+			expected: []SmartCommit{
+				{Keys: []string{"TEST-1"}, Command: SmartCommandTransition, Transition: "done"},
+				{Keys: []string{"TEST-9"}, Command: SmartCommandComment, Comment: "related"},
+			},
+		},
+		{
+			name:     "it ignores lower case keys",
+			message:  "bump node-18 #done",
+			expected: nil,
+		},
+		{
+			name:     "it ignores issue numbers and anchors",
+			message:  "TEST-1 see PR #42 and url.com/a#b",
+			expected: nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, ParseSmartCommits(tc.message))
+		})
+	}
+}
+
+func TestParseLog(t *testing.T) {
+	t.Parallel()
+
+	out := "abc123\x1fJane Doe\x1fjane@example.com\x1f2026-01-02T10:00:00+01:00\x1fTEST-1 fix\n\n#done\n\x1e\n" +
+		"def456\x1fJohn Doe\x1fjohn@example.com\x1f2026-01-03T11:00:00Z\x1fTEST-2 add\x1e"
+
+	commits := parseLog(out)
+
+	assert.Len(t, commits, 2)
+	assert.Equal(t, "abc123", commits[0].SHA)
+	assert.Equal(t, "Jane Doe", commits[0].AuthorName)
+	assert.Equal(t, "TEST-1 fix\n\n#done", commits[0].Message)
+	assert.Equal(t, 2026, commits[0].Date.Year())
+	assert.Equal(t, "def456", commits[1].SHA)
+	assert.Equal(t, "TEST-2 add", commits[1].Message)
+}
@@ -0,0 +1,173 @@
+package jira
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUploadAttachmentReader(t *testing.T) {
+	content := strings.Repeat("jira-cli ", 10000)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/issue/TEST-1/attachments", r.URL.Path)
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "no-check", r.Header.Get("X-Atlassian-Token"))
+
+		file, header, err := r.FormFile("file")
+		assert.NoError(t, err)
+		defer func() { _ = file.Close() }()
+
+		got, err := io.ReadAll(file)
+		assert.NoError(t, err)
+		assert.Equal(t, "notes.txt", header.Filename)
+		assert.Equal(t, content, string(got))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintf(w, `[{"id":"10001","filename":"notes.txt","size":%d}]`, len(got))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.UploadAttachmentReader("TEST-1", "notes.txt", strings.NewReader(content))
+	assert.NoError(t, err)
+	assert.Equal(t, []*Attachment{{ID: "10001", Filename: "notes.txt", Size: int64(len(content))}}, actual)
+}
+
+func TestUploadAttachmentReaderError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(413)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	_, err := client.UploadAttachmentReader("TEST-1", "notes.txt", strings.NewReader("content"))
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestGetAttachmentMeta(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/attachment/meta", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{"enabled":true,"uploadLimit":10485760}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.GetAttachmentMeta()
+	assert.NoError(t, err)
+	assert.Equal(t, &AttachmentMeta{Enabled: true, UploadLimit: 10485760}, actual)
+}
+
+func TestDownloadAttachmentFile(t *testing.T) {
+	content := []byte("0123456789abcdefghij")
+
+	var (
+		ranges      []string
+		ignoreRange bool
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/attachment/content/10001", r.URL.Path)
+		ranges = append(ranges, r.Header.Get("Range"))
+
+		var offset int
+		if rng := r.Header.Get("Range"); rng != "" && !ignoreRange {
+			_, err := fmt.Sscanf(rng, "bytes=%d-", &offset)
+			assert.NoError(t, err)
+
+			w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", offset, len(content)-1, len(content)))
+			w.WriteHeader(206)
+			_, _ = w.Write(content[offset:])
+			return
+		}
+
+		w.WriteHeader(200)
+		_, _ = w.Write(content)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+	att := &Attachment{ID: "10001", Filename: "file.txt", Size: int64(len(content))}
+
+	t.Run("fresh download", func(t *testing.T) {
+		ranges = nil
+		path := filepath.Join(t.TempDir(), "file.txt")
+
+		var written int64
+		err := client.DownloadAttachmentFile(att, path, func(n int64) { written += n })
+		assert.NoError(t, err)
+		assert.Equal(t, []string{""}, ranges)
+		assert.Equal(t, int64(len(content)), written)
+
+		got, err := os.ReadFile(path)
+		assert.NoError(t, err)
+		assert.Equal(t, content, got)
+		assert.NoFileExists(t, path+PartialSuffix)
+	})
+
+	t.Run("resumes partial download", func(t *testing.T) {
+		ranges = nil
+		path := filepath.Join(t.TempDir(), "file.txt")
+		assert.NoError(t, os.WriteFile(path+PartialSuffix, content[:8], 0o600))
+
+		var written int64
+		err := client.DownloadAttachmentFile(att, path, func(n int64) { written += n })
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"bytes=8-"}, ranges)
+		assert.Equal(t, int64(len(content)), written)
+
+		got, err := os.ReadFile(path)
+		assert.NoError(t, err)
+		assert.Equal(t, content, got)
+	})
+
+	t.Run("restarts if range is ignored", func(t *testing.T) {
+		ranges, ignoreRange = nil, true
+		defer func() { ignoreRange = false }()
+
+		path := filepath.Join(t.TempDir(), "file.txt")
+		assert.NoError(t, os.WriteFile(path+PartialSuffix, []byte("garbage"), 0o600))
+
+		err := client.DownloadAttachmentFile(att, path, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"bytes=7-"}, ranges)
+
+		got, err := os.ReadFile(path)
+		assert.NoError(t, err)
+		assert.Equal(t, content, got)
+	})
+
+	t.Run("skips complete file", func(t *testing.T) {
+		ranges = nil
+		path := filepath.Join(t.TempDir(), "file.txt")
+		assert.NoError(t, os.WriteFile(path, content, 0o600))
+
+		err := client.DownloadAttachmentFile(att, path, nil)
+		assert.ErrorIs(t, err, ErrAttachmentExists)
+		assert.Empty(t, ranges)
+	})
+
+	t.Run("size mismatch", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "file.txt")
+		bigger := &Attachment{ID: "10001", Size: int64(len(content)) + 1}
+
+		err := client.DownloadAttachmentFile(bigger, path, nil)
+		assert.ErrorIs(t, err, ErrAttachmentCorrupt)
+		assert.NoFileExists(t, path)
+	})
+}
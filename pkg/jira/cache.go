@@ -0,0 +1,411 @@
+package jira
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// CacheStatusHeader is set on responses served from the cache.
+	CacheStatusHeader = "X-Jira-Cli-Cache"
+
+	cacheFileExt  = ".json"
+	cacheDirPerm  = 0o700
+	cacheFilePerm = 0o600
+)
+
+var apiPathRegex = regexp.MustCompile(`^.*?/rest/[^/]+/[^/]+(/.*)$`)
+
+// CacheRule defines how long responses of the matching endpoint are cached.
+type CacheRule struct {
+	Name    string
+	Pattern *regexp.Regexp
+	TTL     time.Duration
+}
+
+// DefaultCacheRules returns cache rules for metadata endpoints that rarely change.
+func DefaultCacheRules() []CacheRule {
+	return []CacheRule{
+		{Name: "fields", Pattern: regexp.MustCompile(`/rest/api/[23]/field$`), TTL: 24 * time.Hour},
+		{Name: "projects", Pattern: regexp.MustCompile(`/rest/api/[23]/project$`), TTL: time.Hour},
+		{Name: "boards", Pattern: regexp.MustCompile(`/rest/agile/1\.0/board$`), TTL: time.Hour},
+		{Name: "link-types", Pattern: regexp.MustCompile(`/rest/api/[23]/issueLinkType$`), TTL: 24 * time.Hour},
+		{Name: "transitions", Pattern: regexp.MustCompile(`/rest/api/[23]/issue/[^/]+/transitions$`), TTL: 5 * time.Minute},
+		{Name: "createmeta", Pattern: regexp.MustCompile(`/rest/api/[23]/issue/createmeta(/.*)?$`), TTL: 24 * time.Hour},
+		{Name: "server-info", Pattern: regexp.MustCompile(`/rest/api/[23]/serverInfo$`), TTL: time.Hour},
+		{Name: "me", Pattern: regexp.MustCompile(`/rest/api/[23]/myself$`), TTL: time.Hour},
+		{Name: "jql", Pattern: regexp.MustCompile(`/rest/api/[23]/jql/autocompletedata$`), TTL: 24 * time.Hour},
+		{Name: "jql-suggestions", Pattern: regexp.MustCompile(`/rest/api/[23]/jql/autocompletedata/suggestions$`), TTL: 10 * time.Minute},
+	}
+}
+
+// CacheEntry is a cached http response.
+type CacheEntry struct {
+	Rule       string      `json:"rule"`
+	URL        string      `json:"url"`
+	Path       string      `json:"path"`
+	StatusCode int         `json:"statusCode"`
+	Header     http.Header `json:"header"`
+	Body       []byte      `json:"body"`
+	StoredAt   time.Time   `json:"storedAt"`
+}
+
+// Cache is a storage for cached responses.
+type Cache interface {
+	// Get returns a cached entry or nil if the key is not cached.
+	Get(key string) (*CacheEntry, error)
+	// Set stores an entry.
+	Set(key string, entry *CacheEntry) error
+	// Delete removes all entries the match func returns true for.
+	Delete(match func(*CacheEntry) bool) error
+}
+
+// DiskCache is a cache that persists responses as files in a directory.
+type DiskCache struct {
+	dir string
+	mu  sync.Mutex
+}
+
+// NewDiskCache constructs a disk cache that stores entries in the given directory.
+func NewDiskCache(dir string) *DiskCache {
+	return &DiskCache{dir: dir}
+}
+
+// Get returns a cached entry or nil if the key is not cached.
+func (d *DiskCache) Get(key string) (*CacheEntry, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	b, err := os.ReadFile(d.path(key))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var e CacheEntry
+	if err := json.Unmarshal(b, &e); err != nil {
+		// A corrupt entry is treated as a miss and overwritten later.
+		return nil, nil
+	}
+	return &e, nil
+}
+
+// Set stores an entry.
+func (d *DiskCache) Set(key string, entry *CacheEntry) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(d.dir, cacheDirPerm); err != nil {
+		return err
+	}
+
+	// Write to a temporary file first so that concurrent readers never see a partial entry.
+	tmp, err := os.CreateTemp(d.dir, "tmp-*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(b); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), cacheFilePerm); err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), d.path(key))
+}
+
+// Delete removes all entries the match func returns true for.
+func (d *DiskCache) Delete(match func(*CacheEntry) bool) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.walk(func(path string, e *CacheEntry) error {
+		if e != nil && !match(e) {
+			return nil
+		}
+		return os.Remove(path)
+	})
+}
+
+// Entries returns all cached entries.
+func (d *DiskCache) Entries() ([]*CacheEntry, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var entries []*CacheEntry
+	err := d.walk(func(_ string, e *CacheEntry) error {
+		if e != nil {
+			entries = append(entries, e)
+		}
+		return nil
+	})
+	return entries, err
+}
+
+// Clear removes all cached entries.
+func (d *DiskCache) Clear() error {
+	return d.Delete(func(*CacheEntry) bool { return true })
+}
+
+func (d *DiskCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(d.dir, hex.EncodeToString(sum[:])+cacheFileExt)
+}
+
+// walk calls fn for each cache file in the directory. Entry is nil if the file is corrupt.
+func (d *DiskCache) walk(fn func(string, *CacheEntry) error) error {
+	files, err := os.ReadDir(d.dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, f := range files {
+		if f.IsDir() || filepath.Ext(f.Name()) != cacheFileExt {
+			continue
+		}
+		path := filepath.Join(d.dir, f.Name())
+
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		var e CacheEntry
+		if err := json.Unmarshal(b, &e); err != nil {
+			err = fn(path, nil)
+		} else {
+			err = fn(path, &e)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// cacheTransport is a http.RoundTripper that caches responses of metadata endpoints.
+//
+// Fresh entries are served without hitting the server. Stale entries are revalidated
+// with a conditional request if the server sent an ETag or Last-Modified header.
+type cacheTransport struct {
+	next  http.RoundTripper
+	store Cache
+	rules []CacheRule
+	now   func() time.Time
+}
+
+func newCacheTransport(next http.RoundTripper, store Cache, rules []CacheRule) *cacheTransport {
+	return &cacheTransport{
+		next:  next,
+		store: store,
+		rules: rules,
+		now:   time.Now,
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *cacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		res, err := t.next.RoundTrip(req)
+		if err == nil && res.StatusCode < http.StatusBadRequest {
+			t.invalidate(req.URL.Path)
+		}
+		return res, err
+	}
+
+	rule := t.match(req.URL.Path)
+	if rule == nil {
+		return t.next.RoundTrip(req)
+	}
+
+	key := cacheKey(req)
+	entry, _ := t.store.Get(key)
+
+	if entry != nil && t.now().Sub(entry.StoredAt) < rule.TTL {
+		return entry.response(req, "hit"), nil
+	}
+
+	if entry != nil {
+		req = req.Clone(req.Context())
+		if etag := entry.Header.Get("ETag"); etag != "" {
+			req.Header.Set("If-None-Match", etag)
+		}
+		if lm := entry.Header.Get("Last-Modified"); lm != "" {
+			req.Header.Set("If-Modified-Since", lm)
+		}
+	}
+
+	res, err := t.next.RoundTrip(req)
+	if err != nil {
+		return res, err
+	}
+
+	if res.StatusCode == http.StatusNotModified && entry != nil {
+		_ = res.Body.Close()
+
+		entry.StoredAt = t.now()
+		_ = t.store.Set(key, entry)
+
+		return entry.response(req, "revalidated"), nil
+	}
+	if res.StatusCode != http.StatusOK || strings.Contains(res.Header.Get("Cache-Control"), "no-store") {
+		return res, nil
+	}
+
+	body, err := io.ReadAll(res.Body)
+	_ = res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(body))
+
+	header := res.Header.Clone()
+	header.Del("Set-Cookie")
+
+	_ = t.store.Set(key, &CacheEntry{
+		Rule:       rule.Name,
+		URL:        req.URL.String(),
+		Path:       req.URL.Path,
+		StatusCode: res.StatusCode,
+		Header:     header,
+		Body:       body,
+		StoredAt:   t.now(),
+	})
+
+	return res, nil
+}
+
+func (t *cacheTransport) match(path string) *CacheRule {
+	for i, r := range t.rules {
+		if r.TTL > 0 && r.Pattern.MatchString(path) {
+			return &t.rules[i]
+		}
+	}
+	return nil
+}
+
+// invalidate removes entries affected by a successful write to the given path.
+//
+// Entries of the same resource, eg: `/issue/KEY` and its sub-resources like
+// `/issue/KEY/transitions`, are removed. Writes to collections like `/issue`
+// only remove the collection itself so that unrelated metadata is kept.
+func (t *cacheTransport) invalidate(path string) {
+	resource := apiPath(path)
+	single := strings.Count(strings.Trim(resource, "/"), "/") > 0
+
+	_ = t.store.Delete(func(e *CacheEntry) bool {
+		p := apiPath(e.Path)
+		return p == resource || (single && strings.HasPrefix(p, resource+"/"))
+	})
+}
+
+func (e *CacheEntry) response(req *http.Request, status string) *http.Response {
+	header := e.Header.Clone()
+	header.Set(CacheStatusHeader, status)
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", e.StatusCode, http.StatusText(e.StatusCode)),
+		StatusCode:    e.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(e.Body)),
+		ContentLength: int64(len(e.Body)),
+		Request:       req,
+	}
+}
+
+// cacheKey identifies a request. Credentials are part of the key so that users
+// sharing the cache directory never see responses fetched for someone else.
+func cacheKey(req *http.Request) string {
+	auth := sha256.Sum256([]byte(req.Header.Get("Authorization")))
+	return hex.EncodeToString(auth[:]) + " " + req.Method + " " + req.URL.String()
+}
+
+// apiPath strips the server context path and api version from the path.
+func apiPath(path string) string {
+	if m := apiPathRegex.FindStringSubmatch(path); m != nil {
+		return m[1]
+	}
+	return path
+}
+
+// CacheStats is a summary of cached entries.
+type CacheStats struct {
+	Entries int               `json:"entries"`
+	Fresh   int               `json:"fresh"`
+	Size    int64             `json:"size"`
+	Rules   []*CacheRuleStats `json:"rules"`
+}
+
+// CacheRuleStats is a summary of cached entries of a cache rule.
+type CacheRuleStats struct {
+	Name    string        `json:"name"`
+	TTL     time.Duration `json:"ttl"`
+	Entries int           `json:"entries"`
+	Fresh   int           `json:"fresh"`
+	Size    int64         `json:"size"`
+}
+
+// Stats summarizes cached entries per rule. Entries of unknown rules are only counted in the total.
+func (d *DiskCache) Stats(rules []CacheRule) (*CacheStats, error) {
+	entries, err := d.Entries()
+	if err != nil {
+		return nil, err
+	}
+
+	stats := CacheStats{Rules: make([]*CacheRuleStats, 0, len(rules))}
+	byName := make(map[string]*CacheRuleStats, len(rules))
+	for _, r := range rules {
+		rs := CacheRuleStats{Name: r.Name, TTL: r.TTL}
+		stats.Rules = append(stats.Rules, &rs)
+		byName[r.Name] = &rs
+	}
+
+	now := time.Now()
+	for _, e := range entries {
+		size := int64(len(e.Body))
+
+		stats.Entries++
+		stats.Size += size
+
+		rs, ok := byName[e.Rule]
+		if !ok {
+			continue
+		}
+		rs.Entries++
+		rs.Size += size
+		if now.Sub(e.StoredAt) < rs.TTL {
+			rs.Fresh++
+			stats.Fresh++
+		}
+	}
+	return &stats, nil
+}
@@ -0,0 +1,130 @@
+package jira
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCacheTransport(t *testing.T) {
+	var gets, notModified, posts int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			posts++
+			w.WriteHeader(204)
+			return
+		}
+
+		assert.Equal(t, "/rest/api/3/issue/TEST/transitions", r.URL.Path)
+		gets++
+
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			notModified++
+			w.WriteHeader(304)
+			return
+		}
+
+		resp, err := os.ReadFile("./testdata/transitions.json")
+		assert.NoError(t, err)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("ETag", `"v1"`)
+		w.WriteHeader(200)
+		_, _ = w.Write(resp)
+	}))
+	defer server.Close()
+
+	store := NewDiskCache(t.TempDir())
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second), WithCache(store, nil))
+	ct := client.transport.(*cacheTransport)
+
+	// Second request is served from the cache.
+	for i := 0; i < 2; i++ {
+		actual, err := client.Transitions("TEST")
+		assert.NoError(t, err)
+		assert.Len(t, actual, 3)
+	}
+	assert.Equal(t, 1, gets)
+
+	// Stale entry is revalidated with the server.
+	ct.now = func() time.Time { return time.Now().Add(10 * time.Minute) }
+
+	actual, err := client.Transitions("TEST")
+	assert.NoError(t, err)
+	assert.Len(t, actual, 3)
+	assert.Equal(t, 2, gets)
+	assert.Equal(t, 1, notModified)
+
+	// Transitioning the issue invalidates cached transitions.
+	ct.now = time.Now
+
+	_, err = client.Transition("TEST", &TransitionRequest{Transition: &TransitionRequestData{ID: "21"}})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, posts)
+
+	entries, err := store.Entries()
+	assert.NoError(t, err)
+	assert.Empty(t, entries)
+
+	_, err = client.Transitions("TEST")
+	assert.NoError(t, err)
+	assert.Equal(t, 3, gets)
+	assert.Equal(t, 1, notModified)
+}
+
+func TestCacheTransportSkipsUncachedEndpoints(t *testing.T) {
+	var gets int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gets++
+
+		resp, err := os.ReadFile("./testdata/issue-2.json")
+		assert.NoError(t, err)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write(resp)
+	}))
+	defer server.Close()
+
+	store := NewDiskCache(t.TempDir())
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second), WithCache(store, nil))
+
+	for i := 0; i < 2; i++ {
+		_, err := client.GetIssueV2("TEST-1")
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 2, gets)
+
+	entries, err := store.Entries()
+	assert.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestDiskCacheStats(t *testing.T) {
+	store := NewDiskCache(t.TempDir())
+
+	assert.NoError(t, store.Set("a", &CacheEntry{Rule: "fields", Body: []byte("abc"), StoredAt: time.Now()}))
+	assert.NoError(t, store.Set("b", &CacheEntry{Rule: "me", Body: []byte("ab"), StoredAt: time.Now().Add(-2 * time.Hour)}))
+	assert.NoError(t, store.Set("c", &CacheEntry{Rule: "unknown", Body: []byte("a"), StoredAt: time.Now()}))
+
+	stats, err := store.Stats([]CacheRule{{Name: "fields", TTL: time.Hour}, {Name: "me", TTL: time.Hour}})
+	assert.NoError(t, err)
+
+	assert.Equal(t, 3, stats.Entries)
+	assert.Equal(t, 1, stats.Fresh)
+	assert.Equal(t, int64(6), stats.Size)
+	assert.Equal(t, &CacheRuleStats{Name: "fields", TTL: time.Hour, Entries: 1, Fresh: 1, Size: 3}, stats.Rules[0])
+	assert.Equal(t, &CacheRuleStats{Name: "me", TTL: time.Hour, Entries: 1, Size: 2}, stats.Rules[1])
+
+	assert.NoError(t, store.Clear())
+
+	e, err := store.Get("a")
+	assert.NoError(t, err)
+	assert.Nil(t, e)
+}
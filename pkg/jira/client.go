@@ -7,11 +7,14 @@ import (
 	"crypto/x509"
 	"encoding/json"
 	"fmt"
+	"io"
 	"log"
+	"math"
 	"net"
 	"net/http"
 	"net/http/httputil"
 	"os"
+	"sort"
 	"strings"
 	"time"
 )
@@ -30,6 +33,8 @@ const (
 	baseURLv3 = "/rest/api/3"
 	baseURLv2 = "/rest/api/2"
 	baseURLv1 = "/rest/agile/1.0"
+	baseURLSD = "/rest/servicedeskapi"
+	baseURLWH = "/rest/webhooks/1.0"
 
 	apiVersion2 = "v2"
 	apiVersion3 = "v3"
@@ -40,6 +45,10 @@ var (
 	ErrNoResult = fmt.Errorf("jira: no result")
 	// ErrEmptyResponse denotes empty response from the server.
 	ErrEmptyResponse = fmt.Errorf("jira: empty response from server")
+	// ErrAttachmentCorrupt denotes a downloaded attachment that failed verification.
+	ErrAttachmentCorrupt = fmt.Errorf("jira: downloaded attachment is corrupt")
+	// ErrAttachmentExists denotes an attachment that was not downloaded as the file already exists.
+	ErrAttachmentExists = fmt.Errorf("jira: attachment already exists")
 )
 
 // ErrUnexpectedResponse denotes response code other than the expected one.
@@ -93,6 +102,21 @@ func (e Errors) String() string {
 	return out.String()
 }
 
+// messages returns error messages followed by field errors sorted by the field name.
+func (e Errors) messages() []string {
+	out := append([]string{}, e.ErrorMessages...)
+
+	fields := make([]string, 0, len(e.Errors))
+	for k := range e.Errors {
+		fields = append(fields, k)
+	}
+	sort.Strings(fields)
+	for _, k := range fields {
+		out = append(out, fmt.Sprintf("%s: %s", k, e.Errors[k]))
+	}
+	return out
+}
+
 // Header is a key, value pair for request headers.
 type Header map[string]string
 
@@ -116,6 +140,7 @@ type Config struct {
 
 // Client is a jira client.
 type Client struct {
+	ctx        context.Context
 	transport  http.RoundTripper
 	insecure   bool
 	server     string
@@ -125,6 +150,11 @@ type Client struct {
 	timeout    time.Duration
 	debug      bool
 	httpClient *http.Client // Reused HTTP client for connection pooling
+	cache      Cache
+	cacheRules []CacheRule
+	limiter    *RateLimiter
+	retry      *RetryPolicy
+	recorders  []TraceRecorder
 }
 
 // ClientFunc decorates option for client.
@@ -133,6 +163,7 @@ type ClientFunc func(*Client)
 // NewClient instantiates new jira client.
 func NewClient(c Config, opts ...ClientFunc) *Client {
 	client := Client{
+		ctx:      context.Background(),
 		server:   strings.TrimSuffix(c.Server, "/"),
 		login:    c.Login,
 		token:    c.APIToken,
@@ -176,7 +207,21 @@ func NewClient(c Config, opts ...ClientFunc) *Client {
 		transport.TLSClientConfig.Renegotiation = tls.RenegotiateFreelyAsClient
 	}
 
-	client.transport = transport
+	if client.limiter == nil {
+		client.limiter = NewRateLimiter(0, 0)
+	}
+	if client.retry == nil {
+		p := DefaultRetryPolicy()
+		client.retry = &p
+	}
+
+	client.transport = &limitTransport{next: transport, limiter: client.limiter}
+	if client.cache != nil {
+		client.transport = newCacheTransport(client.transport, client.cache, client.cacheRules)
+	}
+	if len(client.recorders) > 0 {
+		client.transport = &traceTransport{next: client.transport, recorders: client.recorders}
+	}
 
 	// Set default timeout if not provided
 	if client.timeout == 0 {
@@ -211,6 +256,48 @@ func WithInsecureTLS(ins bool) ClientFunc {
 	}
 }
 
+// WithContext is a functional opt to set the context used by methods that don't accept one.
+// Cancelling the context cancels all in-flight requests of the client.
+func WithContext(ctx context.Context) ClientFunc {
+	return func(c *Client) {
+		c.ctx = ctx
+	}
+}
+
+// WithRateLimit is a functional opt to limit the client to rps requests per second with bursts of up to burst requests.
+func WithRateLimit(rps float64, burst int) ClientFunc {
+	return func(c *Client) {
+		c.limiter = NewRateLimiter(rps, burst)
+	}
+}
+
+// WithRetryPolicy is a functional opt to configure how failed requests are retried.
+func WithRetryPolicy(p RetryPolicy) ClientFunc {
+	return func(c *Client) {
+		c.retry = &p
+	}
+}
+
+// WithTraceRecorder is a functional opt to record every request and response of the client.
+// Credentials are redacted before the exchange is passed to the recorders.
+func WithTraceRecorder(r ...TraceRecorder) ClientFunc {
+	return func(c *Client) {
+		c.recorders = append(c.recorders, r...)
+	}
+}
+
+// WithCache is a functional opt to cache responses of the endpoints matching the given rules.
+// Default rules are used if rules is nil.
+func WithCache(store Cache, rules []CacheRule) ClientFunc {
+	return func(c *Client) {
+		if rules == nil {
+			rules = DefaultCacheRules()
+		}
+		c.cache = store
+		c.cacheRules = rules
+	}
+}
+
 // Get sends GET request to v3 version of the jira api.
 func (c *Client) Get(ctx context.Context, path string, headers Header) (*http.Response, error) {
 	return c.request(ctx, http.MethodGet, c.server+baseURLv3+path, nil, headers)
@@ -261,60 +348,100 @@ func (c *Client) DeleteV2(ctx context.Context, path string, headers Header) (*ht
 	return c.request(ctx, http.MethodDelete, c.server+baseURLv2+path, nil, headers)
 }
 
-const (
-	// defaultMaxRetries is the default number of retries for transient errors.
-	defaultMaxRetries = 3
-)
+// GetSD sends GET request to the jira service management api.
+func (c *Client) GetSD(ctx context.Context, path string, headers Header) (*http.Response, error) {
+	return c.request(ctx, http.MethodGet, c.server+baseURLSD+path, nil, headers)
+}
 
-// request performs the actual HTTP request.
+// PostSD sends POST request to the jira service management api.
+func (c *Client) PostSD(ctx context.Context, path string, body []byte, headers Header) (*http.Response, error) {
+	return c.request(ctx, http.MethodPost, c.server+baseURLSD+path, body, headers)
+}
+
+// GetWH sends GET request to the jira webhooks api.
+func (c *Client) GetWH(ctx context.Context, path string, headers Header) (*http.Response, error) {
+	return c.request(ctx, http.MethodGet, c.server+baseURLWH+path, nil, headers)
+}
+
+// PostWH sends POST request to the jira webhooks api.
+func (c *Client) PostWH(ctx context.Context, path string, body []byte, headers Header) (*http.Response, error) {
+	return c.request(ctx, http.MethodPost, c.server+baseURLWH+path, body, headers)
+}
+
+// DeleteWH sends DELETE request to the jira webhooks api.
+func (c *Client) DeleteWH(ctx context.Context, path string, headers Header) (*http.Response, error) {
+	return c.request(ctx, http.MethodDelete, c.server+baseURLWH+path, nil, headers)
+}
+
+// request performs the actual HTTP request and retries it according to the retry policy.
 func (c *Client) request(ctx context.Context, method, endpoint string, body []byte, headers Header) (*http.Response, error) {
-	return c.requestWithRetry(ctx, method, endpoint, body, headers, defaultMaxRetries)
-}
-
-// requestWithRetry performs a request with automatic retry for transient errors.
-func (c *Client) requestWithRetry(ctx context.Context, method, endpoint string, body []byte, headers Header, maxRetries int) (*http.Response, error) {
-	var lastErr error
-	var lastResp *http.Response
-
-	for i := 0; i < maxRetries; i++ {
-		resp, err := c.doRequest(ctx, method, endpoint, body, headers)
-		
-		if err == nil {
-			// Check if status code is retryable (5xx errors)
-			if resp.StatusCode < 500 {
-				return resp, nil
-			}
-			lastResp = resp
-			// Close body for retryable errors
-			if resp.Body != nil {
-				resp.Body.Close()
-			}
+	retrySafe := isRetrySafe(ctx, method)
+
+	for attempt := 0; ; attempt++ {
+		res, err := c.doRequest(ctx, method, endpoint, body, headers)
+
+		delay, reason, ok := c.shouldRetry(ctx, res, err, retrySafe, attempt)
+		if !ok {
+			return res, err
+		}
+		if res != nil {
+			_ = res.Body.Close()
 		}
 
-		// Check if error is retryable
-		if !IsRetryableError(err) && (resp == nil || resp.StatusCode < 500) {
-			return resp, err
+		if c.debug {
+			prettyPrintDump("Retry Details", []byte(fmt.Sprintf(
+				"%s %s\nReason: %s\nAttempt: %d/%d\nDelay: %s\n",
+				method, endpoint, reason, attempt+1, c.retry.MaxRetries, delay,
+			)))
 		}
 
-		lastErr = err
-		
-		// Don't retry on last attempt
-		if i < maxRetries-1 {
-			// Exponential backoff: 1s, 2s, 4s
-			backoff := time.Duration(1<<uint(i)) * time.Second
-			select {
-			case <-ctx.Done():
-				return nil, ctx.Err()
-			case <-time.After(backoff):
-				// Continue retry
-			}
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
 		}
 	}
+}
 
-	if lastResp != nil {
-		return lastResp, lastErr
+// shouldRetry decides if a request should be retried and returns the delay before the next attempt.
+func (c *Client) shouldRetry(ctx context.Context, res *http.Response, err error, retrySafe bool, attempt int) (time.Duration, string, bool) {
+	if attempt >= c.retry.MaxRetries || ctx.Err() != nil {
+		return 0, "", false
 	}
-	return nil, fmt.Errorf("max retries (%d) exceeded: %w", maxRetries, lastErr)
+
+	var (
+		requested time.Duration
+		reason    string
+	)
+
+	switch e := err.(type) {
+	case nil:
+		if res == nil || res.StatusCode < http.StatusInternalServerError || !retrySafe {
+			return 0, "", false
+		}
+		requested = retryAfter(res.Header, time.Now())
+		reason = res.Status
+	case *ErrRateLimit:
+		requested = time.Duration(e.RetryAfter) * time.Second
+		reason = e.Error()
+	case *ErrNetwork:
+		if !retrySafe {
+			return 0, "", false
+		}
+		reason = e.Error()
+	default:
+		return 0, "", false
+	}
+
+	if requested > c.retry.MaxRetryAfter {
+		return 0, "", false
+	}
+	if requested > 0 {
+		return requested, reason, true
+	}
+	return c.retry.backoff(attempt), reason, true
 }
 
 // doRequest performs a single HTTP request without retry logic.
@@ -364,6 +491,10 @@ func (c *Client) doRequest(ctx context.Context, method, endpoint string, body []
 
 	resp, err := httpClient.Do(req.WithContext(ctx))
 	if err != nil {
+		// Cancellation is reported as is so that callers can tell it apart from network issues.
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
 		return nil, &ErrNetwork{Underlying: err}
 	}
 
@@ -387,36 +518,33 @@ func (c *Client) doRequest(ctx context.Context, method, endpoint string, body []
 			// Server errors are retryable - don't close body here, let retry logic handle it
 			return resp, nil
 		default:
+			// The body holds the error details so it is read before closing.
+			uerr := formatUnexpectedResponse(resp)
 			resp.Body.Close()
-			return nil, formatUnexpectedResponse(resp)
+			return nil, uerr
 		}
 	}
 
 	return resp, nil
 }
 
-// parseRetryAfter extracts Retry-After header value in seconds.
+// parseRetryAfter extracts delay requested by the server in seconds.
 func parseRetryAfter(headers http.Header) int {
-	retryAfter := headers.Get("Retry-After")
-	if retryAfter == "" {
-		return 0
-	}
-
-	// Try to parse as integer seconds
-	if seconds, err := time.ParseDuration(retryAfter + "s"); err == nil {
-		return int(seconds.Seconds())
-	}
-
-	// Try to parse as integer
-	if seconds, err := time.ParseDuration(retryAfter); err == nil {
-		return int(seconds.Seconds())
-	}
-
-	return 0
+	d := retryAfter(headers, time.Now())
+	return int(math.Ceil(d.Seconds()))
 }
 
 func dump(req *http.Request, res *http.Response) {
-	reqDump, _ := httputil.DumpRequest(req, true)
+	r := req.Clone(req.Context())
+	r.Header = redactHeaders(req.Header)
+	if req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			b, _ := io.ReadAll(body)
+			r.Body = io.NopCloser(bytes.NewReader(redactBody(b)))
+		}
+	}
+
+	reqDump, _ := httputil.DumpRequest(r, true)
 	prettyPrintDump("Request Details", reqDump)
 
 	if res != nil {
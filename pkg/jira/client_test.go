@@ -207,3 +207,119 @@ func TestDeleteV2(t *testing.T) {
 
 	_ = resp.Body.Close()
 }
+
+func TestRequestRetry(t *testing.T) {
+	var calls int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+
+		switch r.URL.Path {
+		case "/rest/api/2/rate-limited":
+			if calls < 3 {
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(429)
+				return
+			}
+			w.WriteHeader(201)
+		case "/rest/api/2/rate-limited-long":
+			w.Header().Set("Retry-After", "120")
+			w.WriteHeader(429)
+		default:
+			w.WriteHeader(503)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(
+		Config{Server: server.URL},
+		WithTimeout(3*time.Second),
+		WithRetryPolicy(RetryPolicy{
+			MaxRetries:    3,
+			BaseDelay:     time.Millisecond,
+			MaxDelay:      5 * time.Millisecond,
+			MaxRetryAfter: time.Second,
+		}),
+	)
+
+	// Rate limited requests are retried regardless of the method.
+	resp, err := client.PostV2(context.Background(), "/rate-limited", nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 201, resp.StatusCode)
+	assert.Equal(t, 3, calls)
+	_ = resp.Body.Close()
+
+	// Server asks to wait longer than the client is willing to.
+	calls = 0
+	_, err = client.GetV2(context.Background(), "/rate-limited-long", nil)
+	assert.Equal(t, &ErrRateLimit{RetryAfter: 120}, err)
+	assert.Equal(t, 1, calls)
+
+	// Server errors are retried for idempotent requests.
+	calls = 0
+	resp, err = client.GetV2(context.Background(), "/unavailable", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 503, resp.StatusCode)
+	assert.Equal(t, 4, calls)
+	_ = resp.Body.Close()
+
+	// Server errors are not retried for non-idempotent requests unless marked safe.
+	calls = 0
+	resp, err = client.PostV2(context.Background(), "/unavailable", nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 503, resp.StatusCode)
+	assert.Equal(t, 1, calls)
+	_ = resp.Body.Close()
+
+	calls = 0
+	resp, err = client.PostV2(WithRetrySafe(context.Background()), "/unavailable", nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 503, resp.StatusCode)
+	assert.Equal(t, 4, calls)
+	_ = resp.Body.Close()
+}
+
+func TestRequestContext(t *testing.T) {
+	done := make(chan struct{})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-done:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(done)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	// Methods that don't accept a context use the client context.
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second), WithContext(ctx))
+	_, err := client.Me()
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+
+	client = NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+	_, err = client.MeContext(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestRequestUnexpectedResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(400)
+		_, _ = w.Write([]byte(`{"errorMessages": ["Field 'stauts' does not exist or you do not have permission to view it."]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+	_, err := client.Search("stauts = Done", 10)
+
+	var uerr *ErrUnexpectedResponse
+	assert.ErrorAs(t, err, &uerr)
+	assert.Equal(t, 400, uerr.StatusCode)
+	assert.Equal(t, []string{"Field 'stauts' does not exist or you do not have permission to view it."}, uerr.Body.ErrorMessages)
+}
@@ -32,8 +32,13 @@ type CommentList struct {
 
 // GetComments retrieves all comments for an issue.
 func (c *Client) GetComments(key string) ([]*Comment, error) {
+	return c.GetCommentsContext(c.ctx, key)
+}
+
+// GetCommentsContext is like GetComments but uses ctx to cancel in-flight requests.
+func (c *Client) GetCommentsContext(ctx context.Context, key string) ([]*Comment, error) {
 	path := fmt.Sprintf("/issue/%s/comment", key)
-	res, err := c.GetV2(context.Background(), path, Header{
+	res, err := c.GetV2(ctx, path, Header{
 		"Accept": "application/json",
 	})
 	if err != nil {
@@ -59,6 +64,11 @@ func (c *Client) GetComments(key string) ([]*Comment, error) {
 
 // UpdateComment updates an existing comment.
 func (c *Client) UpdateComment(key, commentID, body string, internal bool) error {
+	return c.UpdateCommentContext(c.ctx, key, commentID, body, internal)
+}
+
+// UpdateCommentContext is like UpdateComment but uses ctx to cancel in-flight requests.
+func (c *Client) UpdateCommentContext(ctx context.Context, key, commentID, body string, internal bool) error {
 	var bodyContent interface{}
 	if internal {
 		bodyContent = md.ToJiraMD(body)
@@ -92,7 +102,7 @@ func (c *Client) UpdateComment(key, commentID, body string, internal bool) error
 	}
 
 	path := fmt.Sprintf("/issue/%s/comment/%s", key, commentID)
-	res, err := c.PutV2(context.Background(), path, bodyBytes, Header{
+	res, err := c.PutV2(ctx, path, bodyBytes, Header{
 		"Accept":       "application/json",
 		"Content-Type": "application/json",
 	})
@@ -113,8 +123,13 @@ func (c *Client) UpdateComment(key, commentID, body string, internal bool) error
 
 // DeleteComment deletes a comment from an issue.
 func (c *Client) DeleteComment(key, commentID string) error {
+	return c.DeleteCommentContext(c.ctx, key, commentID)
+}
+
+// DeleteCommentContext is like DeleteComment but uses ctx to cancel in-flight requests.
+func (c *Client) DeleteCommentContext(ctx context.Context, key, commentID string) error {
 	path := fmt.Sprintf("/issue/%s/comment/%s", key, commentID)
-	res, err := c.DeleteV2(context.Background(), path, Header{
+	res, err := c.DeleteV2(ctx, path, Header{
 		"Accept": "application/json",
 	})
 	if err != nil {
@@ -0,0 +1,195 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+const (
+	// AssigneeTypeProjectDefault assigns issues to the project default assignee.
+	AssigneeTypeProjectDefault = "PROJECT_DEFAULT"
+	// AssigneeTypeComponentLead assigns issues to the component lead.
+	AssigneeTypeComponentLead = "COMPONENT_LEAD"
+	// AssigneeTypeProjectLead assigns issues to the project lead.
+	AssigneeTypeProjectLead = "PROJECT_LEAD"
+	// AssigneeTypeUnassigned leaves issues unassigned.
+	AssigneeTypeUnassigned = "UNASSIGNED"
+)
+
+// Component holds project component info.
+type Component struct {
+	ID                  string `json:"id"`
+	Name                string `json:"name"`
+	Description         string `json:"description,omitempty"`
+	Lead                *User  `json:"lead,omitempty"`
+	AssigneeType        string `json:"assigneeType,omitempty"`
+	Assignee            *User  `json:"assignee,omitempty"`
+	RealAssigneeType    string `json:"realAssigneeType,omitempty"`
+	IsAssigneeTypeValid bool   `json:"isAssigneeTypeValid"`
+	Project             string `json:"project"`
+	ProjectID           int    `json:"projectId"`
+}
+
+// ComponentRequest holds request data for component create and update requests.
+//
+// Cloud installations identify the lead by account id
+// whereas local installations use the username.
+type ComponentRequest struct {
+	Name          string `json:"name,omitempty"`
+	Description   string `json:"description,omitempty"`
+	Project       string `json:"project,omitempty"`
+	LeadAccountID string `json:"leadAccountId,omitempty"`
+	LeadUserName  string `json:"leadUserName,omitempty"`
+	AssigneeType  string `json:"assigneeType,omitempty"`
+}
+
+// Components fetches project components using GET /project/{projectIdOrKey}/components endpoint.
+func (c *Client) Components(project string) ([]*Component, error) {
+	return c.ComponentsContext(c.ctx, project)
+}
+
+// ComponentsContext is like Components but uses ctx to cancel in-flight requests.
+func (c *Client) ComponentsContext(ctx context.Context, project string) ([]*Component, error) {
+	res, err := c.GetV2(ctx, fmt.Sprintf("/project/%s/components", project), nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out []*Component
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return out, err
+}
+
+// CreateComponent creates a project component using POST /component endpoint.
+func (c *Client) CreateComponent(req *ComponentRequest) (*Component, error) {
+	return c.CreateComponentContext(c.ctx, req)
+}
+
+// CreateComponentContext is like CreateComponent but uses ctx to cancel in-flight requests.
+func (c *Client) CreateComponentContext(ctx context.Context, req *ComponentRequest) (*Component, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.PostV2(ctx, "/component", body, Header{
+		"Accept":       "application/json",
+		"Content-Type": "application/json",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusCreated {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out Component
+	err = json.NewDecoder(res.Body).Decode(&out)
+	return &out, err
+}
+
+// UpdateComponent updates a project component using PUT /component/{id} endpoint.
+func (c *Client) UpdateComponent(id string, req *ComponentRequest) (*Component, error) {
+	return c.UpdateComponentContext(c.ctx, id, req)
+}
+
+// UpdateComponentContext is like UpdateComponent but uses ctx to cancel in-flight requests.
+func (c *Client) UpdateComponentContext(ctx context.Context, id string, req *ComponentRequest) (*Component, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.PutV2(ctx, fmt.Sprintf("/component/%s", id), body, Header{
+		"Accept":       "application/json",
+		"Content-Type": "application/json",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out Component
+	err = json.NewDecoder(res.Body).Decode(&out)
+	return &out, err
+}
+
+// DeleteComponent deletes a project component using DELETE /component/{id} endpoint.
+// Issues are reassigned to the moveIssuesTo component if it is not empty.
+func (c *Client) DeleteComponent(id, moveIssuesTo string) error {
+	return c.DeleteComponentContext(c.ctx, id, moveIssuesTo)
+}
+
+// DeleteComponentContext is like DeleteComponent but uses ctx to cancel in-flight requests.
+func (c *Client) DeleteComponentContext(ctx context.Context, id, moveIssuesTo string) error {
+	path := fmt.Sprintf("/component/%s", id)
+	if moveIssuesTo != "" {
+		path += "?moveIssuesTo=" + url.QueryEscape(moveIssuesTo)
+	}
+
+	res, err := c.DeleteV2(ctx, path, nil)
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusNoContent {
+		return formatUnexpectedResponse(res)
+	}
+	return nil
+}
+
+// ComponentIssueCount fetches number of issues in a component using GET /component/{id}/relatedIssueCounts endpoint.
+func (c *Client) ComponentIssueCount(id string) (int, error) {
+	return c.ComponentIssueCountContext(c.ctx, id)
+}
+
+// ComponentIssueCountContext is like ComponentIssueCount but uses ctx to cancel in-flight requests.
+func (c *Client) ComponentIssueCountContext(ctx context.Context, id string) (int, error) {
+	res, err := c.GetV2(ctx, fmt.Sprintf("/component/%s/relatedIssueCounts", id), nil)
+	if err != nil {
+		return 0, err
+	}
+	if res == nil {
+		return 0, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return 0, formatUnexpectedResponse(res)
+	}
+
+	var out struct {
+		IssueCount int `json:"issueCount"`
+	}
+	err = json.NewDecoder(res.Body).Decode(&out)
+	return out.IssueCount, err
+}
@@ -0,0 +1,143 @@
+package jira
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComponents(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/project/TEST/components", r.URL.Path)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			resp, err := os.ReadFile("./testdata/components.json")
+			assert.NoError(t, err)
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			_, _ = w.Write(resp)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.Components("TEST")
+	assert.NoError(t, err)
+
+	expected := []*Component{
+		{
+			ID:          "10000",
+			Name:        "Backend",
+			Description: "Server side services",
+			Lead: &User{
+				AccountID:   "5b10a2844c20165700ede21g",
+				DisplayName: "Jane Doe",
+				Active:      true,
+			},
+			AssigneeType:        AssigneeTypeComponentLead,
+			RealAssigneeType:    AssigneeTypeComponentLead,
+			IsAssigneeTypeValid: true,
+			Project:             "TEST",
+			ProjectID:           10000,
+		},
+		{
+			ID:               "10001",
+			Name:             "Frontend",
+			AssigneeType:     AssigneeTypeProjectDefault,
+			RealAssigneeType: AssigneeTypeProjectDefault,
+			Project:          "TEST",
+			ProjectID:        10000,
+		},
+	}
+	assert.Equal(t, expected, actual)
+
+	unexpectedStatusCode = true
+
+	_, err = client.Components("TEST")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestCreateComponent(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/component", r.URL.Path)
+		assert.Equal(t, "POST", r.Method)
+
+		actualBody := new(ComponentRequest)
+		_ = json.NewDecoder(r.Body).Decode(&actualBody)
+
+		expectedBody := &ComponentRequest{
+			Name:          "Backend",
+			Project:       "TEST",
+			LeadAccountID: "5b10a2844c20165700ede21g",
+			AssigneeType:  AssigneeTypeComponentLead,
+		}
+		assert.Equal(t, expectedBody, actualBody)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(201)
+			_, _ = w.Write([]byte(`{"id":"10000","name":"Backend","project":"TEST","projectId":10000}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	req := ComponentRequest{
+		Name:          "Backend",
+		Project:       "TEST",
+		LeadAccountID: "5b10a2844c20165700ede21g",
+		AssigneeType:  AssigneeTypeComponentLead,
+	}
+
+	actual, err := client.CreateComponent(&req)
+	assert.NoError(t, err)
+	assert.Equal(t, "10000", actual.ID)
+
+	unexpectedStatusCode = true
+
+	_, err = client.CreateComponent(&req)
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestDeleteComponent(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/component/10000", r.URL.Path)
+		assert.Equal(t, "DELETE", r.Method)
+		assert.Equal(t, "10001", r.URL.Query().Get("moveIssuesTo"))
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			w.WriteHeader(204)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	err := client.DeleteComponent("10000", "10001")
+	assert.NoError(t, err)
+
+	unexpectedStatusCode = true
+
+	err = client.DeleteComponent("10000", "10001")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
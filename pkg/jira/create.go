@@ -68,15 +68,25 @@ func (cr *CreateRequest) WithCustomFields(cf []IssueTypeField) {
 
 // Create creates an issue using v3 version of the POST /issue endpoint.
 func (c *Client) Create(req *CreateRequest) (*CreateResponse, error) {
-	return c.create(req, apiVersion3)
+	return c.CreateContext(c.ctx, req)
+}
+
+// CreateContext is like Create but uses ctx to cancel in-flight requests.
+func (c *Client) CreateContext(ctx context.Context, req *CreateRequest) (*CreateResponse, error) {
+	return c.create(ctx, req, apiVersion3)
 }
 
 // CreateV2 creates an issue using v2 version of the POST /issue endpoint.
 func (c *Client) CreateV2(req *CreateRequest) (*CreateResponse, error) {
-	return c.create(req, apiVersion2)
+	return c.CreateV2Context(c.ctx, req)
+}
+
+// CreateV2Context is like CreateV2 but uses ctx to cancel in-flight requests.
+func (c *Client) CreateV2Context(ctx context.Context, req *CreateRequest) (*CreateResponse, error) {
+	return c.create(ctx, req, apiVersion2)
 }
 
-func (c *Client) create(req *CreateRequest, ver string) (*CreateResponse, error) {
+func (c *Client) create(ctx context.Context, req *CreateRequest, ver string) (*CreateResponse, error) {
 	data := c.getRequestData(req)
 
 	body, err := json.Marshal(&data)
@@ -93,9 +103,9 @@ func (c *Client) create(req *CreateRequest, ver string) (*CreateResponse, error)
 
 	switch ver {
 	case apiVersion2:
-		res, err = c.PostV2(context.Background(), "/issue", body, header)
+		res, err = c.PostV2(ctx, "/issue", body, header)
 	default:
-		res, err = c.Post(context.Background(), "/issue", body, header)
+		res, err = c.Post(ctx, "/issue", body, header)
 	}
 
 	if err != nil {
@@ -41,6 +41,11 @@ type CreateMetaIssueType struct {
 
 // GetCreateMeta gets create metadata using GET /issue/createmeta endpoint.
 func (c *Client) GetCreateMeta(req *CreateMetaRequest) (*CreateMetaResponse, error) {
+	return c.GetCreateMetaContext(c.ctx, req)
+}
+
+// GetCreateMetaContext is like GetCreateMeta but uses ctx to cancel in-flight requests.
+func (c *Client) GetCreateMetaContext(ctx context.Context, req *CreateMetaRequest) (*CreateMetaResponse, error) {
 	path := fmt.Sprintf(
 		"/issue/createmeta?projectKeys=%s&expand=%s",
 		req.Projects, req.Expand,
@@ -49,7 +54,7 @@ func (c *Client) GetCreateMeta(req *CreateMetaRequest) (*CreateMetaResponse, err
 		path += fmt.Sprintf("&issuetypeNames=%s", req.IssueTypeNames)
 	}
 
-	res, err := c.GetV2(context.Background(), path, nil)
+	res, err := c.GetV2(ctx, path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -71,6 +76,11 @@ func (c *Client) GetCreateMeta(req *CreateMetaRequest) (*CreateMetaResponse, err
 
 // GetCreateMetaForJiraServerV9 gets create metadata using GET /issue/createmeta endpoint for jira server 9 and above.
 func (c *Client) GetCreateMetaForJiraServerV9(req *CreateMetaRequest) (*CreateMetaResponseJiraServerV9, error) {
+	return c.GetCreateMetaForJiraServerV9Context(c.ctx, req)
+}
+
+// GetCreateMetaForJiraServerV9Context is like GetCreateMetaForJiraServerV9 but uses ctx to cancel in-flight requests.
+func (c *Client) GetCreateMetaForJiraServerV9Context(ctx context.Context, req *CreateMetaRequest) (*CreateMetaResponseJiraServerV9, error) {
 	path := fmt.Sprintf(
 		"/issue/createmeta/%s/issuetypes?expand=%s",
 		req.Projects, req.Expand,
@@ -79,7 +89,7 @@ func (c *Client) GetCreateMetaForJiraServerV9(req *CreateMetaRequest) (*CreateMe
 		path += fmt.Sprintf("&issuetypeNames=%s", req.IssueTypeNames)
 	}
 
-	res, err := c.GetV2(context.Background(), path, nil)
+	res, err := c.GetV2(ctx, path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -8,12 +8,17 @@ import (
 
 // DeleteIssue deletes an issue using /issue/{key} endpoint.
 func (c *Client) DeleteIssue(key string, cascade bool) error {
+	return c.DeleteIssueContext(c.ctx, key, cascade)
+}
+
+// DeleteIssueContext is like DeleteIssue but uses ctx to cancel in-flight requests.
+func (c *Client) DeleteIssueContext(ctx context.Context, key string, cascade bool) error {
 	path := fmt.Sprintf("/issue/%s", key)
 	if cascade {
 		path = fmt.Sprintf("%s?deleteSubtasks=true", path)
 	}
 
-	res, err := c.DeleteV2(context.Background(), path, nil)
+	res, err := c.DeleteV2(ctx, path, nil)
 	if err != nil {
 		return err
 	}
@@ -47,6 +47,11 @@ func (er *EditRequest) WithCustomFields(cf []IssueTypeField) {
 
 // Edit updates an issue using POST /issue endpoint.
 func (c *Client) Edit(key string, req *EditRequest) error {
+	return c.EditContext(c.ctx, key, req)
+}
+
+// EditContext is like Edit but uses ctx to cancel in-flight requests.
+func (c *Client) EditContext(ctx context.Context, key string, req *EditRequest) error {
 	data := getRequestDataForEdit(req)
 
 	body, err := json.Marshal(&data)
@@ -59,7 +64,7 @@ func (c *Client) Edit(key string, req *EditRequest) error {
 		endpoint += "?notifyUsers=false"
 	}
 
-	res, err := c.PutV2(context.Background(), endpoint, body, Header{
+	res, err := c.PutV2(ctx, endpoint, body, Header{
 		"Accept":       "application/json",
 		"Content-Type": "application/json",
 	})
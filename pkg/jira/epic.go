@@ -17,12 +17,17 @@ const (
 
 // EpicIssues fetches issues in the given epic.
 func (c *Client) EpicIssues(key, jql string, from, limit uint) (*SearchResult, error) {
+	return c.EpicIssuesContext(c.ctx, key, jql, from, limit)
+}
+
+// EpicIssuesContext is like EpicIssues but uses ctx to cancel in-flight requests.
+func (c *Client) EpicIssuesContext(ctx context.Context, key, jql string, from, limit uint) (*SearchResult, error) {
 	path := fmt.Sprintf("/epic/%s/issue?startAt=%d&maxResults=%d", key, from, limit)
 	if jql != "" {
 		path += fmt.Sprintf("&jql=%s", url.QueryEscape(jql))
 	}
 
-	res, err := c.GetV1(context.Background(), path, nil)
+	res, err := c.GetV1(ctx, path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -44,6 +49,11 @@ func (c *Client) EpicIssues(key, jql string, from, limit uint) (*SearchResult, e
 
 // EpicIssuesAdd adds issues to an epic.
 func (c *Client) EpicIssuesAdd(key string, issues ...string) error {
+	return c.EpicIssuesAddContext(c.ctx, key, issues...)
+}
+
+// EpicIssuesAddContext is like EpicIssuesAdd but uses ctx to cancel in-flight requests.
+func (c *Client) EpicIssuesAddContext(ctx context.Context, key string, issues ...string) error {
 	path := fmt.Sprintf("/epic/%s/issue", key)
 
 	data := struct {
@@ -55,7 +65,7 @@ func (c *Client) EpicIssuesAdd(key string, issues ...string) error {
 		return err
 	}
 
-	res, err := c.PostV1(context.Background(), path, body, Header{
+	res, err := c.PostV1(WithRetrySafe(ctx), path, body, Header{
 		"Accept":       "application/json",
 		"Content-Type": "application/json",
 	})
@@ -75,6 +85,11 @@ func (c *Client) EpicIssuesAdd(key string, issues ...string) error {
 
 // EpicIssuesRemove removes issues from epics.
 func (c *Client) EpicIssuesRemove(issues ...string) error {
+	return c.EpicIssuesRemoveContext(c.ctx, issues...)
+}
+
+// EpicIssuesRemoveContext is like EpicIssuesRemove but uses ctx to cancel in-flight requests.
+func (c *Client) EpicIssuesRemoveContext(ctx context.Context, issues ...string) error {
 	path := "/epic/none/issue"
 
 	data := struct {
@@ -86,7 +101,7 @@ func (c *Client) EpicIssuesRemove(issues ...string) error {
 		return err
 	}
 
-	res, err := c.PostV1(context.Background(), path, body, Header{
+	res, err := c.PostV1(WithRetrySafe(ctx), path, body, Header{
 		"Accept":       "application/json",
 		"Content-Type": "application/json",
 	})
@@ -0,0 +1,281 @@
+package jira
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	harVersion  = "1.2"
+	harFilePerm = 0o600
+)
+
+// HAR is a http archive, see http://www.softwareishard.com/blog/har-12-spec/.
+type HAR struct {
+	Log HARLog `json:"log"`
+}
+
+// HARLog is the root of the http archive.
+type HARLog struct {
+	Version string      `json:"version"`
+	Creator HARCreator  `json:"creator"`
+	Entries []*HAREntry `json:"entries"`
+}
+
+// HARCreator is the application that created the archive.
+type HARCreator struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// HAREntry is a recorded request.
+type HAREntry struct {
+	StartedDateTime string      `json:"startedDateTime"`
+	Time            float64     `json:"time"`
+	Request         HARRequest  `json:"request"`
+	Response        HARResponse `json:"response"`
+	Cache           struct{}    `json:"cache"`
+	Timings         HARTimings  `json:"timings"`
+	Error           string      `json:"_error,omitempty"`
+}
+
+// HARRequest is a recorded request.
+type HARRequest struct {
+	Method      string         `json:"method"`
+	URL         string         `json:"url"`
+	HTTPVersion string         `json:"httpVersion"`
+	Cookies     []HARNameValue `json:"cookies"`
+	Headers     []HARNameValue `json:"headers"`
+	QueryString []HARNameValue `json:"queryString"`
+	PostData    *HARPostData   `json:"postData,omitempty"`
+	HeadersSize int            `json:"headersSize"`
+	BodySize    int            `json:"bodySize"`
+}
+
+// HARResponse is a recorded response.
+type HARResponse struct {
+	Status      int            `json:"status"`
+	StatusText  string         `json:"statusText"`
+	HTTPVersion string         `json:"httpVersion"`
+	Cookies     []HARNameValue `json:"cookies"`
+	Headers     []HARNameValue `json:"headers"`
+	Content     HARContent     `json:"content"`
+	RedirectURL string         `json:"redirectURL"`
+	HeadersSize int            `json:"headersSize"`
+	BodySize    int            `json:"bodySize"`
+}
+
+// HARNameValue is a name value pair, eg: a header.
+type HARNameValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// HARPostData is a recorded request body.
+type HARPostData struct {
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text"`
+}
+
+// HARContent is a recorded response body.
+type HARContent struct {
+	Size     int    `json:"size"`
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text,omitempty"`
+	Encoding string `json:"encoding,omitempty"`
+	Comment  string `json:"comment,omitempty"`
+}
+
+// HARTimings holds time spent in each phase of a request in milliseconds.
+type HARTimings struct {
+	Blocked float64 `json:"blocked"`
+	DNS     float64 `json:"dns"`
+	Connect float64 `json:"connect"`
+	Send    float64 `json:"send"`
+	Wait    float64 `json:"wait"`
+	Receive float64 `json:"receive"`
+	SSL     float64 `json:"ssl"`
+}
+
+// HARFile is a trace recorder that writes requests to a http archive file.
+//
+// Entries are kept in memory and the file is written on Flush.
+type HARFile struct {
+	mu    sync.Mutex
+	path  string
+	har   HAR
+	dirty bool
+}
+
+// NewHARFile constructs a trace recorder that writes to the given path.
+func NewHARFile(path, creatorVersion string) *HARFile {
+	return &HARFile{
+		path: path,
+		har: HAR{Log: HARLog{
+			Version: harVersion,
+			Creator: HARCreator{Name: "jira-cli", Version: creatorVersion},
+			Entries: []*HAREntry{},
+		}},
+	}
+}
+
+// Record implements TraceRecorder.
+func (f *HARFile) Record(e *TraceEntry) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.har.Log.Entries = append(f.har.Log.Entries, newHAREntry(e))
+	f.dirty = true
+}
+
+// Flush implements TraceFlusher. The file is written only if there are new entries.
+func (f *HARFile) Flush() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if !f.dirty {
+		return nil
+	}
+	if err := f.write(); err != nil {
+		return fmt.Errorf("unable to write trace file %s: %w", f.path, err)
+	}
+	f.dirty = false
+	return nil
+}
+
+// HAR returns the recorded archive.
+func (f *HARFile) HAR() HAR {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.har
+}
+
+func (f *HARFile) write() error {
+	b, err := json.MarshalIndent(f.har, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := filepath.Join(filepath.Dir(f.path), "."+filepath.Base(f.path)+".tmp")
+	if err := os.WriteFile(tmp, b, harFilePerm); err != nil {
+		return err
+	}
+	return os.Rename(tmp, f.path)
+}
+
+func newHAREntry(e *TraceEntry) *HAREntry {
+	entry := HAREntry{
+		StartedDateTime: e.Start.UTC().Format(time.RFC3339Nano),
+		Time:            ms(e.Duration),
+		Request: HARRequest{
+			Method:      e.Method,
+			URL:         e.URL,
+			HTTPVersion: e.Proto,
+			Cookies:     []HARNameValue{},
+			Headers:     harHeaders(e.RequestHeader),
+			QueryString: harQuery(e.URL),
+			HeadersSize: -1,
+			BodySize:    len(e.RequestBody),
+		},
+		Response: HARResponse{
+			Status:      e.StatusCode,
+			StatusText:  strings.TrimSpace(strings.TrimPrefix(e.Status, fmt.Sprint(e.StatusCode))),
+			HTTPVersion: e.Proto,
+			Cookies:     []HARNameValue{},
+			Headers:     harHeaders(e.Header),
+			Content: HARContent{
+				Size:     len(e.Body),
+				MimeType: e.Header.Get("Content-Type"),
+			},
+			RedirectURL: e.Header.Get("Location"),
+			HeadersSize: -1,
+			BodySize:    len(e.Body),
+		},
+		Timings: HARTimings{
+			Blocked: ms(e.Timings.Blocked),
+			DNS:     ms(e.Timings.DNS),
+			Connect: ms(e.Timings.Connect),
+			Send:    max(ms(e.Timings.Send), 0),
+			Wait:    max(ms(e.Timings.Wait), 0),
+			Receive: max(ms(e.Timings.Receive), 0),
+			SSL:     ms(e.Timings.TLS),
+		},
+	}
+
+	if len(e.RequestBody) > 0 {
+		entry.Request.PostData = &HARPostData{
+			MimeType: e.RequestHeader.Get("Content-Type"),
+			Text:     string(e.RequestBody),
+		}
+	}
+	if utf8.Valid(e.Body) {
+		entry.Response.Content.Text = string(e.Body)
+	} else {
+		entry.Response.Content.Encoding = "base64"
+		entry.Response.Content.Text = base64.StdEncoding.EncodeToString(e.Body)
+	}
+	if e.Truncated {
+		entry.Response.Content.Comment = fmt.Sprintf("body truncated to %d bytes", maxTraceBodySize)
+	}
+	if e.Err != nil {
+		entry.Error = e.Err.Error()
+	}
+	return &entry
+}
+
+func harHeaders(h http.Header) []HARNameValue {
+	keys := make([]string, 0, len(h))
+	for k := range h {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	out := make([]HARNameValue, 0, len(h))
+	for _, k := range keys {
+		for _, v := range h[k] {
+			out = append(out, HARNameValue{Name: k, Value: v})
+		}
+	}
+	return out
+}
+
+func harQuery(rawURL string) []HARNameValue {
+	out := []HARNameValue{}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return out
+	}
+	q := u.Query()
+
+	keys := make([]string, 0, len(q))
+	for k := range q {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		for _, v := range q[k] {
+			out = append(out, HARNameValue{Name: k, Value: v})
+		}
+	}
+	return out
+}
+
+// ms converts duration to milliseconds keeping -1 for phases that didn't happen.
+func ms(d time.Duration) float64 {
+	if d < 0 {
+		return -1
+	}
+	return float64(d) / float64(time.Millisecond)
+}
@@ -27,8 +27,13 @@ type HistoryItem struct {
 
 // GetIssueHistory retrieves the changelog/history for an issue.
 func (c *Client) GetIssueHistory(key string) ([]HistoryEntry, error) {
+	return c.GetIssueHistoryContext(c.ctx, key)
+}
+
+// GetIssueHistoryContext is like GetIssueHistory but uses ctx to cancel in-flight requests.
+func (c *Client) GetIssueHistoryContext(ctx context.Context, key string) ([]HistoryEntry, error) {
 	path := fmt.Sprintf("/issue/%s?expand=changelog", key)
-	res, err := c.GetV2(context.Background(), path, Header{
+	res, err := c.GetV2(ctx, path, Header{
 		"Accept": "application/json",
 	})
 	if err != nil {
@@ -79,7 +84,19 @@ func (c *Client) GetIssueHistoryFlat(key string) ([]struct {
 	FromString string
 	ToString   string
 }, error) {
-	history, err := c.GetIssueHistory(key)
+	return c.GetIssueHistoryFlatContext(c.ctx, key)
+}
+
+// GetIssueHistoryFlatContext is like GetIssueHistoryFlat but uses ctx to cancel in-flight requests.
+func (c *Client) GetIssueHistoryFlatContext(ctx context.Context, key string) ([]struct {
+	ID         string
+	Author     User
+	Created    string
+	Field      string
+	FromString string
+	ToString   string
+}, error) {
+	history, err := c.GetIssueHistoryContext(ctx, key)
 	if err != nil {
 		return nil, err
 	}
@@ -29,7 +29,12 @@ const (
 
 // GetIssue fetches issue details using GET /issue/{key} endpoint.
 func (c *Client) GetIssue(key string, opts ...filter.Filter) (*Issue, error) {
-	iss, err := c.getIssue(key, apiVersion3)
+	return c.GetIssueContext(c.ctx, key, opts...)
+}
+
+// GetIssueContext is like GetIssue but uses ctx to cancel in-flight requests.
+func (c *Client) GetIssueContext(ctx context.Context, key string, opts ...filter.Filter) (*Issue, error) {
+	iss, err := c.getIssue(ctx, key, apiVersion3)
 	if err != nil {
 		return nil, err
 	}
@@ -49,12 +54,17 @@ func (c *Client) GetIssue(key string, opts ...filter.Filter) (*Issue, error) {
 }
 
 // GetIssueV2 fetches issue details using v2 version of Jira GET /issue/{key} endpoint.
-func (c *Client) GetIssueV2(key string, _ ...filter.Filter) (*Issue, error) {
-	return c.getIssue(key, apiVersion2)
+func (c *Client) GetIssueV2(key string, opts ...filter.Filter) (*Issue, error) {
+	return c.GetIssueV2Context(c.ctx, key, opts...)
+}
+
+// GetIssueV2Context is like GetIssueV2 but uses ctx to cancel in-flight requests.
+func (c *Client) GetIssueV2Context(ctx context.Context, key string, _ ...filter.Filter) (*Issue, error) {
+	return c.getIssue(ctx, key, apiVersion2)
 }
 
-func (c *Client) getIssue(key, ver string) (*Issue, error) {
-	rawOut, err := c.getIssueRaw(key, ver)
+func (c *Client) getIssue(ctx context.Context, key, ver string) (*Issue, error) {
+	rawOut, err := c.getIssueRaw(ctx, key, ver)
 	if err != nil {
 		return nil, err
 	}
@@ -69,15 +79,25 @@ func (c *Client) getIssue(key, ver string) (*Issue, error) {
 
 // GetIssueRaw fetches issue details same as GetIssue but returns the raw API response body string.
 func (c *Client) GetIssueRaw(key string) (string, error) {
-	return c.getIssueRaw(key, apiVersion3)
+	return c.GetIssueRawContext(c.ctx, key)
+}
+
+// GetIssueRawContext is like GetIssueRaw but uses ctx to cancel in-flight requests.
+func (c *Client) GetIssueRawContext(ctx context.Context, key string) (string, error) {
+	return c.getIssueRaw(ctx, key, apiVersion3)
 }
 
 // GetIssueV2Raw fetches issue details same as GetIssueV2 but returns the raw API response body string.
 func (c *Client) GetIssueV2Raw(key string) (string, error) {
-	return c.getIssueRaw(key, apiVersion2)
+	return c.GetIssueV2RawContext(c.ctx, key)
 }
 
-func (c *Client) getIssueRaw(key, ver string) (string, error) {
+// GetIssueV2RawContext is like GetIssueV2Raw but uses ctx to cancel in-flight requests.
+func (c *Client) GetIssueV2RawContext(ctx context.Context, key string) (string, error) {
+	return c.getIssueRaw(ctx, key, apiVersion2)
+}
+
+func (c *Client) getIssueRaw(ctx context.Context, key, ver string) (string, error) {
 	path := fmt.Sprintf("/issue/%s", key)
 
 	var (
@@ -87,9 +107,9 @@ func (c *Client) getIssueRaw(key, ver string) (string, error) {
 
 	switch ver {
 	case apiVersion2:
-		res, err = c.GetV2(context.Background(), path, nil)
+		res, err = c.GetV2(ctx, path, nil)
 	default:
-		res, err = c.Get(context.Background(), path, nil)
+		res, err = c.Get(ctx, path, nil)
 	}
 
 	if err != nil {
@@ -114,15 +134,25 @@ func (c *Client) getIssueRaw(key, ver string) (string, error) {
 
 // AssignIssue assigns issue to the user using v3 version of the PUT /issue/{key}/assignee endpoint.
 func (c *Client) AssignIssue(key, assignee string) error {
-	return c.assignIssue(key, assignee, apiVersion3)
+	return c.AssignIssueContext(c.ctx, key, assignee)
+}
+
+// AssignIssueContext is like AssignIssue but uses ctx to cancel in-flight requests.
+func (c *Client) AssignIssueContext(ctx context.Context, key, assignee string) error {
+	return c.assignIssue(ctx, key, assignee, apiVersion3)
 }
 
 // AssignIssueV2 assigns issue to the user using v2 version of the PUT /issue/{key}/assignee endpoint.
 func (c *Client) AssignIssueV2(key, assignee string) error {
-	return c.assignIssue(key, assignee, apiVersion2)
+	return c.AssignIssueV2Context(c.ctx, key, assignee)
+}
+
+// AssignIssueV2Context is like AssignIssueV2 but uses ctx to cancel in-flight requests.
+func (c *Client) AssignIssueV2Context(ctx context.Context, key, assignee string) error {
+	return c.assignIssue(ctx, key, assignee, apiVersion2)
 }
 
-func (c *Client) assignIssue(key, assignee, ver string) error {
+func (c *Client) assignIssue(ctx context.Context, key, assignee, ver string) error {
 	path := fmt.Sprintf("/issue/%s/assignee", key)
 
 	aid := new(string)
@@ -151,7 +181,7 @@ func (c *Client) assignIssue(key, assignee, ver string) error {
 		if err != nil {
 			return err
 		}
-		res, err = c.PutV2(context.Background(), path, body, Header{
+		res, err = c.PutV2(ctx, path, body, Header{
 			"Accept":       "application/json",
 			"Content-Type": "application/json",
 		})
@@ -164,7 +194,7 @@ func (c *Client) assignIssue(key, assignee, ver string) error {
 		if err != nil {
 			return err
 		}
-		res, err = c.Put(context.Background(), path, body, Header{
+		res, err = c.Put(ctx, path, body, Header{
 			"Accept":       "application/json",
 			"Content-Type": "application/json",
 		})
@@ -186,7 +216,12 @@ func (c *Client) assignIssue(key, assignee, ver string) error {
 
 // GetIssueLinkTypes fetches issue link types using GET /issueLinkType endpoint.
 func (c *Client) GetIssueLinkTypes() ([]*IssueLinkType, error) {
-	res, err := c.GetV2(context.Background(), "/issueLinkType", nil)
+	return c.GetIssueLinkTypesContext(c.ctx)
+}
+
+// GetIssueLinkTypesContext is like GetIssueLinkTypes but uses ctx to cancel in-flight requests.
+func (c *Client) GetIssueLinkTypesContext(ctx context.Context) ([]*IssueLinkType, error) {
+	res, err := c.GetV2(ctx, "/issueLinkType", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -224,6 +259,11 @@ type linkRequest struct {
 
 // LinkIssue connects issues to the given link type using POST /issueLink endpoint.
 func (c *Client) LinkIssue(inwardIssue, outwardIssue, linkType string) error {
+	return c.LinkIssueContext(c.ctx, inwardIssue, outwardIssue, linkType)
+}
+
+// LinkIssueContext is like LinkIssue but uses ctx to cancel in-flight requests.
+func (c *Client) LinkIssueContext(ctx context.Context, inwardIssue, outwardIssue, linkType string) error {
 	body, err := json.Marshal(linkRequest{
 		InwardIssue: struct {
 			Key string `json:"key"`
@@ -239,7 +279,7 @@ func (c *Client) LinkIssue(inwardIssue, outwardIssue, linkType string) error {
 		return err
 	}
 
-	res, err := c.PostV2(context.Background(), "/issueLink", body, Header{
+	res, err := c.PostV2(ctx, "/issueLink", body, Header{
 		"Accept":       "application/json",
 		"Content-Type": "application/json",
 	})
@@ -259,8 +299,13 @@ func (c *Client) LinkIssue(inwardIssue, outwardIssue, linkType string) error {
 
 // UnlinkIssue disconnects two issues using DELETE /issueLink/{linkId} endpoint.
 func (c *Client) UnlinkIssue(linkID string) error {
+	return c.UnlinkIssueContext(c.ctx, linkID)
+}
+
+// UnlinkIssueContext is like UnlinkIssue but uses ctx to cancel in-flight requests.
+func (c *Client) UnlinkIssueContext(ctx context.Context, linkID string) error {
 	deleteLinkURL := fmt.Sprintf("/issueLink/%s", linkID)
-	res, err := c.DeleteV2(context.Background(), deleteLinkURL, Header{
+	res, err := c.DeleteV2(ctx, deleteLinkURL, Header{
 		"Accept":       "application/json",
 		"Content-Type": "application/json",
 	})
@@ -280,7 +325,12 @@ func (c *Client) UnlinkIssue(linkID string) error {
 
 // GetLinkID gets linkID between two issues.
 func (c *Client) GetLinkID(inwardIssue, outwardIssue string) (string, error) {
-	i, err := c.GetIssueV2(inwardIssue)
+	return c.GetLinkIDContext(c.ctx, inwardIssue, outwardIssue)
+}
+
+// GetLinkIDContext is like GetLinkID but uses ctx to cancel in-flight requests.
+func (c *Client) GetLinkIDContext(ctx context.Context, inwardIssue, outwardIssue string) (string, error) {
+	i, err := c.GetIssueV2Context(ctx, inwardIssue)
 	if err != nil {
 		return "", err
 	}
@@ -312,13 +362,18 @@ type issueCommentRequest struct {
 
 // AddIssueComment adds comment to an issue using POST /issue/{key}/comment endpoint.
 func (c *Client) AddIssueComment(key, comment string, internal bool) error {
+	return c.AddIssueCommentContext(c.ctx, key, comment, internal)
+}
+
+// AddIssueCommentContext is like AddIssueComment but uses ctx to cancel in-flight requests.
+func (c *Client) AddIssueCommentContext(ctx context.Context, key, comment string, internal bool) error {
 	body, err := json.Marshal(&issueCommentRequest{Body: md.ToJiraMD(comment), Properties: []issueCommentProperty{{Key: "sd.public.comment", Value: issueCommentPropertyValue{Internal: internal}}}})
 	if err != nil {
 		return err
 	}
 
 	path := fmt.Sprintf("/issue/%s/comment", key)
-	res, err := c.PostV2(context.Background(), path, body, Header{
+	res, err := c.PostV2(ctx, path, body, Header{
 		"Accept":       "application/json",
 		"Content-Type": "application/json",
 	})
@@ -345,6 +400,11 @@ type issueWorklogRequest struct {
 // AddIssueWorklog adds worklog to an issue using POST /issue/{key}/worklog endpoint.
 // Leave param `started` empty to use the server's current datetime as start date.
 func (c *Client) AddIssueWorklog(key, started, timeSpent, comment, newEstimate string) error {
+	return c.AddIssueWorklogContext(c.ctx, key, started, timeSpent, comment, newEstimate)
+}
+
+// AddIssueWorklogContext is like AddIssueWorklog but uses ctx to cancel in-flight requests.
+func (c *Client) AddIssueWorklogContext(ctx context.Context, key, started, timeSpent, comment, newEstimate string) error {
 	worklogReq := issueWorklogRequest{
 		TimeSpent: timeSpent,
 		Comment:   md.ToJiraMD(comment),
@@ -361,7 +421,7 @@ func (c *Client) AddIssueWorklog(key, started, timeSpent, comment, newEstimate s
 	if newEstimate != "" {
 		path = fmt.Sprintf("%s?adjustEstimate=new&newEstimate=%s", path, newEstimate)
 	}
-	res, err := c.PostV2(context.Background(), path, body, Header{
+	res, err := c.PostV2(ctx, path, body, Header{
 		"Accept":       "application/json",
 		"Content-Type": "application/json",
 	})
@@ -381,7 +441,12 @@ func (c *Client) AddIssueWorklog(key, started, timeSpent, comment, newEstimate s
 
 // GetField gets all fields configured for a Jira instance using GET /field endpiont.
 func (c *Client) GetField() ([]*Field, error) {
-	res, err := c.GetV2(context.Background(), "/field", Header{
+	return c.GetFieldContext(c.ctx)
+}
+
+// GetFieldContext is like GetField but uses ctx to cancel in-flight requests.
+func (c *Client) GetFieldContext(ctx context.Context) ([]*Field, error) {
+	res, err := c.GetV2(ctx, "/field", Header{
 		"Accept":       "application/json",
 		"Content-Type": "application/json",
 	})
@@ -431,6 +496,11 @@ type remotelinkRequest struct {
 
 // RemoteLinkIssue adds a remote link to an issue using POST /issue/{issueId}/remotelink endpoint.
 func (c *Client) RemoteLinkIssue(issueID, title, url string) error {
+	return c.RemoteLinkIssueContext(c.ctx, issueID, title, url)
+}
+
+// RemoteLinkIssueContext is like RemoteLinkIssue but uses ctx to cancel in-flight requests.
+func (c *Client) RemoteLinkIssueContext(ctx context.Context, issueID, title, url string) error {
 	body, err := json.Marshal(remotelinkRequest{
 		RemoteObject: struct {
 			URL   string `json:"url"`
@@ -443,7 +513,7 @@ func (c *Client) RemoteLinkIssue(issueID, title, url string) error {
 
 	path := fmt.Sprintf("/issue/%s/remotelink", issueID)
 
-	res, err := c.PostV2(context.Background(), path, body, Header{
+	res, err := c.PostV2(ctx, path, body, Header{
 		"Accept":       "application/json",
 		"Content-Type": "application/json",
 	})
@@ -463,30 +533,50 @@ func (c *Client) RemoteLinkIssue(issueID, title, url string) error {
 
 // WatchIssue adds user as a watcher using v2 version of the POST /issue/{key}/watchers endpoint.
 func (c *Client) WatchIssue(key, watcher string) error {
-	return c.watchIssue(key, watcher, apiVersion3)
+	return c.WatchIssueContext(c.ctx, key, watcher)
+}
+
+// WatchIssueContext is like WatchIssue but uses ctx to cancel in-flight requests.
+func (c *Client) WatchIssueContext(ctx context.Context, key, watcher string) error {
+	return c.watchIssue(ctx, key, watcher, apiVersion3)
 }
 
 // WatchIssueV2 adds user as a watcher using using v2 version of the POST /issue/{key}/watchers endpoint.
 func (c *Client) WatchIssueV2(key, watcher string) error {
-	return c.watchIssue(key, watcher, apiVersion2)
+	return c.WatchIssueV2Context(c.ctx, key, watcher)
+}
+
+// WatchIssueV2Context is like WatchIssueV2 but uses ctx to cancel in-flight requests.
+func (c *Client) WatchIssueV2Context(ctx context.Context, key, watcher string) error {
+	return c.watchIssue(ctx, key, watcher, apiVersion2)
 }
 
 // UnwatchIssue removes user from watchers.
 // For Cloud instances, watcher should be accountId.
 // For Local instances, watcher should be username.
 func (c *Client) UnwatchIssue(key, watcher string) error {
+	return c.UnwatchIssueContext(c.ctx, key, watcher)
+}
+
+// UnwatchIssueContext is like UnwatchIssue but uses ctx to cancel in-flight requests.
+func (c *Client) UnwatchIssueContext(ctx context.Context, key, watcher string) error {
 	// Default to v2, but ProxyUnwatchIssue will handle version selection
-	return c.unwatchIssue(key, watcher, apiVersion2)
+	return c.unwatchIssue(ctx, key, watcher, apiVersion2)
 }
 
-func (c *Client) unwatchIssue(key, watcher, ver string) error {
-	return c.UnwatchIssueWithAccountID(key, watcher, ver == apiVersion2, false)
+func (c *Client) unwatchIssue(ctx context.Context, key, watcher, ver string) error {
+	return c.UnwatchIssueWithAccountIDContext(ctx, key, watcher, ver == apiVersion2, false)
 }
 
 // UnwatchIssueWithAccountID removes user from watchers using the appropriate parameter.
 // For Cloud instances, useAccountID should be true and watcher should be accountId.
 // For Local instances, useAccountID should be false and watcher should be username.
 func (c *Client) UnwatchIssueWithAccountID(key, watcher string, isV2, useAccountID bool) error {
+	return c.UnwatchIssueWithAccountIDContext(c.ctx, key, watcher, isV2, useAccountID)
+}
+
+// UnwatchIssueWithAccountIDContext is like UnwatchIssueWithAccountID but uses ctx to cancel in-flight requests.
+func (c *Client) UnwatchIssueWithAccountIDContext(ctx context.Context, key, watcher string, isV2, useAccountID bool) error {
 	var (
 		res *http.Response
 		err error
@@ -509,9 +599,9 @@ func (c *Client) UnwatchIssueWithAccountID(key, watcher string, isV2, useAccount
 	}
 
 	if isV2 {
-		res, err = c.DeleteV2(context.Background(), path, header)
+		res, err = c.DeleteV2(ctx, path, header)
 	} else {
-		res, err = c.DeleteV2(context.Background(), path, header)
+		res, err = c.DeleteV2(ctx, path, header)
 	}
 
 	if err != nil {
@@ -529,7 +619,7 @@ func (c *Client) UnwatchIssueWithAccountID(key, watcher string, isV2, useAccount
 	return nil
 }
 
-func (c *Client) watchIssue(key, watcher, ver string) error {
+func (c *Client) watchIssue(ctx context.Context, key, watcher, ver string) error {
 	path := fmt.Sprintf("/issue/%s/watchers", key)
 
 	var (
@@ -550,9 +640,9 @@ func (c *Client) watchIssue(key, watcher, ver string) error {
 
 	switch ver {
 	case apiVersion2:
-		res, err = c.PostV2(context.Background(), path, body, header)
+		res, err = c.PostV2(ctx, path, body, header)
 	default:
-		res, err = c.Post(context.Background(), path, body, header)
+		res, err = c.Post(ctx, path, body, header)
 	}
 
 	if err != nil {
@@ -571,22 +661,42 @@ func (c *Client) watchIssue(key, watcher, ver string) error {
 
 // VoteIssue adds a vote to an issue.
 func (c *Client) VoteIssue(key string) error {
-	return c.voteIssue(key, apiVersion2)
+	return c.VoteIssueContext(c.ctx, key)
+}
+
+// VoteIssueContext is like VoteIssue but uses ctx to cancel in-flight requests.
+func (c *Client) VoteIssueContext(ctx context.Context, key string) error {
+	return c.voteIssue(ctx, key, apiVersion2)
 }
 
 // VoteIssueV2 adds a vote to an issue using v2 API.
 func (c *Client) VoteIssueV2(key string) error {
-	return c.voteIssue(key, apiVersion2)
+	return c.VoteIssueV2Context(c.ctx, key)
+}
+
+// VoteIssueV2Context is like VoteIssueV2 but uses ctx to cancel in-flight requests.
+func (c *Client) VoteIssueV2Context(ctx context.Context, key string) error {
+	return c.voteIssue(ctx, key, apiVersion2)
 }
 
 // UnvoteIssue removes a vote from an issue.
 func (c *Client) UnvoteIssue(key string) error {
-	return c.unvoteIssue(key, apiVersion2)
+	return c.UnvoteIssueContext(c.ctx, key)
+}
+
+// UnvoteIssueContext is like UnvoteIssue but uses ctx to cancel in-flight requests.
+func (c *Client) UnvoteIssueContext(ctx context.Context, key string) error {
+	return c.unvoteIssue(ctx, key, apiVersion2)
 }
 
 // UnvoteIssueV2 removes a vote from an issue using v2 API.
 func (c *Client) UnvoteIssueV2(key string) error {
-	return c.unvoteIssue(key, apiVersion2)
+	return c.UnvoteIssueV2Context(c.ctx, key)
+}
+
+// UnvoteIssueV2Context is like UnvoteIssueV2 but uses ctx to cancel in-flight requests.
+func (c *Client) UnvoteIssueV2Context(ctx context.Context, key string) error {
+	return c.unvoteIssue(ctx, key, apiVersion2)
 }
 
 // Voters represents the voters of an issue.
@@ -599,15 +709,25 @@ type Voters struct {
 
 // GetVoters fetches the list of voters for an issue.
 func (c *Client) GetVoters(key string) (*Voters, error) {
-	return c.getVoters(key, apiVersion2)
+	return c.GetVotersContext(c.ctx, key)
+}
+
+// GetVotersContext is like GetVoters but uses ctx to cancel in-flight requests.
+func (c *Client) GetVotersContext(ctx context.Context, key string) (*Voters, error) {
+	return c.getVoters(ctx, key, apiVersion2)
 }
 
 // GetVotersV2 fetches the list of voters for an issue using v2 API.
 func (c *Client) GetVotersV2(key string) (*Voters, error) {
-	return c.getVoters(key, apiVersion2)
+	return c.GetVotersV2Context(c.ctx, key)
+}
+
+// GetVotersV2Context is like GetVotersV2 but uses ctx to cancel in-flight requests.
+func (c *Client) GetVotersV2Context(ctx context.Context, key string) (*Voters, error) {
+	return c.getVoters(ctx, key, apiVersion2)
 }
 
-func (c *Client) voteIssue(key, ver string) error {
+func (c *Client) voteIssue(ctx context.Context, key, ver string) error {
 	path := fmt.Sprintf("/issue/%s/votes", key)
 
 	var (
@@ -626,9 +746,9 @@ func (c *Client) voteIssue(key, ver string) error {
 
 	switch ver {
 	case apiVersion2:
-		res, err = c.PostV2(context.Background(), path, body, header)
+		res, err = c.PostV2(ctx, path, body, header)
 	default:
-		res, err = c.PostV2(context.Background(), path, body, header)
+		res, err = c.PostV2(ctx, path, body, header)
 	}
 
 	if err != nil {
@@ -645,7 +765,7 @@ func (c *Client) voteIssue(key, ver string) error {
 	return nil
 }
 
-func (c *Client) unvoteIssue(key, ver string) error {
+func (c *Client) unvoteIssue(ctx context.Context, key, ver string) error {
 	path := fmt.Sprintf("/issue/%s/votes", key)
 
 	header := Header{
@@ -657,9 +777,9 @@ func (c *Client) unvoteIssue(key, ver string) error {
 
 	switch ver {
 	case apiVersion2:
-		res, err = c.DeleteV2(context.Background(), path, header)
+		res, err = c.DeleteV2(ctx, path, header)
 	default:
-		res, err = c.DeleteV2(context.Background(), path, header)
+		res, err = c.DeleteV2(ctx, path, header)
 	}
 
 	if err != nil {
@@ -677,7 +797,7 @@ func (c *Client) unvoteIssue(key, ver string) error {
 	return nil
 }
 
-func (c *Client) getVoters(key, ver string) (*Voters, error) {
+func (c *Client) getVoters(ctx context.Context, key, ver string) (*Voters, error) {
 	path := fmt.Sprintf("/issue/%s/votes", key)
 
 	var (
@@ -691,9 +811,9 @@ func (c *Client) getVoters(key, ver string) (*Voters, error) {
 
 	switch ver {
 	case apiVersion2:
-		res, err = c.GetV2(context.Background(), path, header)
+		res, err = c.GetV2(ctx, path, header)
 	default:
-		res, err = c.GetV2(context.Background(), path, header)
+		res, err = c.GetV2(ctx, path, header)
 	}
 
 	if err != nil {
@@ -71,9 +71,7 @@ func TestGetIssue(t *testing.T) {
 				IsWatching bool `json:"isWatching"`
 				WatchCount int  `json:"watchCount"`
 			}{IsWatching: true, WatchCount: 1},
-			Status: struct {
-				Name string `json:"name"`
-			}{Name: "To Do"},
+			Status:  IssueStatus{Name: "To Do"},
 			Created: "2020-12-03T14:05:20.974+0100",
 			Updated: "2020-12-03T14:05:20.974+0100",
 			IssueLinks: []struct {
@@ -141,9 +139,7 @@ func TestGetIssueWithoutDescription(t *testing.T) {
 				IsWatching bool `json:"isWatching"`
 				WatchCount int  `json:"watchCount"`
 			}{IsWatching: true, WatchCount: 1},
-			Status: struct {
-				Name string `json:"name"`
-			}{Name: "To Do"},
+			Status:  IssueStatus{Name: "To Do"},
 			Created: "2020-12-03T14:05:20.974+0100",
 			Updated: "2020-12-03T14:05:20.974+0100",
 		},
@@ -192,9 +188,7 @@ func TestGetIssueV2(t *testing.T) {
 				IsWatching bool `json:"isWatching"`
 				WatchCount int  `json:"watchCount"`
 			}{IsWatching: true, WatchCount: 1},
-			Status: struct {
-				Name string `json:"name"`
-			}{Name: "To Do"},
+			Status:  IssueStatus{Name: "To Do"},
 			Created: "2020-12-03T14:05:20.974+0100",
 			Updated: "2020-12-03T14:05:20.974+0100",
 		},
@@ -0,0 +1,188 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// ParsedJQL is a result of parsing a JQL query.
+type ParsedJQL struct {
+	Query    string   `json:"query"`
+	Errors   []string `json:"errors,omitempty"`
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// Valid checks if the query was parsed without errors.
+func (p *ParsedJQL) Valid() bool {
+	return len(p.Errors) == 0
+}
+
+// JQLField is a field that can be used in JQL queries.
+//
+// Jira sends boolean properties of the field as strings.
+type JQLField struct {
+	Value       string   `json:"value"`
+	DisplayName string   `json:"displayName"`
+	Orderable   string   `json:"orderable,omitempty"`
+	Searchable  string   `json:"searchable,omitempty"`
+	CfID        string   `json:"cfid,omitempty"`
+	Operators   []string `json:"operators"`
+	Types       []string `json:"types"`
+}
+
+// JQLFunction is a function that can be used in JQL queries.
+type JQLFunction struct {
+	Value       string   `json:"value"`
+	DisplayName string   `json:"displayName"`
+	IsList      string   `json:"isList,omitempty"`
+	Types       []string `json:"types"`
+}
+
+// JQLAutocompleteData holds fields, functions and reserved words of JQL.
+type JQLAutocompleteData struct {
+	Fields        []*JQLField    `json:"visibleFieldNames"`
+	Functions     []*JQLFunction `json:"visibleFunctionNames"`
+	ReservedWords []string       `json:"jqlReservedWords"`
+}
+
+// JQLSuggestion is a suggested value of a JQL field.
+type JQLSuggestion struct {
+	Value       string `json:"value"`
+	DisplayName string `json:"displayName"`
+}
+
+// ParseJQL parses and validates JQL queries using POST /jql/parse endpoint.
+func (c *Client) ParseJQL(queries ...string) ([]*ParsedJQL, error) {
+	return c.ParseJQLContext(c.ctx, queries...)
+}
+
+// ParseJQLContext is like ParseJQL but uses ctx to cancel in-flight requests.
+func (c *Client) ParseJQLContext(ctx context.Context, queries ...string) ([]*ParsedJQL, error) {
+	body, err := json.Marshal(struct {
+		Queries []string `json:"queries"`
+	}{queries})
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.Post(ctx, "/jql/parse?validation=strict", body, Header{
+		"Accept":       "application/json",
+		"Content-Type": "application/json",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out struct {
+		Queries []*ParsedJQL `json:"queries"`
+	}
+	err = json.NewDecoder(res.Body).Decode(&out)
+	return out.Queries, err
+}
+
+// ValidateJQLV2 validates a JQL query using GET /search endpoint with strict
+// query validation. It is a fallback for Jira servers without /jql/parse endpoint.
+func (c *Client) ValidateJQLV2(jql string) (*ParsedJQL, error) {
+	return c.ValidateJQLV2Context(c.ctx, jql)
+}
+
+// ValidateJQLV2Context is like ValidateJQLV2 but uses ctx to cancel in-flight requests.
+func (c *Client) ValidateJQLV2Context(ctx context.Context, jql string) (*ParsedJQL, error) {
+	path := fmt.Sprintf("/search?jql=%s&maxResults=0&fields=key&validateQuery=strict", url.QueryEscape(jql))
+
+	res, err := c.GetV2(ctx, path, nil)
+
+	// Jira responds with bad request if the query is invalid.
+	var uerr *ErrUnexpectedResponse
+	if errors.As(err, &uerr) && uerr.StatusCode == http.StatusBadRequest {
+		return &ParsedJQL{Query: jql, Errors: uerr.Body.messages(), Warnings: uerr.Body.WarningMessages}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out struct {
+		WarningMessages []string `json:"warningMessages"`
+	}
+	err = json.NewDecoder(res.Body).Decode(&out)
+	return &ParsedJQL{Query: jql, Warnings: out.WarningMessages}, err
+}
+
+// JQLAutocompleteData fetches JQL fields, functions and reserved words
+// using GET /jql/autocompletedata endpoint.
+func (c *Client) JQLAutocompleteData() (*JQLAutocompleteData, error) {
+	return c.JQLAutocompleteDataContext(c.ctx)
+}
+
+// JQLAutocompleteDataContext is like JQLAutocompleteData but uses ctx to cancel in-flight requests.
+func (c *Client) JQLAutocompleteDataContext(ctx context.Context) (*JQLAutocompleteData, error) {
+	res, err := c.GetV2(ctx, "/jql/autocompletedata", nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out JQLAutocompleteData
+	err = json.NewDecoder(res.Body).Decode(&out)
+	return &out, err
+}
+
+// JQLSuggestions fetches suggested values of a JQL field that start with the given
+// value using GET /jql/autocompletedata/suggestions endpoint.
+func (c *Client) JQLSuggestions(field, value string) ([]*JQLSuggestion, error) {
+	return c.JQLSuggestionsContext(c.ctx, field, value)
+}
+
+// JQLSuggestionsContext is like JQLSuggestions but uses ctx to cancel in-flight requests.
+func (c *Client) JQLSuggestionsContext(ctx context.Context, field, value string) ([]*JQLSuggestion, error) {
+	path := fmt.Sprintf(
+		"/jql/autocompletedata/suggestions?fieldName=%s&fieldValue=%s",
+		url.QueryEscape(field), url.QueryEscape(value),
+	)
+
+	res, err := c.GetV2(ctx, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out struct {
+		Results []*JQLSuggestion `json:"results"`
+	}
+	err = json.NewDecoder(res.Body).Decode(&out)
+	return out.Results, err
+}
@@ -0,0 +1,120 @@
+package jira
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseJQL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/3/jql/parse", r.URL.Path)
+		assert.Equal(t, "strict", r.URL.Query().Get("validation"))
+		assert.Equal(t, http.MethodPost, r.Method)
+
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"queries": ["project = TEST", "stauts = Done"]}`, string(body))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{"queries": [
+			{"query": "project = TEST", "structure": {"where": {}}},
+			{"query": "stauts = Done", "errors": ["Field 'stauts' does not exist or you do not have permission to view it."]}
+		]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.ParseJQL("project = TEST", "stauts = Done")
+	assert.NoError(t, err)
+	assert.Len(t, actual, 2)
+	assert.True(t, actual[0].Valid())
+	assert.False(t, actual[1].Valid())
+	assert.Equal(t, []string{"Field 'stauts' does not exist or you do not have permission to view it."}, actual[1].Errors)
+}
+
+func TestValidateJQLV2(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/search", r.URL.Path)
+		assert.Equal(t, "strict", r.URL.Query().Get("validateQuery"))
+		assert.Equal(t, "0", r.URL.Query().Get("maxResults"))
+
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("jql") == "project = TEST" {
+			w.WriteHeader(200)
+			_, _ = w.Write([]byte(`{"startAt": 0, "maxResults": 0, "total": 10, "issues": []}`))
+			return
+		}
+		w.WriteHeader(400)
+		_, _ = w.Write([]byte(`{"errorMessages": ["The value 'Foo' does not exist for the field 'status'."], "errors": {}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.ValidateJQLV2("project = TEST")
+	assert.NoError(t, err)
+	assert.True(t, actual.Valid())
+
+	actual, err = client.ValidateJQLV2("status = Foo")
+	assert.NoError(t, err)
+	assert.Equal(t, &ParsedJQL{
+		Query:  "status = Foo",
+		Errors: []string{"The value 'Foo' does not exist for the field 'status'."},
+	}, actual)
+}
+
+func TestJQLAutocompleteData(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/jql/autocompletedata", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{
+			"visibleFieldNames": [
+				{"value": "status", "displayName": "status", "orderable": "true", "searchable": "true", "operators": ["=", "!=", "in", "not in", "was"], "types": ["com.atlassian.jira.issue.status.Status"]},
+				{"value": "cf[10010]", "displayName": "Story Points - cf[10010]", "orderable": "true", "cfid": "cf[10010]", "operators": ["=", ">", "<"], "types": ["java.lang.Number"]}
+			],
+			"visibleFunctionNames": [
+				{"value": "currentUser()", "displayName": "currentUser()", "types": ["com.atlassian.jira.user.ApplicationUser"]}
+			],
+			"jqlReservedWords": ["and", "or", "order"]
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.JQLAutocompleteData()
+	assert.NoError(t, err)
+	assert.Len(t, actual.Fields, 2)
+	assert.Equal(t, "cf[10010]", actual.Fields[1].CfID)
+	assert.Equal(t, []string{"=", "!=", "in", "not in", "was"}, actual.Fields[0].Operators)
+	assert.Equal(t, "currentUser()", actual.Functions[0].Value)
+	assert.Equal(t, []string{"and", "or", "order"}, actual.ReservedWords)
+}
+
+func TestJQLSuggestions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/jql/autocompletedata/suggestions", r.URL.Path)
+		assert.Equal(t, "status", r.URL.Query().Get("fieldName"))
+		assert.Equal(t, "in p", r.URL.Query().Get("fieldValue"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{"results": [{"value": "\"In Progress\"", "displayName": "<b>In P</b>rogress"}]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.JQLSuggestions("status", "in p")
+	assert.NoError(t, err)
+	assert.Equal(t, []*JQLSuggestion{{Value: `"In Progress"`, DisplayName: "<b>In P</b>rogress"}}, actual)
+}
@@ -0,0 +1,225 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// IssueLink is a link between two issues. Only the issue on the other end of the
+// link is set, the link belongs to the issue it was fetched from.
+type IssueLink struct {
+	ID           string        `json:"id"`
+	Type         IssueLinkType `json:"type"`
+	InwardIssue  *Issue        `json:"inwardIssue,omitempty"`
+	OutwardIssue *Issue        `json:"outwardIssue,omitempty"`
+}
+
+// Linked returns the issue on the other end of the link and the relation
+// of the issue the link belongs to, eg: "blocks" or "is blocked by".
+func (l *IssueLink) Linked() (*Issue, string) {
+	if l.InwardIssue != nil {
+		return l.InwardIssue, l.Type.Inward
+	}
+	return l.OutwardIssue, l.Type.Outward
+}
+
+// RemoteLink is a link from an issue to an object in another system, eg: a web page.
+type RemoteLink struct {
+	ID   int    `json:"id,omitempty"`
+	Self string `json:"self,omitempty"`
+	// GlobalID identifies the remote object. Creating a link with an
+	// existing global id updates the link instead.
+	GlobalID     string                 `json:"globalId,omitempty"`
+	Application  *RemoteLinkApplication `json:"application,omitempty"`
+	Relationship string                 `json:"relationship,omitempty"`
+	Object       RemoteLinkObject       `json:"object"`
+}
+
+// RemoteLinkApplication is the application a remote object belongs to.
+type RemoteLinkApplication struct {
+	Type string `json:"type,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+// RemoteLinkObject is the remote object of a remote link.
+type RemoteLinkObject struct {
+	URL     string          `json:"url"`
+	Title   string          `json:"title"`
+	Summary string          `json:"summary,omitempty"`
+	Icon    *RemoteLinkIcon `json:"icon,omitempty"`
+}
+
+// RemoteLinkIcon is the icon shown next to a remote link.
+type RemoteLinkIcon struct {
+	URL   string `json:"url16x16,omitempty"`
+	Title string `json:"title,omitempty"`
+}
+
+// GetIssueLinks fetches links of an issue using GET /issue/{key} endpoint.
+func (c *Client) GetIssueLinks(key string) ([]*IssueLink, error) {
+	return c.GetIssueLinksContext(c.ctx, key)
+}
+
+// GetIssueLinksContext is like GetIssueLinks but uses ctx to cancel in-flight requests.
+func (c *Client) GetIssueLinksContext(ctx context.Context, key string) ([]*IssueLink, error) {
+	res, err := c.GetV2(ctx, fmt.Sprintf("/issue/%s?fields=issuelinks", key), nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out struct {
+		Fields struct {
+			IssueLinks []*IssueLink `json:"issuelinks"`
+		} `json:"fields"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+
+	return out.Fields.IssueLinks, nil
+}
+
+// GetRemoteLinks fetches remote links of an issue using GET /issue/{key}/remotelink endpoint.
+func (c *Client) GetRemoteLinks(key string) ([]*RemoteLink, error) {
+	return c.GetRemoteLinksContext(c.ctx, key)
+}
+
+// GetRemoteLinksContext is like GetRemoteLinks but uses ctx to cancel in-flight requests.
+func (c *Client) GetRemoteLinksContext(ctx context.Context, key string) ([]*RemoteLink, error) {
+	res, err := c.GetV2(ctx, fmt.Sprintf("/issue/%s/remotelink", key), nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out []*RemoteLink
+	if err := json.NewDecoder(res.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// CreateRemoteLink adds a remote link to an issue using POST /issue/{key}/remotelink endpoint.
+// The link is updated instead if a link with the same global id exists.
+func (c *Client) CreateRemoteLink(key string, link *RemoteLink) (*RemoteLink, error) {
+	return c.CreateRemoteLinkContext(c.ctx, key, link)
+}
+
+// CreateRemoteLinkContext is like CreateRemoteLink but uses ctx to cancel in-flight requests.
+func (c *Client) CreateRemoteLinkContext(ctx context.Context, key string, link *RemoteLink) (*RemoteLink, error) {
+	body, err := json.Marshal(link)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.PostV2(ctx, fmt.Sprintf("/issue/%s/remotelink", key), body, Header{
+		"Accept":       "application/json",
+		"Content-Type": "application/json",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusCreated && res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out RemoteLink
+	if err := json.NewDecoder(res.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+
+	return &out, nil
+}
+
+// UpdateRemoteLink replaces a remote link of an issue using PUT /issue/{key}/remotelink/{id} endpoint.
+func (c *Client) UpdateRemoteLink(key string, id int, link *RemoteLink) error {
+	return c.UpdateRemoteLinkContext(c.ctx, key, id, link)
+}
+
+// UpdateRemoteLinkContext is like UpdateRemoteLink but uses ctx to cancel in-flight requests.
+func (c *Client) UpdateRemoteLinkContext(ctx context.Context, key string, id int, link *RemoteLink) error {
+	body, err := json.Marshal(link)
+	if err != nil {
+		return err
+	}
+
+	res, err := c.PutV2(ctx, fmt.Sprintf("/issue/%s/remotelink/%d", key, id), body, Header{
+		"Accept":       "application/json",
+		"Content-Type": "application/json",
+	})
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusNoContent {
+		return formatUnexpectedResponse(res)
+	}
+	return nil
+}
+
+// DeleteRemoteLink deletes a remote link of an issue using DELETE /issue/{key}/remotelink/{id} endpoint.
+func (c *Client) DeleteRemoteLink(key string, id int) error {
+	return c.DeleteRemoteLinkContext(c.ctx, key, id)
+}
+
+// DeleteRemoteLinkContext is like DeleteRemoteLink but uses ctx to cancel in-flight requests.
+func (c *Client) DeleteRemoteLinkContext(ctx context.Context, key string, id int) error {
+	return c.deleteRemoteLink(ctx, fmt.Sprintf("/issue/%s/remotelink/%d", key, id))
+}
+
+// DeleteRemoteLinkByGlobalID deletes a remote link of an issue by its global id
+// using DELETE /issue/{key}/remotelink endpoint.
+func (c *Client) DeleteRemoteLinkByGlobalID(key, globalID string) error {
+	return c.DeleteRemoteLinkByGlobalIDContext(c.ctx, key, globalID)
+}
+
+// DeleteRemoteLinkByGlobalIDContext is like DeleteRemoteLinkByGlobalID but uses ctx to cancel in-flight requests.
+func (c *Client) DeleteRemoteLinkByGlobalIDContext(ctx context.Context, key, globalID string) error {
+	return c.deleteRemoteLink(ctx, fmt.Sprintf("/issue/%s/remotelink?globalId=%s", key, url.QueryEscape(globalID)))
+}
+
+func (c *Client) deleteRemoteLink(ctx context.Context, path string) error {
+	res, err := c.DeleteV2(ctx, path, Header{
+		"Accept": "application/json",
+	})
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusNoContent {
+		return formatUnexpectedResponse(res)
+	}
+	return nil
+}
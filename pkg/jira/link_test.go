@@ -0,0 +1,219 @@
+package jira
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetIssueLinks(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/issue/TEST-1", r.URL.Path)
+		assert.Equal(t, "issuelinks", r.URL.Query().Get("fields"))
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{"key": "TEST-1", "fields": {"issuelinks": [
+			{"id": "10001", "type": {"id": "1", "name": "Blocks", "inward": "is blocked by", "outward": "blocks"},
+				"outwardIssue": {"key": "TEST-2", "fields": {"summary": "Second", "status": {"name": "To Do"}}}},
+			{"id": "10002", "type": {"id": "2", "name": "Relates", "inward": "relates to", "outward": "relates to"},
+				"inwardIssue": {"key": "TEST-3", "fields": {"summary": "Third", "status": {"name": "Done"}}}}
+		]}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	links, err := client.GetIssueLinks("TEST-1")
+	assert.NoError(t, err)
+	assert.Len(t, links, 2)
+
+	iss, rel := links[0].Linked()
+	assert.Equal(t, "10001", links[0].ID)
+	assert.Equal(t, "Blocks", links[0].Type.Name)
+	assert.Equal(t, "TEST-2", iss.Key)
+	assert.Equal(t, "To Do", iss.Fields.Status.Name)
+	assert.Equal(t, "blocks", rel)
+
+	iss, rel = links[1].Linked()
+	assert.Equal(t, "TEST-3", iss.Key)
+	assert.Equal(t, "relates to", rel)
+
+	unexpectedStatusCode = true
+
+	_, err = client.GetIssueLinks("TEST-1")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestGetRemoteLinks(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/issue/TEST-1/remotelink", r.URL.Path)
+		assert.Equal(t, http.MethodGet, r.Method)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`[{"id": 10000, "self": "https://test.atlassian.net/rest/api/2/issue/TEST-1/remotelink/10000",
+			"globalId": "system=https://ci.example.com&id=42", "relationship": "built by",
+			"object": {"url": "https://ci.example.com/42", "title": "Build #42", "summary": "Passed",
+				"icon": {"url16x16": "https://ci.example.com/favicon.ico", "title": "CI"}}}]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	links, err := client.GetRemoteLinks("TEST-1")
+	assert.NoError(t, err)
+
+	expected := []*RemoteLink{{
+		ID:           10000,
+		Self:         "https://test.atlassian.net/rest/api/2/issue/TEST-1/remotelink/10000",
+		GlobalID:     "system=https://ci.example.com&id=42",
+		Relationship: "built by",
+		Object: RemoteLinkObject{
+			URL:     "https://ci.example.com/42",
+			Title:   "Build #42",
+			Summary: "Passed",
+			Icon:    &RemoteLinkIcon{URL: "https://ci.example.com/favicon.ico", Title: "CI"},
+		},
+	}}
+	assert.Equal(t, expected, links)
+
+	unexpectedStatusCode = true
+
+	_, err = client.GetRemoteLinks("TEST-1")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestCreateRemoteLink(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/issue/TEST-1/remotelink", r.URL.Path)
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+
+		var actual map[string]any
+		assert.NoError(t, json.Unmarshal(body, &actual))
+		assert.Equal(t, map[string]any{
+			"globalId": "build-42",
+			"object": map[string]any{
+				"url":   "https://ci.example.com/42",
+				"title": "Build #42",
+				"icon":  map[string]any{"url16x16": "https://ci.example.com/favicon.ico"},
+			},
+		}, actual)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(201)
+		_, _ = w.Write([]byte(`{"id": 10000, "self": "https://test.atlassian.net/rest/api/2/issue/TEST-1/remotelink/10000"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	link := RemoteLink{
+		GlobalID: "build-42",
+		Object: RemoteLinkObject{
+			URL:   "https://ci.example.com/42",
+			Title: "Build #42",
+			Icon:  &RemoteLinkIcon{URL: "https://ci.example.com/favicon.ico"},
+		},
+	}
+
+	out, err := client.CreateRemoteLink("TEST-1", &link)
+	assert.NoError(t, err)
+	assert.Equal(t, 10000, out.ID)
+	assert.Equal(t, "https://test.atlassian.net/rest/api/2/issue/TEST-1/remotelink/10000", out.Self)
+
+	unexpectedStatusCode = true
+
+	_, err = client.CreateRemoteLink("TEST-1", &link)
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestUpdateRemoteLink(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/issue/TEST-1/remotelink/10000", r.URL.Path)
+		assert.Equal(t, http.MethodPut, r.Method)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			w.WriteHeader(204)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	link := RemoteLink{Object: RemoteLinkObject{URL: "https://example.com", Title: "Example"}}
+
+	err := client.UpdateRemoteLink("TEST-1", 10000, &link)
+	assert.NoError(t, err)
+
+	unexpectedStatusCode = true
+
+	err = client.UpdateRemoteLink("TEST-1", 10000, &link)
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestDeleteRemoteLink(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+
+		if r.URL.Query().Has("globalId") {
+			assert.Equal(t, "/rest/api/2/issue/TEST-1/remotelink", r.URL.Path)
+			assert.Equal(t, "system=https://ci.example.com&id=42", r.URL.Query().Get("globalId"))
+		} else {
+			assert.Equal(t, "/rest/api/2/issue/TEST-1/remotelink/10000", r.URL.Path)
+		}
+
+		if unexpectedStatusCode {
+			w.WriteHeader(404)
+		} else {
+			w.WriteHeader(204)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	assert.NoError(t, client.DeleteRemoteLink("TEST-1", 10000))
+	assert.NoError(t, client.DeleteRemoteLinkByGlobalID("TEST-1", "system=https://ci.example.com&id=42"))
+
+	unexpectedStatusCode = true
+
+	err := client.DeleteRemoteLink("TEST-1", 10000)
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
@@ -8,15 +8,21 @@ import (
 
 // Me struct holds response from /myself endpoint.
 type Me struct {
-	Login    string `json:"name"`
-	Name     string `json:"displayName"`
-	Email    string `json:"emailAddress"`
-	Timezone string `json:"timeZone"`
+	AccountID string `json:"accountId,omitempty"`
+	Login     string `json:"name"`
+	Name      string `json:"displayName"`
+	Email     string `json:"emailAddress"`
+	Timezone  string `json:"timeZone"`
 }
 
 // Me fetches response from /myself endpoint.
 func (c *Client) Me() (*Me, error) {
-	res, err := c.GetV2(context.Background(), "/myself", nil)
+	return c.MeContext(c.ctx)
+}
+
+// MeContext is like Me but uses ctx to cancel in-flight requests.
+func (c *Client) MeContext(ctx context.Context) (*Me, error) {
+	res, err := c.GetV2(ctx, "/myself", nil)
 	if err != nil {
 		return nil, err
 	}
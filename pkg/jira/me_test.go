@@ -35,8 +35,9 @@ func TestMe(t *testing.T) {
 	assert.NoError(t, err)
 
 	expected := &Me{
-		Name:  "Person A",
-		Email: "user@test.com",
+		AccountID: "5b10a2844c20165700ede21g",
+		Name:      "Person A",
+		Email:     "user@test.com",
 	}
 	assert.Equal(t, expected, actual)
 
@@ -0,0 +1,233 @@
+package jira
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	otlpTracesPath = "/v1/traces"
+	otlpTimeout    = 2 * time.Second
+
+	// Spans are exported in the background when the batch is full or the interval elapses.
+	otlpBatchSize     = 64
+	otlpBatchInterval = 5 * time.Second
+
+	// https://opentelemetry.io/docs/specs/otel/trace/api/#spankind
+	otlpSpanKindClient = 3
+	// https://opentelemetry.io/docs/specs/otel/trace/api/#set-status
+	otlpStatusError = 2
+)
+
+// OTLPExporter is a trace recorder that sends requests as OpenTelemetry spans
+// to a collector using the OTLP/HTTP JSON protocol.
+//
+// All requests of the exporter share a trace id so that a command shows up as a single trace.
+// Spans are batched and exported in the background, call Flush to export the pending spans.
+type OTLPExporter struct {
+	endpoint string
+	service  string
+	version  string
+	traceID  string
+	client   *http.Client
+
+	mu    sync.Mutex
+	spans []map[string]any
+	wake  chan struct{}
+	flush chan chan error
+}
+
+// NewOTLPExporter constructs an exporter for the given collector endpoint, eg: http://localhost:4318.
+func NewOTLPExporter(endpoint, serviceVersion string) *OTLPExporter {
+	endpoint = strings.TrimSuffix(endpoint, "/")
+	if !strings.HasSuffix(endpoint, otlpTracesPath) {
+		endpoint += otlpTracesPath
+	}
+
+	e := OTLPExporter{
+		endpoint: endpoint,
+		service:  "jira-cli",
+		version:  serviceVersion,
+		traceID:  randomHex(16),
+		client:   &http.Client{Timeout: otlpTimeout},
+		wake:     make(chan struct{}, 1),
+		flush:    make(chan chan error),
+	}
+	go e.run()
+
+	return &e
+}
+
+// TraceID returns the id of the trace spans are exported to.
+func (e *OTLPExporter) TraceID() string {
+	return e.traceID
+}
+
+// Record implements TraceRecorder.
+func (e *OTLPExporter) Record(entry *TraceEntry) {
+	e.mu.Lock()
+	e.spans = append(e.spans, e.span(entry))
+	full := len(e.spans) >= otlpBatchSize
+	e.mu.Unlock()
+
+	if full {
+		select {
+		case e.wake <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Flush implements TraceFlusher. It exports the pending spans and waits for the export to finish.
+func (e *OTLPExporter) Flush() error {
+	done := make(chan error, 1)
+	e.flush <- done
+	return <-done
+}
+
+func (e *OTLPExporter) run() {
+	ticker := time.NewTicker(otlpBatchInterval)
+	defer ticker.Stop()
+
+	for {
+		var err error
+
+		select {
+		case <-ticker.C:
+			err = e.export()
+		case <-e.wake:
+			err = e.export()
+		case done := <-e.flush:
+			done <- e.export()
+			continue
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s\n", err)
+		}
+	}
+}
+
+func (e *OTLPExporter) export() error {
+	e.mu.Lock()
+	spans := e.spans
+	e.spans = nil
+	e.mu.Unlock()
+
+	if len(spans) == 0 {
+		return nil
+	}
+
+	b, err := json.Marshal(e.payload(spans))
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), otlpTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.endpoint, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := e.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("unable to export trace to %s: %w", e.endpoint, err)
+	}
+	_ = res.Body.Close()
+
+	if res.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("unable to export trace to %s: %s", e.endpoint, res.Status)
+	}
+	return nil
+}
+
+type otlpAttribute struct {
+	Key   string         `json:"key"`
+	Value map[string]any `json:"value"`
+}
+
+func (e *OTLPExporter) span(entry *TraceEntry) map[string]any {
+	u, _ := url.Parse(entry.URL)
+
+	attrs := []otlpAttribute{
+		stringAttr("http.request.method", entry.Method),
+		stringAttr("url.full", entry.URL),
+	}
+	name := entry.Method
+	if u != nil {
+		name += " " + u.Path
+		attrs = append(attrs, stringAttr("server.address", u.Hostname()))
+	}
+	if entry.StatusCode > 0 {
+		attrs = append(attrs, intAttr("http.response.status_code", entry.StatusCode))
+	}
+	if v := entry.Header.Get(CacheStatusHeader); v != "" {
+		attrs = append(attrs, stringAttr("jira_cli.cache", v))
+	}
+
+	status := map[string]any{}
+	if entry.Err != nil || entry.StatusCode >= http.StatusBadRequest {
+		status["code"] = otlpStatusError
+		if entry.Err != nil {
+			status["message"] = entry.Err.Error()
+		} else {
+			status["message"] = entry.Status
+		}
+	}
+
+	return map[string]any{
+		"traceId":           e.traceID,
+		"spanId":            randomHex(8),
+		"name":              name,
+		"kind":              otlpSpanKindClient,
+		"startTimeUnixNano": strconv.FormatInt(entry.Start.UnixNano(), 10),
+		"endTimeUnixNano":   strconv.FormatInt(entry.Start.Add(entry.Duration).UnixNano(), 10),
+		"attributes":        attrs,
+		"status":            status,
+	}
+}
+
+func (e *OTLPExporter) payload(spans []map[string]any) map[string]any {
+	return map[string]any{
+		"resourceSpans": []any{map[string]any{
+			"resource": map[string]any{
+				"attributes": []otlpAttribute{
+					stringAttr("service.name", e.service),
+					stringAttr("service.version", e.version),
+				},
+			},
+			"scopeSpans": []any{map[string]any{
+				"scope": map[string]any{"name": "github.com/ankitpokhrel/jira-cli/pkg/jira"},
+				"spans": spans,
+			}},
+		}},
+	}
+}
+
+func stringAttr(k, v string) otlpAttribute {
+	return otlpAttribute{Key: k, Value: map[string]any{"stringValue": v}}
+}
+
+func intAttr(k string, v int) otlpAttribute {
+	// OTLP JSON encodes 64 bit integers as strings.
+	return otlpAttribute{Key: k, Value: map[string]any{"intValue": strconv.Itoa(v)}}
+}
+
+func randomHex(n int) string {
+	b := make([]byte, n)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -3,6 +3,7 @@ package jira
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 )
 
@@ -13,9 +14,21 @@ const (
 	ProjectTypeNextGen = "next-gen"
 )
 
+// IssueTypeStatuses holds statuses that issues of an issue type can be in.
+type IssueTypeStatuses struct {
+	ID       string              `json:"id"`
+	Name     string              `json:"name"`
+	Statuses []*TransitionStatus `json:"statuses"`
+}
+
 // Project fetches response from /project endpoint.
 func (c *Client) Project() ([]*Project, error) {
-	res, err := c.GetV2(context.Background(), "/project?expand=lead", nil)
+	return c.ProjectContext(c.ctx)
+}
+
+// ProjectContext is like Project but uses ctx to cancel in-flight requests.
+func (c *Client) ProjectContext(ctx context.Context) ([]*Project, error) {
+	res, err := c.GetV2(ctx, "/project?expand=lead", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -34,3 +47,29 @@ func (c *Client) Project() ([]*Project, error) {
 
 	return out, err
 }
+
+// ProjectStatuses fetches statuses of a project grouped by issue type
+// using GET /project/{key}/statuses endpoint.
+func (c *Client) ProjectStatuses(key string) ([]*IssueTypeStatuses, error) {
+	return c.ProjectStatusesContext(c.ctx, key)
+}
+
+// ProjectStatusesContext is like ProjectStatuses but uses ctx to cancel in-flight requests.
+func (c *Client) ProjectStatusesContext(ctx context.Context, key string) ([]*IssueTypeStatuses, error) {
+	res, err := c.GetV2(ctx, fmt.Sprintf("/project/%s/statuses", key), nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out []*IssueTypeStatuses
+	err = json.NewDecoder(res.Body).Decode(&out)
+	return out, err
+}
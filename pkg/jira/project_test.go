@@ -71,3 +71,34 @@ func TestProjects(t *testing.T) {
 	_, err = client.Project()
 	assert.Error(t, &ErrUnexpectedResponse{}, err)
 }
+
+func TestProjectStatuses(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/project/TEST/statuses", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`[
+			{"id": "10001", "name": "Bug", "statuses": [
+				{"id": "1", "name": "To Do", "statusCategory": {"key": "new", "name": "To Do"}},
+				{"id": "3", "name": "Done", "statusCategory": {"key": "done", "name": "Done"}}
+			]}
+		]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.ProjectStatuses("TEST")
+	assert.NoError(t, err)
+	assert.Equal(t, []*IssueTypeStatuses{
+		{
+			ID:   "10001",
+			Name: "Bug",
+			Statuses: []*TransitionStatus{
+				{ID: "1", Name: "To Do", StatusCategory: StatusCategory{Key: "new", Name: "To Do"}},
+				{ID: "3", Name: "Done", StatusCategory: StatusCategory{Key: "done", Name: "Done"}},
+			},
+		},
+	}, actual)
+}
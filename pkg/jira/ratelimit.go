@@ -0,0 +1,220 @@
+package jira
+
+import (
+	"context"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	headerRetryAfter         = "Retry-After"
+	headerRateLimitRemaining = "X-RateLimit-Remaining"
+	headerRateLimitReset     = "X-RateLimit-Reset"
+)
+
+// RetryPolicy configures how failed requests are retried.
+//
+// Requests are retried on network errors and 5xx responses if the method is idempotent
+// or the request context is marked with WithRetrySafe. Rate limited requests are always
+// retried since the server rejects them before doing any work.
+type RetryPolicy struct {
+	// MaxRetries is the number of retries after the first attempt.
+	MaxRetries int
+	// BaseDelay is the delay before the first retry, it doubles on every retry.
+	BaseDelay time.Duration
+	// MaxDelay caps the exponential backoff delay.
+	MaxDelay time.Duration
+	// MaxRetryAfter is the longest delay requested by the server the client is willing to wait.
+	MaxRetryAfter time.Duration
+}
+
+// DefaultRetryPolicy returns the default retry policy.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries:    3,
+		BaseDelay:     time.Second,
+		MaxDelay:      30 * time.Second,
+		MaxRetryAfter: time.Minute,
+	}
+}
+
+// backoff returns jittered exponential backoff delay for the given retry attempt starting at 0.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	d := p.BaseDelay << attempt
+	if d <= 0 || d > p.MaxDelay {
+		d = p.MaxDelay
+	}
+	if d <= 1 {
+		return d
+	}
+	// Equal jitter keeps at least half of the delay while spreading concurrent clients.
+	half := d / 2
+	return half + rand.N(half+1)
+}
+
+// RateLimiter is a token bucket rate limiter.
+//
+// Requests are also paused if the server reports that the rate limit is exhausted.
+type RateLimiter struct {
+	mu          sync.Mutex
+	rate        float64
+	burst       float64
+	tokens      float64
+	last        time.Time
+	pausedUntil time.Time
+	now         func() time.Time
+}
+
+// NewRateLimiter constructs a rate limiter that allows rps requests per second
+// with bursts of up to burst requests. A rate of 0 disables client-side limiting.
+func NewRateLimiter(rps float64, burst int) *RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &RateLimiter{
+		rate:   rps,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		now:    time.Now,
+	}
+}
+
+// Wait blocks until a request is allowed or the context is done.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	d := l.reserve()
+	if d <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// PauseUntil blocks all requests until the given time.
+func (l *RateLimiter) PauseUntil(t time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if t.After(l.pausedUntil) {
+		l.pausedUntil = t
+	}
+}
+
+// reserve takes a token and returns how long the caller has to wait before using it.
+func (l *RateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+
+	var wait time.Duration
+	if now.Before(l.pausedUntil) {
+		wait = l.pausedUntil.Sub(now)
+	}
+	if l.rate <= 0 {
+		return wait
+	}
+
+	if !l.last.IsZero() {
+		l.tokens += now.Sub(l.last).Seconds() * l.rate
+		if l.tokens > l.burst {
+			l.tokens = l.burst
+		}
+	}
+	l.last = now
+
+	// Tokens can go negative, the debt is paid by waiting.
+	l.tokens--
+	if l.tokens < 0 {
+		if d := time.Duration(-l.tokens / l.rate * float64(time.Second)); d > wait {
+			wait = d
+		}
+	}
+	return wait
+}
+
+// limitTransport is a http.RoundTripper that applies the rate limiter to outgoing requests.
+type limitTransport struct {
+	next    http.RoundTripper
+	limiter *RateLimiter
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *limitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.limiter.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+
+	res, err := t.next.RoundTrip(req)
+	if err != nil {
+		return res, err
+	}
+	if res.Header.Get(headerRateLimitRemaining) == "0" {
+		if reset, ok := parseRateLimitReset(res.Header.Get(headerRateLimitReset)); ok {
+			t.limiter.PauseUntil(reset)
+		}
+	}
+	return res, nil
+}
+
+type retrySafeKey struct{}
+
+// WithRetrySafe marks requests made with the context as safe to retry
+// even if the http method is not idempotent.
+func WithRetrySafe(ctx context.Context) context.Context {
+	return context.WithValue(ctx, retrySafeKey{}, true)
+}
+
+func isRetrySafe(ctx context.Context, method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	safe, _ := ctx.Value(retrySafeKey{}).(bool)
+	return safe
+}
+
+// retryAfter returns how long the server asked the client to wait before the next request.
+func retryAfter(headers http.Header, now time.Time) time.Duration {
+	if v := headers.Get(headerRetryAfter); v != "" {
+		if secs, err := strconv.Atoi(v); err == nil && secs > 0 {
+			return time.Duration(secs) * time.Second
+		}
+		if t, err := http.ParseTime(v); err == nil && t.After(now) {
+			return t.Sub(now)
+		}
+	}
+	if headers.Get(headerRateLimitRemaining) == "0" {
+		if t, ok := parseRateLimitReset(headers.Get(headerRateLimitReset)); ok && t.After(now) {
+			return t.Sub(now)
+		}
+	}
+	return 0
+}
+
+// parseRateLimitReset parses reset time sent as an ISO 8601 timestamp
+// by Atlassian Cloud or as unix seconds by some proxies.
+func parseRateLimitReset(v string) (time.Time, bool) {
+	if v == "" {
+		return time.Time{}, false
+	}
+	if secs, err := strconv.ParseInt(v, 10, 64); err == nil {
+		return time.Unix(secs, 0), true
+	}
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04Z07:00"} {
+		if t, err := time.Parse(layout, v); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,103 @@
+package jira
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimiterReserve(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	l := NewRateLimiter(2, 2)
+	l.now = func() time.Time { return now }
+
+	// Burst is allowed without waiting, then requests are spaced by 1/rate.
+	assert.Equal(t, time.Duration(0), l.reserve())
+	assert.Equal(t, time.Duration(0), l.reserve())
+	assert.Equal(t, 500*time.Millisecond, l.reserve())
+	assert.Equal(t, time.Second, l.reserve())
+
+	// Tokens are refilled over time.
+	now = now.Add(3 * time.Second)
+	assert.Equal(t, time.Duration(0), l.reserve())
+
+	// Server reported pause takes precedence.
+	l.PauseUntil(now.Add(10 * time.Second))
+	assert.Equal(t, 10*time.Second, l.reserve())
+}
+
+func TestRateLimiterUnlimited(t *testing.T) {
+	t.Parallel()
+
+	l := NewRateLimiter(0, 0)
+	for i := 0; i < 100; i++ {
+		assert.Equal(t, time.Duration(0), l.reserve())
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name     string
+		headers  http.Header
+		expected time.Duration
+	}{
+		{
+			name:     "seconds",
+			headers:  http.Header{"Retry-After": []string{"30"}},
+			expected: 30 * time.Second,
+		},
+		{
+			name:     "http date",
+			headers:  http.Header{"Retry-After": []string{"Mon, 01 Jan 2024 12:01:00 GMT"}},
+			expected: time.Minute,
+		},
+		{
+			name: "rate limit reset",
+			headers: http.Header{
+				"X-Ratelimit-Remaining": []string{"0"},
+				"X-Ratelimit-Reset":     []string{"2024-01-01T12:02Z"},
+			},
+			expected: 2 * time.Minute,
+		},
+		{
+			name: "remaining quota",
+			headers: http.Header{
+				"X-Ratelimit-Remaining": []string{"10"},
+				"X-Ratelimit-Reset":     []string{"2024-01-01T12:02Z"},
+			},
+			expected: 0,
+		},
+		{
+			name:     "empty",
+			headers:  http.Header{},
+			expected: 0,
+		},
+	}
+
+	for _, tc := range cases {
+		assert.Equal(t, tc.expected, retryAfter(tc.headers, now), tc.name)
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	t.Parallel()
+
+	p := RetryPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+
+	for attempt, max := range []time.Duration{100, 200, 400, 800, 1000, 1000} {
+		max *= time.Millisecond
+
+		d := p.backoff(attempt)
+		assert.GreaterOrEqual(t, d, max/2)
+		assert.LessOrEqual(t, d, max)
+	}
+}
@@ -9,8 +9,13 @@ import (
 
 // Release fetches response from /project/{projectIdOrKey}/version endpoint.
 func (c *Client) Release(project string) ([]*ProjectVersion, error) {
+	return c.ReleaseContext(c.ctx, project)
+}
+
+// ReleaseContext is like Release but uses ctx to cancel in-flight requests.
+func (c *Client) ReleaseContext(ctx context.Context, project string) ([]*ProjectVersion, error) {
 	path := fmt.Sprintf("/project/%s/versions", project)
-	res, err := c.Get(context.Background(), path, nil)
+	res, err := c.Get(ctx, path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -54,12 +59,17 @@ type UpdateVersionRequest struct {
 
 // CreateVersion creates a new project version.
 func (c *Client) CreateVersion(req *CreateVersionRequest) (*ProjectVersion, error) {
+	return c.CreateVersionContext(c.ctx, req)
+}
+
+// CreateVersionContext is like CreateVersion but uses ctx to cancel in-flight requests.
+func (c *Client) CreateVersionContext(ctx context.Context, req *CreateVersionRequest) (*ProjectVersion, error) {
 	body, err := json.Marshal(req)
 	if err != nil {
 		return nil, err
 	}
 
-	res, err := c.PostV2(context.Background(), "/version", body, Header{
+	res, err := c.PostV2(ctx, "/version", body, Header{
 		"Content-Type": "application/json",
 	})
 	if err != nil {
@@ -81,12 +91,17 @@ func (c *Client) CreateVersion(req *CreateVersionRequest) (*ProjectVersion, erro
 
 // UpdateVersion updates an existing project version.
 func (c *Client) UpdateVersion(versionID string, req *UpdateVersionRequest) (*ProjectVersion, error) {
+	return c.UpdateVersionContext(c.ctx, versionID, req)
+}
+
+// UpdateVersionContext is like UpdateVersion but uses ctx to cancel in-flight requests.
+func (c *Client) UpdateVersionContext(ctx context.Context, versionID string, req *UpdateVersionRequest) (*ProjectVersion, error) {
 	body, err := json.Marshal(req)
 	if err != nil {
 		return nil, err
 	}
 
-	res, err := c.PutV2(context.Background(), fmt.Sprintf("/version/%s", versionID), body, Header{
+	res, err := c.PutV2(ctx, fmt.Sprintf("/version/%s", versionID), body, Header{
 		"Content-Type": "application/json",
 	})
 	if err != nil {
@@ -108,6 +123,11 @@ func (c *Client) UpdateVersion(versionID string, req *UpdateVersionRequest) (*Pr
 
 // DeleteVersion deletes a project version.
 func (c *Client) DeleteVersion(versionID string, moveFixIssuesTo string, moveAffectedIssuesTo string) error {
+	return c.DeleteVersionContext(c.ctx, versionID, moveFixIssuesTo, moveAffectedIssuesTo)
+}
+
+// DeleteVersionContext is like DeleteVersion but uses ctx to cancel in-flight requests.
+func (c *Client) DeleteVersionContext(ctx context.Context, versionID string, moveFixIssuesTo string, moveAffectedIssuesTo string) error {
 	path := fmt.Sprintf("/version/%s", versionID)
 	if moveFixIssuesTo != "" || moveAffectedIssuesTo != "" {
 		path += "?"
@@ -124,7 +144,61 @@ func (c *Client) DeleteVersion(versionID string, moveFixIssuesTo string, moveAff
 		}
 	}
 
-	res, err := c.DeleteV2(context.Background(), path, nil)
+	res, err := c.DeleteV2(ctx, path, nil)
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusNoContent && res.StatusCode != http.StatusOK {
+		return formatUnexpectedResponse(res)
+	}
+
+	return nil
+}
+
+// UnresolvedIssueCount fetches number of unresolved issues in a version
+// using GET /version/{id}/unresolvedIssueCount endpoint.
+func (c *Client) UnresolvedIssueCount(versionID string) (int, error) {
+	return c.UnresolvedIssueCountContext(c.ctx, versionID)
+}
+
+// UnresolvedIssueCountContext is like UnresolvedIssueCount but uses ctx to cancel in-flight requests.
+func (c *Client) UnresolvedIssueCountContext(ctx context.Context, versionID string) (int, error) {
+	res, err := c.GetV2(ctx, fmt.Sprintf("/version/%s/unresolvedIssueCount", versionID), nil)
+	if err != nil {
+		return 0, err
+	}
+	if res == nil {
+		return 0, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return 0, formatUnexpectedResponse(res)
+	}
+
+	var out struct {
+		IssuesUnresolvedCount int `json:"issuesUnresolvedCount"`
+	}
+	err = json.NewDecoder(res.Body).Decode(&out)
+	return out.IssuesUnresolvedCount, err
+}
+
+// MergeVersion merges a version into another using PUT /version/{id}/mergeto/{moveIssuesTo} endpoint.
+// All issues of the version are moved to the target version and the version is deleted.
+func (c *Client) MergeVersion(versionID, moveIssuesTo string) error {
+	return c.MergeVersionContext(c.ctx, versionID, moveIssuesTo)
+}
+
+// MergeVersionContext is like MergeVersion but uses ctx to cancel in-flight requests.
+func (c *Client) MergeVersionContext(ctx context.Context, versionID, moveIssuesTo string) error {
+	path := fmt.Sprintf("/version/%s/mergeto/%s", versionID, moveIssuesTo)
+
+	res, err := c.PutV2(ctx, path, nil, nil)
 	if err != nil {
 		return err
 	}
@@ -139,3 +213,16 @@ func (c *Client) DeleteVersion(versionID string, moveFixIssuesTo string, moveAff
 
 	return nil
 }
+
+// MoveFixVersion moves an issue from one fix version to another using the issue edit endpoint.
+func (c *Client) MoveFixVersion(key, from, to string) error {
+	return c.MoveFixVersionContext(c.ctx, key, from, to)
+}
+
+// MoveFixVersionContext is like MoveFixVersion but uses ctx to cancel in-flight requests.
+func (c *Client) MoveFixVersionContext(ctx context.Context, key, from, to string) error {
+	return c.EditContext(ctx, key, &EditRequest{
+		FixVersions: []string{separatorMinus + from, to},
+		SkipNotify:  true,
+	})
+}
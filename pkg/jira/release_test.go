@@ -68,3 +68,57 @@ func TestReleases(t *testing.T) {
 	_, err = client.Release("1000")
 	assert.Error(t, &ErrUnexpectedResponse{}, err)
 }
+
+func TestUnresolvedIssueCount(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/version/1001/unresolvedIssueCount", r.URL.Path)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			_, _ = w.Write([]byte(`{"issuesUnresolvedCount":3,"issuesCount":12}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.UnresolvedIssueCount("1001")
+	assert.NoError(t, err)
+	assert.Equal(t, 3, actual)
+
+	unexpectedStatusCode = true
+
+	_, err = client.UnresolvedIssueCount("1001")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestMergeVersion(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/version/1001/mergeto/1002", r.URL.Path)
+		assert.Equal(t, "PUT", r.Method)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			w.WriteHeader(204)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	err := client.MergeVersion("1001", "1002")
+	assert.NoError(t, err)
+
+	unexpectedStatusCode = true
+
+	err = client.MergeVersion("1001", "1002")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
@@ -17,11 +17,60 @@ type SavedFilter struct {
 		AccountID   string `json:"accountId"`
 		DisplayName string `json:"displayName"`
 	} `json:"owner"`
-	SharePermissions []struct {
-		Type string `json:"type"`
-	} `json:"sharePermissions"`
-	Favourite bool   `json:"favourite"`
-	Self      string `json:"self"`
+	SharePermissions []*FilterPermission `json:"sharePermissions"`
+	Favourite        bool                `json:"favourite"`
+	Self             string              `json:"self"`
+}
+
+// Filter share types.
+const (
+	FilterShareGroup   = "group"
+	FilterShareProject = "project"
+	FilterShareUser    = "user"
+)
+
+// FilterPermission is a share permission of a saved filter.
+type FilterPermission struct {
+	ID      int    `json:"id"`
+	Type    string `json:"type"`
+	Project *struct {
+		ID   string `json:"id"`
+		Key  string `json:"key"`
+		Name string `json:"name"`
+	} `json:"project,omitempty"`
+	Role *struct {
+		Name string `json:"name"`
+	} `json:"role,omitempty"`
+	Group *struct {
+		Name string `json:"name"`
+	} `json:"group,omitempty"`
+	User *User `json:"user,omitempty"`
+}
+
+// With returns who the filter is shared with, eg: the group name.
+func (p *FilterPermission) With() string {
+	switch {
+	case p.Project != nil && p.Role != nil:
+		return p.Project.Key + " (" + p.Role.Name + ")"
+	case p.Project != nil:
+		return p.Project.Key
+	case p.Group != nil:
+		return p.Group.Name
+	case p.User != nil:
+		return p.User.DisplayName
+	}
+	return ""
+}
+
+// FilterShareRequest is a request to share a filter. Set the field matching the type,
+// eg: GroupName for the group type. Users are identified by AccountID in cloud
+// installations and by UserKey in local installations.
+type FilterShareRequest struct {
+	Type      string `json:"type"`
+	ProjectID string `json:"projectId,omitempty"`
+	GroupName string `json:"groupname,omitempty"`
+	AccountID string `json:"accountId,omitempty"`
+	UserKey   string `json:"userKey,omitempty"`
 }
 
 // SavedFilterListResponse represents the response from listing filters.
@@ -35,25 +84,30 @@ type SavedFilterListResponse struct {
 
 // CreateFilterRequest represents the request to create a filter.
 type CreateFilterRequest struct {
-	Name             string   `json:"name"`
-	Description      string   `json:"description,omitempty"`
-	JQL              string   `json:"jql"`
-	Favourite        bool     `json:"favourite,omitempty"`
-	SharePermissions []string `json:"sharePermissions,omitempty"`
+	Name             string                `json:"name"`
+	Description      string                `json:"description,omitempty"`
+	JQL              string                `json:"jql"`
+	Favourite        bool                  `json:"favourite,omitempty"`
+	SharePermissions []*FilterShareRequest `json:"sharePermissions,omitempty"`
 }
 
 // UpdateFilterRequest represents the request to update a filter.
 type UpdateFilterRequest struct {
-	Name             string   `json:"name,omitempty"`
-	Description      string   `json:"description,omitempty"`
-	JQL              string   `json:"jql,omitempty"`
-	Favourite        *bool   `json:"favourite,omitempty"`
-	SharePermissions []string `json:"sharePermissions,omitempty"`
+	Name             string                `json:"name,omitempty"`
+	Description      string                `json:"description,omitempty"`
+	JQL              string                `json:"jql,omitempty"`
+	Favourite        *bool                 `json:"favourite,omitempty"`
+	SharePermissions []*FilterShareRequest `json:"sharePermissions,omitempty"`
 }
 
 // GetFilters fetches user's saved filters from /rest/api/2/filter endpoint.
 func (c *Client) GetFilters() ([]*SavedFilter, error) {
-	res, err := c.GetV2(context.Background(), "/filter/favourite", nil)
+	return c.GetFiltersContext(c.ctx)
+}
+
+// GetFiltersContext is like GetFilters but uses ctx to cancel in-flight requests.
+func (c *Client) GetFiltersContext(ctx context.Context) ([]*SavedFilter, error) {
+	res, err := c.GetV2(ctx, "/filter/favourite", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -73,8 +127,13 @@ func (c *Client) GetFilters() ([]*SavedFilter, error) {
 
 // GetAllFilters fetches all filters accessible to the user.
 func (c *Client) GetAllFilters(startAt, maxResults int) (*SavedFilterListResponse, error) {
+	return c.GetAllFiltersContext(c.ctx, startAt, maxResults)
+}
+
+// GetAllFiltersContext is like GetAllFilters but uses ctx to cancel in-flight requests.
+func (c *Client) GetAllFiltersContext(ctx context.Context, startAt, maxResults int) (*SavedFilterListResponse, error) {
 	path := fmt.Sprintf("/filter/search?startAt=%d&maxResults=%d", startAt, maxResults)
-	res, err := c.GetV2(context.Background(), path, nil)
+	res, err := c.GetV2(ctx, path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -94,7 +153,12 @@ func (c *Client) GetAllFilters(startAt, maxResults int) (*SavedFilterListRespons
 
 // GetFilter fetches a specific filter by ID.
 func (c *Client) GetFilter(filterID string) (*SavedFilter, error) {
-	res, err := c.GetV2(context.Background(), fmt.Sprintf("/filter/%s", filterID), nil)
+	return c.GetFilterContext(c.ctx, filterID)
+}
+
+// GetFilterContext is like GetFilter but uses ctx to cancel in-flight requests.
+func (c *Client) GetFilterContext(ctx context.Context, filterID string) (*SavedFilter, error) {
+	res, err := c.GetV2(ctx, fmt.Sprintf("/filter/%s", filterID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -114,12 +178,17 @@ func (c *Client) GetFilter(filterID string) (*SavedFilter, error) {
 
 // CreateFilter creates a new saved filter.
 func (c *Client) CreateFilter(req *CreateFilterRequest) (*SavedFilter, error) {
+	return c.CreateFilterContext(c.ctx, req)
+}
+
+// CreateFilterContext is like CreateFilter but uses ctx to cancel in-flight requests.
+func (c *Client) CreateFilterContext(ctx context.Context, req *CreateFilterRequest) (*SavedFilter, error) {
 	body, err := json.Marshal(req)
 	if err != nil {
 		return nil, err
 	}
 
-	res, err := c.PostV2(context.Background(), "/filter", body, Header{
+	res, err := c.PostV2(ctx, "/filter", body, Header{
 		"Content-Type": "application/json",
 	})
 	if err != nil {
@@ -141,12 +210,17 @@ func (c *Client) CreateFilter(req *CreateFilterRequest) (*SavedFilter, error) {
 
 // UpdateFilter updates an existing filter.
 func (c *Client) UpdateFilter(filterID string, req *UpdateFilterRequest) (*SavedFilter, error) {
+	return c.UpdateFilterContext(c.ctx, filterID, req)
+}
+
+// UpdateFilterContext is like UpdateFilter but uses ctx to cancel in-flight requests.
+func (c *Client) UpdateFilterContext(ctx context.Context, filterID string, req *UpdateFilterRequest) (*SavedFilter, error) {
 	body, err := json.Marshal(req)
 	if err != nil {
 		return nil, err
 	}
 
-	res, err := c.PutV2(context.Background(), fmt.Sprintf("/filter/%s", filterID), body, Header{
+	res, err := c.PutV2(ctx, fmt.Sprintf("/filter/%s", filterID), body, Header{
 		"Content-Type": "application/json",
 	})
 	if err != nil {
@@ -168,7 +242,12 @@ func (c *Client) UpdateFilter(filterID string, req *UpdateFilterRequest) (*Saved
 
 // DeleteFilter deletes a filter.
 func (c *Client) DeleteFilter(filterID string) error {
-	res, err := c.DeleteV2(context.Background(), fmt.Sprintf("/filter/%s", filterID), nil)
+	return c.DeleteFilterContext(c.ctx, filterID)
+}
+
+// DeleteFilterContext is like DeleteFilter but uses ctx to cancel in-flight requests.
+func (c *Client) DeleteFilterContext(ctx context.Context, filterID string) error {
+	res, err := c.DeleteV2(ctx, fmt.Sprintf("/filter/%s", filterID), nil)
 	if err != nil {
 		return err
 	}
@@ -186,13 +265,73 @@ func (c *Client) DeleteFilter(filterID string) error {
 
 // ExecuteFilter executes a filter and returns the matching issues.
 func (c *Client) ExecuteFilter(filterID string, limit uint) (*SearchResult, error) {
-	filter, err := c.GetFilter(filterID)
+	return c.ExecuteFilterContext(c.ctx, filterID, limit)
+}
+
+// ExecuteFilterContext is like ExecuteFilter but uses ctx to cancel in-flight requests.
+func (c *Client) ExecuteFilterContext(ctx context.Context, filterID string, limit uint) (*SearchResult, error) {
+	filter, err := c.GetFilterContext(ctx, filterID)
 	if err != nil {
 		return nil, err
 	}
 
 	// Use the filter's JQL to search for issues
 	// SearchV2 takes from and limit parameters
-	return c.SearchV2(filter.JQL, 0, limit)
+	return c.SearchV2Context(ctx, filter.JQL, 0, limit)
+}
+
+// AddFilterPermission shares a filter using POST /filter/{id}/permission endpoint.
+// It returns all share permissions of the filter.
+func (c *Client) AddFilterPermission(filterID string, req *FilterShareRequest) ([]*FilterPermission, error) {
+	return c.AddFilterPermissionContext(c.ctx, filterID, req)
+}
+
+// AddFilterPermissionContext is like AddFilterPermission but uses ctx to cancel in-flight requests.
+func (c *Client) AddFilterPermissionContext(ctx context.Context, filterID string, req *FilterShareRequest) ([]*FilterPermission, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.PostV2(ctx, fmt.Sprintf("/filter/%s/permission", filterID), body, Header{
+		"Accept":       "application/json",
+		"Content-Type": "application/json",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusCreated && res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out []*FilterPermission
+	err = json.NewDecoder(res.Body).Decode(&out)
+	return out, err
 }
 
+// DeleteFilterPermission removes a share permission using DELETE /filter/{id}/permission/{permissionId} endpoint.
+func (c *Client) DeleteFilterPermission(filterID string, permissionID int) error {
+	return c.DeleteFilterPermissionContext(c.ctx, filterID, permissionID)
+}
+
+// DeleteFilterPermissionContext is like DeleteFilterPermission but uses ctx to cancel in-flight requests.
+func (c *Client) DeleteFilterPermissionContext(ctx context.Context, filterID string, permissionID int) error {
+	res, err := c.DeleteV2(ctx, fmt.Sprintf("/filter/%s/permission/%d", filterID, permissionID), nil)
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusNoContent && res.StatusCode != http.StatusOK {
+		return formatUnexpectedResponse(res)
+	}
+	return nil
+}
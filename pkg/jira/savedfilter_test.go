@@ -0,0 +1,176 @@
+package jira
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const filterPermissionsResponse = `[
+	{"id": 10010, "type": "group", "group": {"name": "jira-developers"}},
+	{"id": 10011, "type": "project", "project": {"id": "10000", "key": "TEST", "name": "Test"}},
+	{"id": 10012, "type": "projectRole", "project": {"id": "10000", "key": "TEST", "name": "Test"}, "role": {"name": "Developers"}},
+	{"id": 10013, "type": "user", "user": {"accountId": "5b10a2844c20165700ede21g", "displayName": "Jane Doe"}}
+]`
+
+func TestGetFilterSharePermissions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/filter/10000", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{"id": "10000", "name": "My bugs", "jql": "type = Bug", "sharePermissions": ` + filterPermissionsResponse + `}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.GetFilter("10000")
+	assert.NoError(t, err)
+	assert.Equal(t, "My bugs", actual.Name)
+	assert.Len(t, actual.SharePermissions, 4)
+
+	with := make([]string, 0, len(actual.SharePermissions))
+	for _, p := range actual.SharePermissions {
+		with = append(with, p.With())
+	}
+	assert.Equal(t, []string{"jira-developers", "TEST", "TEST (Developers)", "Jane Doe"}, with)
+	assert.Equal(t, 10010, actual.SharePermissions[0].ID)
+}
+
+func TestUpdateFilter(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/filter/10000", r.URL.Path)
+		assert.Equal(t, http.MethodPut, r.Method)
+
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+
+		var actual map[string]any
+		assert.NoError(t, json.Unmarshal(body, &actual))
+		assert.Equal(t, map[string]any{
+			"name":      "My bugs",
+			"jql":       "type = Bug AND status != Done",
+			"favourite": false,
+		}, actual)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{"id": "10000", "name": "My bugs", "jql": "type = Bug AND status != Done"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	fav := false
+	req := UpdateFilterRequest{Name: "My bugs", JQL: "type = Bug AND status != Done", Favourite: &fav}
+
+	actual, err := client.UpdateFilter("10000", &req)
+	assert.NoError(t, err)
+	assert.Equal(t, "type = Bug AND status != Done", actual.JQL)
+
+	unexpectedStatusCode = true
+
+	_, err = client.UpdateFilter("10000", &req)
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestDeleteFilter(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/filter/10000", r.URL.Path)
+		assert.Equal(t, http.MethodDelete, r.Method)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(404)
+			return
+		}
+		w.WriteHeader(204)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	assert.NoError(t, client.DeleteFilter("10000"))
+
+	unexpectedStatusCode = true
+
+	assert.Error(t, &ErrUnexpectedResponse{}, client.DeleteFilter("10000"))
+}
+
+func TestAddFilterPermission(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/filter/10000/permission", r.URL.Path)
+		assert.Equal(t, http.MethodPost, r.Method)
+
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+
+		var actual map[string]any
+		assert.NoError(t, json.Unmarshal(body, &actual))
+		assert.Equal(t, map[string]any{"type": "group", "groupname": "jira-developers"}, actual)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(201)
+		_, _ = w.Write([]byte(filterPermissionsResponse))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	req := FilterShareRequest{Type: FilterShareGroup, GroupName: "jira-developers"}
+
+	actual, err := client.AddFilterPermission("10000", &req)
+	assert.NoError(t, err)
+	assert.Len(t, actual, 4)
+	assert.Equal(t, FilterShareGroup, actual[0].Type)
+
+	unexpectedStatusCode = true
+
+	_, err = client.AddFilterPermission("10000", &req)
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestDeleteFilterPermission(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/filter/10000/permission/10010", r.URL.Path)
+		assert.Equal(t, http.MethodDelete, r.Method)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(404)
+			return
+		}
+		w.WriteHeader(204)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	assert.NoError(t, client.DeleteFilterPermission("10000", 10010))
+
+	unexpectedStatusCode = true
+
+	assert.Error(t, &ErrUnexpectedResponse{}, client.DeleteFilterPermission("10000", 10010))
+}
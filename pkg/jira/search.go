@@ -12,22 +12,49 @@ import (
 type SearchResult struct {
 	IsLast        bool     `json:"isLast"`
 	NextPageToken string   `json:"nextPageToken"`
+	Total         int      `json:"total"`
 	Issues        []*Issue `json:"issues"`
 }
 
 // Search searches for issues using v3 version of the Jira GET /search endpoint.
 func (c *Client) Search(jql string, limit uint) (*SearchResult, error) {
+	return c.SearchContext(c.ctx, jql, limit)
+}
+
+// SearchContext is like Search but uses ctx to cancel in-flight requests.
+func (c *Client) SearchContext(ctx context.Context, jql string, limit uint) (*SearchResult, error) {
+	path := fmt.Sprintf("/search/jql?jql=%s&maxResults=%d&fields=*all", url.QueryEscape(jql), limit)
+	return c.search(ctx, path, apiVersion3)
+}
+
+// SearchNext fetches the page of issues that follows the page with the given
+// token using v3 version of the Jira GET /search endpoint. An empty token
+// fetches the first page.
+func (c *Client) SearchNext(jql, token string, limit uint) (*SearchResult, error) {
+	return c.SearchNextContext(c.ctx, jql, token, limit)
+}
+
+// SearchNextContext is like SearchNext but uses ctx to cancel in-flight requests.
+func (c *Client) SearchNextContext(ctx context.Context, jql, token string, limit uint) (*SearchResult, error) {
 	path := fmt.Sprintf("/search/jql?jql=%s&maxResults=%d&fields=*all", url.QueryEscape(jql), limit)
-	return c.search(path, apiVersion3)
+	if token != "" {
+		path += "&nextPageToken=" + url.QueryEscape(token)
+	}
+	return c.search(ctx, path, apiVersion3)
 }
 
 // SearchV2 searches an issues using v2 version of the Jira GET /search endpoint.
 func (c *Client) SearchV2(jql string, from, limit uint) (*SearchResult, error) {
+	return c.SearchV2Context(c.ctx, jql, from, limit)
+}
+
+// SearchV2Context is like SearchV2 but uses ctx to cancel in-flight requests.
+func (c *Client) SearchV2Context(ctx context.Context, jql string, from, limit uint) (*SearchResult, error) {
 	path := fmt.Sprintf("/search?jql=%s&startAt=%d&maxResults=%d", url.QueryEscape(jql), from, limit)
-	return c.search(path, apiVersion2)
+	return c.search(ctx, path, apiVersion2)
 }
 
-func (c *Client) search(path, ver string) (*SearchResult, error) {
+func (c *Client) search(ctx context.Context, path, ver string) (*SearchResult, error) {
 	var (
 		res *http.Response
 		err error
@@ -35,9 +62,9 @@ func (c *Client) search(path, ver string) (*SearchResult, error) {
 
 	switch ver {
 	case apiVersion2:
-		res, err = c.GetV2(context.Background(), path, nil)
+		res, err = c.GetV2(ctx, path, nil)
 	default:
-		res, err = c.Get(context.Background(), path, nil)
+		res, err = c.Get(ctx, path, nil)
 	}
 
 	if err != nil {
@@ -58,3 +85,74 @@ func (c *Client) search(path, ver string) (*SearchResult, error) {
 
 	return &out, err
 }
+
+// SearchFieldValues fetches a single field of issues matching jql using v3 version
+// of the Jira GET /search endpoint. Values are keyed by issue key and left undecoded
+// as their type depends on the field. Issues without a value for the field are skipped.
+func (c *Client) SearchFieldValues(jql, field string, limit uint) (map[string]json.RawMessage, error) {
+	return c.SearchFieldValuesContext(c.ctx, jql, field, limit)
+}
+
+// SearchFieldValuesContext is like SearchFieldValues but uses ctx to cancel in-flight requests.
+func (c *Client) SearchFieldValuesContext(ctx context.Context, jql, field string, limit uint) (map[string]json.RawMessage, error) {
+	path := fmt.Sprintf("/search/jql?jql=%s&maxResults=%d&fields=%s", url.QueryEscape(jql), limit, url.QueryEscape(field))
+	return c.searchFieldValues(ctx, path, field, apiVersion3)
+}
+
+// SearchFieldValuesV2 is like SearchFieldValues but uses v2 version of the Jira GET /search endpoint.
+func (c *Client) SearchFieldValuesV2(jql, field string, from, limit uint) (map[string]json.RawMessage, error) {
+	return c.SearchFieldValuesV2Context(c.ctx, jql, field, from, limit)
+}
+
+// SearchFieldValuesV2Context is like SearchFieldValuesV2 but uses ctx to cancel in-flight requests.
+func (c *Client) SearchFieldValuesV2Context(ctx context.Context, jql, field string, from, limit uint) (map[string]json.RawMessage, error) {
+	path := fmt.Sprintf(
+		"/search?jql=%s&startAt=%d&maxResults=%d&fields=%s",
+		url.QueryEscape(jql), from, limit, url.QueryEscape(field),
+	)
+	return c.searchFieldValues(ctx, path, field, apiVersion2)
+}
+
+func (c *Client) searchFieldValues(ctx context.Context, path, field, ver string) (map[string]json.RawMessage, error) {
+	var (
+		res *http.Response
+		err error
+	)
+
+	switch ver {
+	case apiVersion2:
+		res, err = c.GetV2(ctx, path, nil)
+	default:
+		res, err = c.Get(ctx, path, nil)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out struct {
+		Issues []struct {
+			Key    string                     `json:"key"`
+			Fields map[string]json.RawMessage `json:"fields"`
+		} `json:"issues"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]json.RawMessage, len(out.Issues))
+	for _, iss := range out.Issues {
+		if v, ok := iss.Fields[field]; ok && string(v) != "null" {
+			values[iss.Key] = v
+		}
+	}
+	return values, nil
+}
@@ -2,6 +2,7 @@
 package jira
 
 import (
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -71,9 +72,7 @@ func TestSearch(t *testing.T) {
 						IsWatching bool `json:"isWatching"`
 						WatchCount int  `json:"watchCount"`
 					}{IsWatching: true, WatchCount: 1},
-					Status: struct {
-						Name string `json:"name"`
-					}{Name: "To Do"},
+					Status:  IssueStatus{Name: "To Do"},
 					Created: "2020-12-03T14:05:20.974+0100",
 					Updated: "2020-12-03T14:05:20.974+0100",
 				},
@@ -94,9 +93,7 @@ func TestSearch(t *testing.T) {
 						IsWatching bool `json:"isWatching"`
 						WatchCount int  `json:"watchCount"`
 					}{IsWatching: true, WatchCount: 12},
-					Status: struct {
-						Name string `json:"name"`
-					}{Name: "In Progress"},
+					Status:  IssueStatus{Name: "In Progress"},
 					Created: "2020-12-03T14:05:20.974+0100",
 					Updated: "2020-12-03T14:05:20.974+0100",
 				},
@@ -123,9 +120,7 @@ func TestSearch(t *testing.T) {
 						IsWatching bool `json:"isWatching"`
 						WatchCount int  `json:"watchCount"`
 					}{IsWatching: false, WatchCount: 3},
-					Status: struct {
-						Name string `json:"name"`
-					}{Name: "Done"},
+					Status:  IssueStatus{Name: "Done"},
 					Created: "2020-12-03T14:05:20.974+0100",
 					Updated: "2020-12-03T14:05:20.974+0100",
 				},
@@ -140,3 +135,69 @@ func TestSearch(t *testing.T) {
 	_, err = client.SearchV2("project=TEST", 0, 100)
 	assert.Error(t, &ErrUnexpectedResponse{}, err)
 }
+
+func TestSearchNext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/3/search/jql", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+
+		if r.URL.Query().Get("nextPageToken") == "" {
+			_, _ = w.Write([]byte(`{"isLast": false, "nextPageToken": "page-2", "issues": [{"key": "TEST-1"}]}`))
+			return
+		}
+		assert.Equal(t, "page-2", r.URL.Query().Get("nextPageToken"))
+		_, _ = w.Write([]byte(`{"isLast": true, "issues": [{"key": "TEST-2"}]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.SearchNext("project=TEST", "", 1)
+	assert.NoError(t, err)
+	assert.False(t, actual.IsLast)
+	assert.Equal(t, "TEST-1", actual.Issues[0].Key)
+
+	actual, err = client.SearchNext("project=TEST", actual.NextPageToken, 1)
+	assert.NoError(t, err)
+	assert.True(t, actual.IsLast)
+	assert.Equal(t, "TEST-2", actual.Issues[0].Key)
+}
+
+func TestSearchFieldValues(t *testing.T) {
+	var apiVersion2 bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if apiVersion2 {
+			assert.Equal(t, "/rest/api/2/search", r.URL.Path)
+			assert.Equal(t, "5", r.URL.Query().Get("startAt"))
+		} else {
+			assert.Equal(t, "/rest/api/3/search/jql", r.URL.Path)
+		}
+		assert.Equal(t, "key IN (TEST-1, TEST-2, TEST-3)", r.URL.Query().Get("jql"))
+		assert.Equal(t, "customfield_10016", r.URL.Query().Get("fields"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{"issues": [
+			{"key": "TEST-1", "fields": {"customfield_10016": 5}},
+			{"key": "TEST-2", "fields": {"customfield_10016": null}},
+			{"key": "TEST-3", "fields": {}}
+		]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+	expected := map[string]json.RawMessage{"TEST-1": json.RawMessage("5")}
+
+	actual, err := client.SearchFieldValues("key IN (TEST-1, TEST-2, TEST-3)", "customfield_10016", 100)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+
+	apiVersion2 = true
+
+	actual, err = client.SearchFieldValuesV2("key IN (TEST-1, TEST-2, TEST-3)", "customfield_10016", 5, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
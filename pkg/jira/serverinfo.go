@@ -19,7 +19,12 @@ type ServerInfo struct {
 
 // ServerInfo fetches response from /serverInfo endpoint.
 func (c *Client) ServerInfo() (*ServerInfo, error) {
-	res, err := c.GetV2(context.Background(), "/serverInfo", nil)
+	return c.ServerInfoContext(c.ctx)
+}
+
+// ServerInfoContext is like ServerInfo but uses ctx to cancel in-flight requests.
+func (c *Client) ServerInfoContext(ctx context.Context) (*ServerInfo, error) {
+	res, err := c.GetV2(ctx, "/serverInfo", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,341 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const (
+	// ApprovalDecisionApprove approves a pending approval.
+	ApprovalDecisionApprove = "approve"
+	// ApprovalDecisionDecline declines a pending approval.
+	ApprovalDecisionDecline = "decline"
+)
+
+// ServiceDesk holds service desk info.
+type ServiceDesk struct {
+	ID          string `json:"id"`
+	ProjectID   string `json:"projectId"`
+	ProjectKey  string `json:"projectKey"`
+	ProjectName string `json:"projectName"`
+}
+
+// Queue holds service desk queue info.
+type Queue struct {
+	ID         string   `json:"id"`
+	Name       string   `json:"name"`
+	JQL        string   `json:"jql"`
+	Fields     []string `json:"fields"`
+	IssueCount int      `json:"issueCount"`
+}
+
+// RequestType holds customer request type info.
+type RequestType struct {
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	Description   string `json:"description"`
+	HelpText      string `json:"helpText"`
+	IssueTypeID   string `json:"issueTypeId"`
+	ServiceDeskID string `json:"serviceDeskId"`
+}
+
+// SDDate is a date representation used by the service management api.
+type SDDate struct {
+	ISO8601     string `json:"iso8601"`
+	Jira        string `json:"jira"`
+	Friendly    string `json:"friendly"`
+	EpochMillis int64  `json:"epochMillis"`
+}
+
+// SDDuration is a duration representation used by the service management api.
+type SDDuration struct {
+	Millis   int64  `json:"millis"`
+	Friendly string `json:"friendly"`
+}
+
+// SLACycle holds a single SLA cycle.
+type SLACycle struct {
+	StartTime           SDDate     `json:"startTime"`
+	StopTime            *SDDate    `json:"stopTime,omitempty"`
+	BreachTime          *SDDate    `json:"breachTime,omitempty"`
+	Breached            bool       `json:"breached"`
+	Paused              bool       `json:"paused"`
+	WithinCalendarHours bool       `json:"withinCalendarHours"`
+	GoalDuration        SDDuration `json:"goalDuration"`
+	ElapsedTime         SDDuration `json:"elapsedTime"`
+	RemainingTime       SDDuration `json:"remainingTime"`
+}
+
+// SLA holds SLA info of a customer request.
+type SLA struct {
+	ID              string     `json:"id"`
+	Name            string     `json:"name"`
+	OngoingCycle    *SLACycle  `json:"ongoingCycle,omitempty"`
+	CompletedCycles []SLACycle `json:"completedCycles"`
+}
+
+// Approver holds approver info of an approval.
+type Approver struct {
+	Approver         User   `json:"approver"`
+	ApproverDecision string `json:"approverDecision"`
+}
+
+// Approval holds approval info of a customer request.
+type Approval struct {
+	ID                string     `json:"id"`
+	Name              string     `json:"name"`
+	FinalDecision     string     `json:"finalDecision"`
+	CanAnswerApproval bool       `json:"canAnswerApproval"`
+	Approvers         []Approver `json:"approvers"`
+	CreatedDate       SDDate     `json:"createdDate"`
+	CompletedDate     *SDDate    `json:"completedDate,omitempty"`
+}
+
+// CustomerRequest holds customer request info.
+type CustomerRequest struct {
+	IssueID       string `json:"issueId"`
+	IssueKey      string `json:"issueKey"`
+	RequestTypeID string `json:"requestTypeId"`
+	ServiceDeskID string `json:"serviceDeskId"`
+	CurrentStatus struct {
+		Status string `json:"status"`
+	} `json:"currentStatus"`
+}
+
+// CreateCustomerRequest struct holds request data for customer request create request.
+type CreateCustomerRequest struct {
+	ServiceDeskID       string                 `json:"serviceDeskId"`
+	RequestTypeID       string                 `json:"requestTypeId"`
+	RequestFieldValues  map[string]interface{} `json:"requestFieldValues"`
+	RaiseOnBehalfOf     string                 `json:"raiseOnBehalfOf,omitempty"`
+	RequestParticipants []string               `json:"requestParticipants,omitempty"`
+}
+
+// SDUsersRequest holds a list of users for customer and participant requests.
+// Cloud installations use account ids whereas local installations use usernames.
+type SDUsersRequest struct {
+	Usernames  []string `json:"usernames,omitempty"`
+	AccountIDs []string `json:"accountIds,omitempty"`
+}
+
+// QueueIssuesResult holds response from /servicedesk/{id}/queue/{queueId}/issue endpoint.
+type QueueIssuesResult struct {
+	Size       int      `json:"size"`
+	Start      int      `json:"start"`
+	Limit      int      `json:"limit"`
+	IsLastPage bool     `json:"isLastPage"`
+	Issues     []*Issue `json:"values"`
+}
+
+// Some of the service management endpoints are still marked as experimental in older versions.
+var sdHeader = Header{
+	"Accept":            "application/json",
+	"Content-Type":      "application/json",
+	"X-ExperimentalApi": "opt-in",
+}
+
+// ServiceDesks fetches service desks using GET /servicedesk endpoint.
+func (c *Client) ServiceDesks() ([]*ServiceDesk, error) {
+	return c.ServiceDesksContext(c.ctx)
+}
+
+// ServiceDesksContext is like ServiceDesks but uses ctx to cancel in-flight requests.
+func (c *Client) ServiceDesksContext(ctx context.Context) ([]*ServiceDesk, error) {
+	var out struct {
+		Values []*ServiceDesk `json:"values"`
+	}
+	if err := c.getSD(ctx, "/servicedesk", &out); err != nil {
+		return nil, err
+	}
+	return out.Values, nil
+}
+
+// Queues fetches queues of a service desk using GET /servicedesk/{id}/queue endpoint.
+func (c *Client) Queues(serviceDeskID string) ([]*Queue, error) {
+	return c.QueuesContext(c.ctx, serviceDeskID)
+}
+
+// QueuesContext is like Queues but uses ctx to cancel in-flight requests.
+func (c *Client) QueuesContext(ctx context.Context, serviceDeskID string) ([]*Queue, error) {
+	var out struct {
+		Values []*Queue `json:"values"`
+	}
+	path := fmt.Sprintf("/servicedesk/%s/queue?includeCount=true", serviceDeskID)
+	if err := c.getSD(ctx, path, &out); err != nil {
+		return nil, err
+	}
+	return out.Values, nil
+}
+
+// QueueIssues fetches issues in a queue using GET /servicedesk/{id}/queue/{queueId}/issue endpoint.
+func (c *Client) QueueIssues(serviceDeskID, queueID string, from, limit uint) (*QueueIssuesResult, error) {
+	return c.QueueIssuesContext(c.ctx, serviceDeskID, queueID, from, limit)
+}
+
+// QueueIssuesContext is like QueueIssues but uses ctx to cancel in-flight requests.
+func (c *Client) QueueIssuesContext(ctx context.Context, serviceDeskID, queueID string, from, limit uint) (*QueueIssuesResult, error) {
+	var out QueueIssuesResult
+	path := fmt.Sprintf("/servicedesk/%s/queue/%s/issue?start=%d&limit=%d", serviceDeskID, queueID, from, limit)
+	if err := c.getSD(ctx, path, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RequestTypes fetches customer request types of a service desk using GET /servicedesk/{id}/requesttype endpoint.
+func (c *Client) RequestTypes(serviceDeskID string) ([]*RequestType, error) {
+	return c.RequestTypesContext(c.ctx, serviceDeskID)
+}
+
+// RequestTypesContext is like RequestTypes but uses ctx to cancel in-flight requests.
+func (c *Client) RequestTypesContext(ctx context.Context, serviceDeskID string) ([]*RequestType, error) {
+	var out struct {
+		Values []*RequestType `json:"values"`
+	}
+	path := fmt.Sprintf("/servicedesk/%s/requesttype", serviceDeskID)
+	if err := c.getSD(ctx, path, &out); err != nil {
+		return nil, err
+	}
+	return out.Values, nil
+}
+
+// RequestSLA fetches SLA timers of a customer request using GET /request/{key}/sla endpoint.
+func (c *Client) RequestSLA(key string) ([]*SLA, error) {
+	return c.RequestSLAContext(c.ctx, key)
+}
+
+// RequestSLAContext is like RequestSLA but uses ctx to cancel in-flight requests.
+func (c *Client) RequestSLAContext(ctx context.Context, key string) ([]*SLA, error) {
+	var out struct {
+		Values []*SLA `json:"values"`
+	}
+	if err := c.getSD(ctx, fmt.Sprintf("/request/%s/sla", key), &out); err != nil {
+		return nil, err
+	}
+	return out.Values, nil
+}
+
+// Approvals fetches approvals of a customer request using GET /request/{key}/approval endpoint.
+func (c *Client) Approvals(key string) ([]*Approval, error) {
+	return c.ApprovalsContext(c.ctx, key)
+}
+
+// ApprovalsContext is like Approvals but uses ctx to cancel in-flight requests.
+func (c *Client) ApprovalsContext(ctx context.Context, key string) ([]*Approval, error) {
+	var out struct {
+		Values []*Approval `json:"values"`
+	}
+	if err := c.getSD(ctx, fmt.Sprintf("/request/%s/approval", key), &out); err != nil {
+		return nil, err
+	}
+	return out.Values, nil
+}
+
+// AnswerApproval approves or declines an approval using POST /request/{key}/approval/{approvalId} endpoint.
+func (c *Client) AnswerApproval(key, approvalID, decision string) (*Approval, error) {
+	return c.AnswerApprovalContext(c.ctx, key, approvalID, decision)
+}
+
+// AnswerApprovalContext is like AnswerApproval but uses ctx to cancel in-flight requests.
+func (c *Client) AnswerApprovalContext(ctx context.Context, key, approvalID, decision string) (*Approval, error) {
+	body, err := json.Marshal(struct {
+		Decision string `json:"decision"`
+	}{Decision: decision})
+	if err != nil {
+		return nil, err
+	}
+
+	var out Approval
+	path := fmt.Sprintf("/request/%s/approval/%s", key, approvalID)
+	if err := c.postSD(ctx, path, body, http.StatusOK, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateCustomerRequest creates a customer request using POST /request endpoint.
+func (c *Client) CreateCustomerRequest(req *CreateCustomerRequest) (*CustomerRequest, error) {
+	return c.CreateCustomerRequestContext(c.ctx, req)
+}
+
+// CreateCustomerRequestContext is like CreateCustomerRequest but uses ctx to cancel in-flight requests.
+func (c *Client) CreateCustomerRequestContext(ctx context.Context, req *CreateCustomerRequest) (*CustomerRequest, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var out CustomerRequest
+	if err := c.postSD(ctx, "/request", body, http.StatusCreated, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AddCustomers adds customers to a service desk using POST /servicedesk/{id}/customer endpoint.
+func (c *Client) AddCustomers(serviceDeskID string, req *SDUsersRequest) error {
+	return c.AddCustomersContext(c.ctx, serviceDeskID, req)
+}
+
+// AddCustomersContext is like AddCustomers but uses ctx to cancel in-flight requests.
+func (c *Client) AddCustomersContext(ctx context.Context, serviceDeskID string, req *SDUsersRequest) error {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	path := fmt.Sprintf("/servicedesk/%s/customer", serviceDeskID)
+	return c.postSD(ctx, path, body, http.StatusNoContent, nil)
+}
+
+// AddParticipants adds request participants using POST /request/{key}/participant endpoint.
+func (c *Client) AddParticipants(key string, req *SDUsersRequest) error {
+	return c.AddParticipantsContext(c.ctx, key, req)
+}
+
+// AddParticipantsContext is like AddParticipants but uses ctx to cancel in-flight requests.
+func (c *Client) AddParticipantsContext(ctx context.Context, key string, req *SDUsersRequest) error {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	return c.postSD(ctx, fmt.Sprintf("/request/%s/participant", key), body, http.StatusOK, nil)
+}
+
+func (c *Client) getSD(ctx context.Context, path string, out interface{}) error {
+	res, err := c.GetSD(ctx, path, sdHeader)
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return formatUnexpectedResponse(res)
+	}
+
+	return json.NewDecoder(res.Body).Decode(out)
+}
+
+func (c *Client) postSD(ctx context.Context, path string, body []byte, expected int, out interface{}) error {
+	res, err := c.PostSD(ctx, path, body, sdHeader)
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != expected {
+		return formatUnexpectedResponse(res)
+	}
+	if out == nil {
+		return nil
+	}
+
+	return json.NewDecoder(res.Body).Decode(out)
+}
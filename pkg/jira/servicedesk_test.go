@@ -0,0 +1,161 @@
+package jira
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServiceDesks(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/servicedeskapi/servicedesk", r.URL.Path)
+		assert.Equal(t, "opt-in", r.Header.Get("X-ExperimentalApi"))
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			resp, err := os.ReadFile("./testdata/servicedesks.json")
+			assert.NoError(t, err)
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			_, _ = w.Write(resp)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.ServiceDesks()
+	assert.NoError(t, err)
+
+	expected := []*ServiceDesk{
+		{ID: "1", ProjectID: "10000", ProjectKey: "ITH", ProjectName: "IT Help Desk"},
+		{ID: "2", ProjectID: "10001", ProjectKey: "HR", ProjectName: "HR Services"},
+	}
+	assert.Equal(t, expected, actual)
+
+	unexpectedStatusCode = true
+
+	_, err = client.ServiceDesks()
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestQueues(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/servicedeskapi/servicedesk/1/queue", r.URL.Path)
+		assert.Equal(t, "true", r.URL.Query().Get("includeCount"))
+
+		resp, err := os.ReadFile("./testdata/queues.json")
+		assert.NoError(t, err)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write(resp)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.Queues("1")
+	assert.NoError(t, err)
+	assert.Len(t, actual, 2)
+	assert.Equal(t, "Unassigned issues", actual[0].Name)
+	assert.Equal(t, 7, actual[0].IssueCount)
+	assert.Equal(t, "20", actual[1].ID)
+}
+
+func TestRequestSLA(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/servicedeskapi/request/ITH-1/sla", r.URL.Path)
+
+		resp, err := os.ReadFile("./testdata/sla.json")
+		assert.NoError(t, err)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write(resp)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.RequestSLA("ITH-1")
+	assert.NoError(t, err)
+	assert.Len(t, actual, 1)
+	assert.Equal(t, "Time to first response", actual[0].Name)
+	assert.NotNil(t, actual[0].OngoingCycle)
+	assert.Equal(t, "3h", actual[0].OngoingCycle.RemainingTime.Friendly)
+	assert.False(t, actual[0].OngoingCycle.Breached)
+}
+
+func TestCreateCustomerRequest(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/servicedeskapi/request", r.URL.Path)
+		assert.Equal(t, "POST", r.Method)
+
+		actualBody := new(CreateCustomerRequest)
+		_ = json.NewDecoder(r.Body).Decode(&actualBody)
+		assert.Equal(t, "1", actualBody.ServiceDeskID)
+		assert.Equal(t, "25", actualBody.RequestTypeID)
+		assert.Equal(t, "Printer is on fire", actualBody.RequestFieldValues["summary"])
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(201)
+			_, _ = w.Write([]byte(`{"issueId":"10010","issueKey":"ITH-11","requestTypeId":"25","serviceDeskId":"1","currentStatus":{"status":"Waiting for support"}}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	req := CreateCustomerRequest{
+		ServiceDeskID:      "1",
+		RequestTypeID:      "25",
+		RequestFieldValues: map[string]interface{}{"summary": "Printer is on fire"},
+	}
+
+	actual, err := client.CreateCustomerRequest(&req)
+	assert.NoError(t, err)
+	assert.Equal(t, "ITH-11", actual.IssueKey)
+	assert.Equal(t, "Waiting for support", actual.CurrentStatus.Status)
+
+	unexpectedStatusCode = true
+
+	_, err = client.CreateCustomerRequest(&req)
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestAnswerApproval(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/servicedeskapi/request/ITH-1/approval/5", r.URL.Path)
+		assert.Equal(t, "POST", r.Method)
+
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"decision":"decline"}`, string(body))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{"id":"5","name":"Manager approval","finalDecision":"declined","canAnswerApproval":false}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.AnswerApproval("ITH-1", "5", ApprovalDecisionDecline)
+	assert.NoError(t, err)
+	assert.Equal(t, "declined", actual.FinalDecision)
+}
@@ -27,8 +27,13 @@ type SprintResult struct {
 //
 // qp is an additional query parameters in key, value pair format, eg: state=closed.
 func (c *Client) Sprints(boardID int, qp string, from, limit int) (*SprintResult, error) {
+	return c.SprintsContext(c.ctx, boardID, qp, from, limit)
+}
+
+// SprintsContext is like Sprints but uses ctx to cancel in-flight requests.
+func (c *Client) SprintsContext(ctx context.Context, boardID int, qp string, from, limit int) (*SprintResult, error) {
 	res, err := c.GetV1(
-		context.Background(),
+		ctx,
 		fmt.Sprintf("/board/%d/sprint?%s&startAt=%d&maxResults=%d", boardID, qp, from, limit),
 		nil,
 	)
@@ -53,8 +58,13 @@ func (c *Client) Sprints(boardID int, qp string, from, limit int) (*SprintResult
 
 // GetSprint returns a single sprint given an ID.
 func (c *Client) GetSprint(sprintID int) (*Sprint, error) {
+	return c.GetSprintContext(c.ctx, sprintID)
+}
+
+// GetSprintContext is like GetSprint but uses ctx to cancel in-flight requests.
+func (c *Client) GetSprintContext(ctx context.Context, sprintID int) (*Sprint, error) {
 	res, err := c.GetV1(
-		context.Background(),
+		ctx,
 		fmt.Sprintf("/sprint/%d", sprintID),
 		nil,
 	)
@@ -83,8 +93,13 @@ func (c *Client) GetSprint(sprintID int) (*Sprint, error) {
 // full updates the sprint with new status of closed.
 // Default behavior is all open tasks are sent to backlog.
 func (c *Client) EndSprint(sprintID int) error {
+	return c.EndSprintContext(c.ctx, sprintID)
+}
+
+// EndSprintContext is like EndSprint but uses ctx to cancel in-flight requests.
+func (c *Client) EndSprintContext(ctx context.Context, sprintID int) error {
 	// get the sprint
-	sprint, err := c.GetSprint(sprintID)
+	sprint, err := c.GetSprintContext(ctx, sprintID)
 	if err != nil {
 		return err
 	}
@@ -100,7 +115,7 @@ func (c *Client) EndSprint(sprintID int) error {
 	}
 
 	res, err := c.PutV1(
-		context.Background(),
+		ctx,
 		fmt.Sprintf("/sprint/%d", sprintID),
 		body,
 		Header{
@@ -125,6 +140,11 @@ func (c *Client) EndSprint(sprintID int) error {
 
 // CreateSprint creates a new sprint.
 func (c *Client) CreateSprint(boardID int, name, startDate, endDate, goal string) (*Sprint, error) {
+	return c.CreateSprintContext(c.ctx, boardID, name, startDate, endDate, goal)
+}
+
+// CreateSprintContext is like CreateSprint but uses ctx to cancel in-flight requests.
+func (c *Client) CreateSprintContext(ctx context.Context, boardID int, name, startDate, endDate, goal string) (*Sprint, error) {
 	sprint := Sprint{
 		Name:      name,
 		BoardID:   boardID,
@@ -140,7 +160,7 @@ func (c *Client) CreateSprint(boardID int, name, startDate, endDate, goal string
 	}
 
 	res, err := c.PostV1(
-		context.Background(),
+		ctx,
 		"/sprint",
 		body,
 		Header{
@@ -171,7 +191,12 @@ func (c *Client) CreateSprint(boardID int, name, startDate, endDate, goal string
 
 // StartSprint starts a sprint by updating its status to active.
 func (c *Client) StartSprint(sprintID int) error {
-	sprint, err := c.GetSprint(sprintID)
+	return c.StartSprintContext(c.ctx, sprintID)
+}
+
+// StartSprintContext is like StartSprint but uses ctx to cancel in-flight requests.
+func (c *Client) StartSprintContext(ctx context.Context, sprintID int) error {
+	sprint, err := c.GetSprintContext(ctx, sprintID)
 	if err != nil {
 		return err
 	}
@@ -187,7 +212,7 @@ func (c *Client) StartSprint(sprintID int) error {
 	}
 
 	res, err := c.PutV1(
-		context.Background(),
+		ctx,
 		fmt.Sprintf("/sprint/%d", sprintID),
 		body,
 		Header{
@@ -212,7 +237,12 @@ func (c *Client) StartSprint(sprintID int) error {
 
 // UpdateSprint updates an existing sprint.
 func (c *Client) UpdateSprint(sprintID int, name, startDate, endDate, goal string) (*Sprint, error) {
-	sprint, err := c.GetSprint(sprintID)
+	return c.UpdateSprintContext(c.ctx, sprintID, name, startDate, endDate, goal)
+}
+
+// UpdateSprintContext is like UpdateSprint but uses ctx to cancel in-flight requests.
+func (c *Client) UpdateSprintContext(ctx context.Context, sprintID int, name, startDate, endDate, goal string) (*Sprint, error) {
+	sprint, err := c.GetSprintContext(ctx, sprintID)
 	if err != nil {
 		return nil, err
 	}
@@ -236,7 +266,7 @@ func (c *Client) UpdateSprint(sprintID int, name, startDate, endDate, goal strin
 	}
 
 	res, err := c.PutV1(
-		context.Background(),
+		ctx,
 		fmt.Sprintf("/sprint/%d", sprintID),
 		body,
 		Header{
@@ -269,12 +299,17 @@ func (c *Client) UpdateSprint(sprintID int, name, startDate, endDate, goal strin
 //
 // qp is an additional query parameters in key, value pair format, eg: state=closed.
 func (c *Client) SprintsInBoards(boardIDs []int, qp string, limit int) []*Sprint {
+	return c.SprintsInBoardsContext(c.ctx, boardIDs, qp, limit)
+}
+
+// SprintsInBoardsContext is like SprintsInBoards but uses ctx to cancel in-flight requests.
+func (c *Client) SprintsInBoardsContext(ctx context.Context, boardIDs []int, qp string, limit int) []*Sprint {
 	n := len(boardIDs)
 	ch := make(chan []*Sprint, n)
 
 	for _, boardID := range boardIDs {
 		go func(id int) {
-			s, err := c.lastNSprints(id, qp, limit)
+			s, err := c.lastNSprints(ctx, id, qp, limit)
 			if err != nil {
 				ch <- nil
 				return
@@ -308,12 +343,17 @@ func (c *Client) SprintsInBoards(boardIDs []int, qp string, limit int) []*Sprint
 
 // SprintIssues fetches issues in the given sprint.
 func (c *Client) SprintIssues(sprintID int, jql string, from, limit uint) (*SearchResult, error) {
+	return c.SprintIssuesContext(c.ctx, sprintID, jql, from, limit)
+}
+
+// SprintIssuesContext is like SprintIssues but uses ctx to cancel in-flight requests.
+func (c *Client) SprintIssuesContext(ctx context.Context, sprintID int, jql string, from, limit uint) (*SearchResult, error) {
 	path := fmt.Sprintf("/sprint/%d/issue?startAt=%d&maxResults=%d", sprintID, from, limit)
 	if jql != "" {
 		path += fmt.Sprintf("&jql=%s", url.QueryEscape(jql))
 	}
 
-	res, err := c.GetV1(context.Background(), path, nil)
+	res, err := c.GetV1(ctx, path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -335,6 +375,11 @@ func (c *Client) SprintIssues(sprintID int, jql string, from, limit uint) (*Sear
 
 // SprintIssuesAdd adds issues to the sprint.
 func (c *Client) SprintIssuesAdd(id string, issues ...string) error {
+	return c.SprintIssuesAddContext(c.ctx, id, issues...)
+}
+
+// SprintIssuesAddContext is like SprintIssuesAdd but uses ctx to cancel in-flight requests.
+func (c *Client) SprintIssuesAddContext(ctx context.Context, id string, issues ...string) error {
 	path := fmt.Sprintf("/sprint/%s/issue", id)
 
 	data := struct {
@@ -346,7 +391,7 @@ func (c *Client) SprintIssuesAdd(id string, issues ...string) error {
 		return err
 	}
 
-	res, err := c.PostV1(context.Background(), path, body, Header{
+	res, err := c.PostV1(WithRetrySafe(ctx), path, body, Header{
 		"Accept":       "application/json",
 		"Content-Type": "application/json",
 	})
@@ -369,7 +414,7 @@ func (c *Client) SprintIssuesAdd(id string, issues ...string) error {
 // Jira api to get all sprints doesn't provide an option to sort results and
 // returns result in ascending order by default. So, we will have to send
 // multiple requests to get the results we are interested in.
-func (c *Client) lastNSprints(boardID int, qp string, limit int) (*SprintResult, error) {
+func (c *Client) lastNSprints(ctx context.Context, boardID int, qp string, limit int) (*SprintResult, error) {
 	var (
 		s        *SprintResult
 		err      error
@@ -377,7 +422,7 @@ func (c *Client) lastNSprints(boardID int, qp string, limit int) (*SprintResult,
 	)
 
 	for {
-		s, err = c.Sprints(boardID, qp, n, limit)
+		s, err = c.SprintsContext(ctx, boardID, qp, n, limit)
 		if err != nil {
 			break
 		}
@@ -399,7 +444,7 @@ func (c *Client) lastNSprints(boardID int, qp string, limit int) (*SprintResult,
 	if n < 0 {
 		return s, err
 	}
-	return c.Sprints(boardID, qp, n, limit)
+	return c.SprintsContext(ctx, boardID, qp, n, limit)
 }
 
 func injectBoardID(sprints []*Sprint, boardID int) {
@@ -1,6 +1,7 @@
 package jira
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -38,12 +39,17 @@ type WorklogSummary struct {
 
 // GetSprintStatistics calculates statistics for a sprint.
 func (c *Client) GetSprintStatistics(sprintID int) (*SprintStatistics, error) {
-	sprint, err := c.GetSprint(sprintID)
+	return c.GetSprintStatisticsContext(c.ctx, sprintID)
+}
+
+// GetSprintStatisticsContext is like GetSprintStatistics but uses ctx to cancel in-flight requests.
+func (c *Client) GetSprintStatisticsContext(ctx context.Context, sprintID int) (*SprintStatistics, error) {
+	sprint, err := c.GetSprintContext(ctx, sprintID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get sprint: %w", err)
 	}
 
-	issues, err := c.SprintIssues(sprintID, "", 0, 1000)
+	issues, err := c.SprintIssuesContext(ctx, sprintID, "", 0, 1000)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get sprint issues: %w", err)
 	}
@@ -98,7 +104,12 @@ func (c *Client) GetSprintStatistics(sprintID int) (*SprintStatistics, error) {
 
 // GetIssueDistribution groups issues by status.
 func (c *Client) GetIssueDistribution(jql string) ([]IssueDistribution, error) {
-	result, err := c.SearchV2(jql, 0, 1000)
+	return c.GetIssueDistributionContext(c.ctx, jql)
+}
+
+// GetIssueDistributionContext is like GetIssueDistribution but uses ctx to cancel in-flight requests.
+func (c *Client) GetIssueDistributionContext(ctx context.Context, jql string) ([]IssueDistribution, error) {
+	result, err := c.SearchV2Context(ctx, jql, 0, 1000)
 	if err != nil {
 		return nil, fmt.Errorf("failed to search issues: %w", err)
 	}
@@ -122,11 +133,16 @@ func (c *Client) GetIssueDistribution(jql string) ([]IssueDistribution, error) {
 
 // GetUserWorklogs retrieves worklogs for a user across issues.
 func (c *Client) GetUserWorklogs(user string, from, to time.Time) (*WorklogSummary, error) {
+	return c.GetUserWorklogsContext(c.ctx, user, from, to)
+}
+
+// GetUserWorklogsContext is like GetUserWorklogs but uses ctx to cancel in-flight requests.
+func (c *Client) GetUserWorklogsContext(ctx context.Context, user string, from, to time.Time) (*WorklogSummary, error) {
 	// Search for issues with worklogs by this user
 	jql := fmt.Sprintf("worklogAuthor = %q AND worklogDate >= %s AND worklogDate <= %s",
 		user, from.Format("2006-01-02"), to.Format("2006-01-02"))
 	
-	result, err := c.SearchV2(jql, 0, 1000)
+	result, err := c.SearchV2Context(ctx, jql, 0, 1000)
 	if err != nil {
 		return nil, fmt.Errorf("failed to search issues: %w", err)
 	}
@@ -142,7 +158,10 @@ func (c *Client) GetUserWorklogs(user string, from, to time.Time) (*WorklogSumma
 	totalSeconds := 0
 
 	for _, issue := range result.Issues {
-		worklogs, err := c.GetWorklogs(issue.Key)
+		worklogs, err := c.GetWorklogsContext(ctx, issue.Key)
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
 		if err != nil {
 			continue
 		}
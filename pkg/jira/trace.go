@@ -0,0 +1,333 @@
+package jira
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptrace"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	redacted = "REDACTED"
+
+	// maxTraceBodySize caps recorded bodies so that large responses don't bloat traces.
+	maxTraceBodySize = 1 << 20
+)
+
+var (
+	sensitiveHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie"}
+
+	// sensitiveFields are parts of names of body fields and query parameters that hold credentials.
+	sensitiveFields = []string{
+		"authorization", "credential", "password", "secret",
+		"apitoken", "api_token", "accesstoken", "access_token", "refreshtoken", "refresh_token",
+	}
+)
+
+// TraceRecorder records http exchanges made by the client.
+type TraceRecorder interface {
+	Record(*TraceEntry)
+}
+
+// TraceFlusher is implemented by trace recorders that buffer entries.
+// Buffered entries are lost if Flush isn't called before the program exits.
+type TraceFlusher interface {
+	Flush() error
+}
+
+// TraceTimings holds time spent in each phase of a request.
+// Phases that didn't happen, eg: DNS lookup on a reused connection, are -1.
+type TraceTimings struct {
+	Blocked time.Duration
+	DNS     time.Duration
+	Connect time.Duration
+	TLS     time.Duration
+	Send    time.Duration
+	Wait    time.Duration
+	Receive time.Duration
+}
+
+// TraceEntry is a recorded http exchange. Credentials are redacted from
+// the headers, query parameters and JSON bodies.
+type TraceEntry struct {
+	Start         time.Time
+	Duration      time.Duration
+	Timings       TraceTimings
+	Method        string
+	URL           string
+	Proto         string
+	RequestHeader http.Header
+	RequestBody   []byte
+	StatusCode    int
+	Status        string
+	Header        http.Header
+	Body          []byte
+	Truncated     bool
+	Err           error
+}
+
+// traceTransport is a http.RoundTripper that records requests and responses.
+type traceTransport struct {
+	next      http.RoundTripper
+	recorders []TraceRecorder
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *traceTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	rt := newRequestTrace()
+
+	entry := TraceEntry{
+		Start:         rt.start,
+		Method:        req.Method,
+		URL:           redactURL(req.URL),
+		Proto:         req.Proto,
+		RequestHeader: redactHeaders(req.Header),
+	}
+	if req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			b, _ := io.ReadAll(body)
+			_ = body.Close()
+			entry.RequestBody = redactBody(b)
+		}
+	}
+
+	req = req.WithContext(httptrace.WithClientTrace(req.Context(), rt.clientTrace()))
+
+	res, err := t.next.RoundTrip(req)
+	if err != nil {
+		entry.Err = err
+		entry.Duration = time.Since(rt.start)
+		entry.Timings = rt.timings(time.Now())
+		t.record(&entry)
+		return res, err
+	}
+
+	entry.StatusCode = res.StatusCode
+	entry.Status = res.Status
+	entry.Proto = res.Proto
+	entry.Header = redactHeaders(res.Header)
+
+	res.Body = &traceBody{
+		ReadCloser: res.Body,
+		onClose: func(body []byte, truncated bool) {
+			end := time.Now()
+
+			entry.Body = redactBody(body)
+			entry.Truncated = truncated
+			entry.Duration = end.Sub(rt.start)
+			entry.Timings = rt.timings(end)
+			t.record(&entry)
+		},
+	}
+	return res, nil
+}
+
+func (t *traceTransport) record(e *TraceEntry) {
+	for _, r := range t.recorders {
+		r.Record(e)
+	}
+}
+
+// traceBody captures the response body as it is read and reports it on close.
+type traceBody struct {
+	io.ReadCloser
+
+	buf       bytes.Buffer
+	truncated bool
+	once      sync.Once
+	onClose   func([]byte, bool)
+}
+
+func (b *traceBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if n > 0 {
+		if room := maxTraceBodySize - b.buf.Len(); room < n {
+			b.buf.Write(p[:max(room, 0)])
+			b.truncated = true
+		} else {
+			b.buf.Write(p[:n])
+		}
+	}
+	return n, err
+}
+
+func (b *traceBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(func() {
+		b.onClose(b.buf.Bytes(), b.truncated)
+	})
+	return err
+}
+
+// requestTrace collects phase timestamps of a request.
+type requestTrace struct {
+	mu sync.Mutex
+
+	start                     time.Time
+	getConn, gotConn          time.Time
+	dnsStart, dnsDone         time.Time
+	connectStart, connectDone time.Time
+	tlsStart, tlsDone         time.Time
+	wroteRequest, firstByte   time.Time
+}
+
+func newRequestTrace() *requestTrace {
+	return &requestTrace{start: time.Now()}
+}
+
+func (rt *requestTrace) set(t *time.Time) func() {
+	return func() {
+		rt.mu.Lock()
+		defer rt.mu.Unlock()
+
+		if t.IsZero() {
+			*t = time.Now()
+		}
+	}
+}
+
+func (rt *requestTrace) clientTrace() *httptrace.ClientTrace {
+	return &httptrace.ClientTrace{
+		GetConn:              func(string) { rt.set(&rt.getConn)() },
+		GotConn:              func(httptrace.GotConnInfo) { rt.set(&rt.gotConn)() },
+		DNSStart:             func(httptrace.DNSStartInfo) { rt.set(&rt.dnsStart)() },
+		DNSDone:              func(httptrace.DNSDoneInfo) { rt.set(&rt.dnsDone)() },
+		ConnectStart:         func(string, string) { rt.set(&rt.connectStart)() },
+		ConnectDone:          func(string, string, error) { rt.set(&rt.connectDone)() },
+		TLSHandshakeStart:    rt.set(&rt.tlsStart),
+		TLSHandshakeDone:     func(tls.ConnectionState, error) { rt.set(&rt.tlsDone)() },
+		WroteRequest:         func(httptrace.WroteRequestInfo) { rt.set(&rt.wroteRequest)() },
+		GotFirstResponseByte: rt.set(&rt.firstByte),
+	}
+}
+
+func (rt *requestTrace) timings(end time.Time) TraceTimings {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+
+	between := func(from, to time.Time) time.Duration {
+		if from.IsZero() || to.IsZero() {
+			return -1
+		}
+		return to.Sub(from)
+	}
+
+	return TraceTimings{
+		Blocked: between(rt.getConn, rt.firstNonZero(rt.dnsStart, rt.connectStart, rt.gotConn)),
+		DNS:     between(rt.dnsStart, rt.dnsDone),
+		Connect: between(rt.connectStart, rt.connectDone),
+		TLS:     between(rt.tlsStart, rt.tlsDone),
+		Send:    between(rt.gotConn, rt.wroteRequest),
+		Wait:    between(rt.wroteRequest, rt.firstByte),
+		Receive: between(rt.firstByte, end),
+	}
+}
+
+func (*requestTrace) firstNonZero(times ...time.Time) time.Time {
+	for _, t := range times {
+		if !t.IsZero() {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// redactHeaders returns a copy of the headers with credentials replaced.
+func redactHeaders(h http.Header) http.Header {
+	out := h.Clone()
+	if out == nil {
+		return http.Header{}
+	}
+	for _, k := range sensitiveHeaders {
+		if _, ok := out[k]; ok {
+			out.Set(k, redacted)
+		}
+	}
+	for k := range out {
+		if strings.Contains(strings.ToLower(k), "token") {
+			out.Set(k, redacted)
+		}
+	}
+	return out
+}
+
+// redactURL returns the url with values of credential-like query parameters replaced.
+func redactURL(u *url.URL) string {
+	q := u.Query()
+
+	changed := false
+	for k := range q {
+		if isSensitiveField(k) {
+			q.Set(k, redacted)
+			changed = true
+		}
+	}
+	if !changed {
+		return u.String()
+	}
+
+	out := *u
+	out.RawQuery = q.Encode()
+	return out.String()
+}
+
+// redactBody returns a copy of a JSON body with values of credential-like fields
+// replaced, eg: the secret of a webhook. Other bodies are returned as is.
+func redactBody(b []byte) []byte {
+	var v any
+
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	if len(b) == 0 || dec.Decode(&v) != nil || !redactValue(v) {
+		return b
+	}
+
+	out, err := json.Marshal(v)
+	if err != nil {
+		return b
+	}
+	return out
+}
+
+func redactValue(v any) bool {
+	changed := false
+
+	switch v := v.(type) {
+	case map[string]any:
+		for k, val := range v {
+			switch val.(type) {
+			case map[string]any, []any:
+				changed = redactValue(val) || changed
+			default:
+				if isSensitiveField(k) && val != nil {
+					v[k] = redacted
+					changed = true
+				}
+			}
+		}
+	case []any:
+		for _, val := range v {
+			changed = redactValue(val) || changed
+		}
+	}
+	return changed
+}
+
+func isSensitiveField(name string) bool {
+	name = strings.ToLower(name)
+	if name == "token" {
+		return true
+	}
+	for _, f := range sensitiveFields {
+		if strings.Contains(name, f) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,176 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTraceHAR(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Set-Cookie", "JSESSIONID=secret")
+
+		if r.Method == http.MethodPost {
+			w.WriteHeader(201)
+			_, _ = w.Write([]byte(`{"id":"10000"}`))
+			return
+		}
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{"name":"jira"}`))
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "out.har")
+	har := NewHARFile(path, "v1.0.0")
+
+	client := NewClient(
+		Config{Server: server.URL, Login: "jon", APIToken: "secret-token"},
+		WithTimeout(3*time.Second),
+		WithTraceRecorder(har),
+	)
+
+	res, err := client.GetV2(context.Background(), "/serverInfo?doHealthCheck=true", nil)
+	assert.NoError(t, err)
+	_, _ = io.ReadAll(res.Body)
+	_ = res.Body.Close()
+
+	res, err = client.PostV2(context.Background(), "/issue", []byte(`{"fields":{}}`), Header{"Content-Type": "application/json"})
+	assert.NoError(t, err)
+	_, _ = io.ReadAll(res.Body)
+	_ = res.Body.Close()
+
+	res, err = client.PostV2(context.Background(), "/webhook", []byte(`{"url":"https://example.com","secret":"s3cr3t"}`), Header{"Content-Type": "application/json"})
+	assert.NoError(t, err)
+	_, _ = io.ReadAll(res.Body)
+	_ = res.Body.Close()
+
+	_, err = os.Stat(path)
+	assert.True(t, os.IsNotExist(err), "entries are written on flush")
+	assert.NoError(t, har.Flush())
+
+	b, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(b), "secret-token")
+	assert.NotContains(t, string(b), "JSESSIONID")
+	assert.NotContains(t, string(b), "s3cr3t")
+
+	var actual HAR
+	assert.NoError(t, json.Unmarshal(b, &actual))
+
+	assert.Equal(t, "1.2", actual.Log.Version)
+	assert.Equal(t, HARCreator{Name: "jira-cli", Version: "v1.0.0"}, actual.Log.Creator)
+	assert.Len(t, actual.Log.Entries, 3)
+
+	get := actual.Log.Entries[0]
+	assert.Equal(t, "GET", get.Request.Method)
+	assert.Equal(t, server.URL+"/rest/api/2/serverInfo?doHealthCheck=true", get.Request.URL)
+	assert.Equal(t, []HARNameValue{{Name: "doHealthCheck", Value: "true"}}, get.Request.QueryString)
+	assert.Contains(t, get.Request.Headers, HARNameValue{Name: "Authorization", Value: "REDACTED"})
+	assert.Equal(t, 200, get.Response.Status)
+	assert.Equal(t, "OK", get.Response.StatusText)
+	assert.Equal(t, `{"name":"jira"}`, get.Response.Content.Text)
+	assert.Contains(t, get.Response.Headers, HARNameValue{Name: "Set-Cookie", Value: "REDACTED"})
+	assert.Greater(t, get.Time, float64(0))
+
+	post := actual.Log.Entries[1]
+	assert.Equal(t, "POST", post.Request.Method)
+	assert.Equal(t, &HARPostData{MimeType: "application/json", Text: `{"fields":{}}`}, post.Request.PostData)
+	assert.Equal(t, 201, post.Response.Status)
+
+	webhook := actual.Log.Entries[2]
+	assert.JSONEq(t, `{"url":"https://example.com","secret":"REDACTED"}`, webhook.Request.PostData.Text)
+}
+
+func TestTraceOTLP(t *testing.T) {
+	spans := make(chan map[string]any, 1)
+
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/traces", r.URL.Path)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		var payload struct {
+			ResourceSpans []struct {
+				ScopeSpans []struct {
+					Spans []map[string]any `json:"spans"`
+				} `json:"scopeSpans"`
+			} `json:"resourceSpans"`
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		spans <- payload.ResourceSpans[0].ScopeSpans[0].Spans[0]
+
+		w.WriteHeader(200)
+	}))
+	defer collector.Close()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(400)
+	}))
+	defer server.Close()
+
+	exporter := NewOTLPExporter(collector.URL, "v1.0.0")
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second), WithTraceRecorder(exporter))
+
+	_, err := client.GetV2(context.Background(), "/myself", nil)
+	assert.Error(t, err)
+	assert.NoError(t, exporter.Flush())
+
+	span := <-spans
+	assert.Equal(t, exporter.TraceID(), span["traceId"])
+	assert.Equal(t, "GET /rest/api/2/myself", span["name"])
+	assert.Equal(t, float64(otlpSpanKindClient), span["kind"])
+	assert.Equal(t, float64(otlpStatusError), span["status"].(map[string]any)["code"])
+}
+
+func TestRedactHeaders(t *testing.T) {
+	t.Parallel()
+
+	h := http.Header{
+		"Authorization":     []string{"Basic am9uOnNlY3JldA=="},
+		"X-Atlassian-Token": []string{"no-check"},
+		"Content-Type":      []string{"application/json"},
+	}
+	actual := redactHeaders(h)
+
+	assert.Equal(t, "REDACTED", actual.Get("Authorization"))
+	assert.Equal(t, "REDACTED", actual.Get("X-Atlassian-Token"))
+	assert.Equal(t, "application/json", actual.Get("Content-Type"))
+	assert.Equal(t, "Basic am9uOnNlY3JldA==", h.Get("Authorization"))
+}
+
+func TestRedactBody(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		body     string
+		expected string
+	}{
+		{`{"name":"hook","secret":"s3cr3t","id":10000000000000001}`, `{"name":"hook","secret":"REDACTED","id":10000000000000001}`},
+		{`{"user":{"password":"p","apiToken":"t"},"tokens":[{"token":"t"}]}`, `{"user":{"password":"REDACTED","apiToken":"REDACTED"},"tokens":[{"token":"REDACTED"}]}`},
+		{`{"nextPageToken":"abc","secret":null}`, `{"nextPageToken":"abc","secret":null}`},
+	}
+
+	for _, tc := range cases {
+		assert.JSONEq(t, tc.expected, string(redactBody([]byte(tc.body))), tc.body)
+	}
+	assert.Equal(t, "not json, secret=s3cr3t", string(redactBody([]byte("not json, secret=s3cr3t"))))
+}
+
+func TestRedactURL(t *testing.T) {
+	t.Parallel()
+
+	u, _ := url.Parse("https://jira.example.com/rest/api/2/search?jql=a&access_token=t&nextPageToken=p")
+	assert.Equal(t, "https://jira.example.com/rest/api/2/search?access_token=REDACTED&jql=a&nextPageToken=p", redactURL(u))
+
+	u, _ = url.Parse("https://jira.example.com/rest/api/2/search?jql=b&maxResults=1")
+	assert.Equal(t, "https://jira.example.com/rest/api/2/search?jql=b&maxResults=1", redactURL(u))
+}
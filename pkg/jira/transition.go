@@ -31,6 +31,29 @@ type TransitionRequestFields struct {
 	Resolution *struct {
 		Name string `json:"name"`
 	} `json:"resolution,omitempty"`
+	// Extra holds other screen fields keyed by field id, eg: required fields of a transition screen.
+	Extra map[string]any `json:"-"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (f TransitionRequestFields) MarshalJSON() ([]byte, error) {
+	type fields TransitionRequestFields
+
+	b, err := json.Marshal(fields(f))
+	if err != nil || len(f.Extra) == 0 {
+		return b, err
+	}
+
+	out := make(map[string]any, len(f.Extra))
+	if err := json.Unmarshal(b, &out); err != nil {
+		return nil, err
+	}
+	for k, v := range f.Extra {
+		if _, ok := out[k]; !ok {
+			out[k] = v
+		}
+	}
+	return json.Marshal(out)
 }
 
 // TransitionRequestData is a transition request data.
@@ -46,16 +69,49 @@ type transitionResponse struct {
 
 // Transitions fetches valid transitions for an issue using v3 version of the GET /issue/{key}/transitions endpoint.
 func (c *Client) Transitions(key string) ([]*Transition, error) {
-	return c.transitions(key, apiVersion3)
+	return c.TransitionsContext(c.ctx, key)
+}
+
+// TransitionsContext is like Transitions but uses ctx to cancel in-flight requests.
+func (c *Client) TransitionsContext(ctx context.Context, key string) ([]*Transition, error) {
+	return c.transitions(ctx, key, apiVersion3, false)
 }
 
 // TransitionsV2 fetches valid transitions for an issue using v2 version of the GET /issue/{key}/transitions endpoint.
 func (c *Client) TransitionsV2(key string) ([]*Transition, error) {
-	return c.transitions(key, apiVersion2)
+	return c.TransitionsV2Context(c.ctx, key)
+}
+
+// TransitionsV2Context is like TransitionsV2 but uses ctx to cancel in-flight requests.
+func (c *Client) TransitionsV2Context(ctx context.Context, key string) ([]*Transition, error) {
+	return c.transitions(ctx, key, apiVersion2, false)
 }
 
-func (c *Client) transitions(key, ver string) ([]*Transition, error) {
+// TransitionsWithFields is like Transitions but also fetches fields of the transition screens.
+func (c *Client) TransitionsWithFields(key string) ([]*Transition, error) {
+	return c.TransitionsWithFieldsContext(c.ctx, key)
+}
+
+// TransitionsWithFieldsContext is like TransitionsWithFields but uses ctx to cancel in-flight requests.
+func (c *Client) TransitionsWithFieldsContext(ctx context.Context, key string) ([]*Transition, error) {
+	return c.transitions(ctx, key, apiVersion3, true)
+}
+
+// TransitionsV2WithFields is like TransitionsV2 but also fetches fields of the transition screens.
+func (c *Client) TransitionsV2WithFields(key string) ([]*Transition, error) {
+	return c.TransitionsV2WithFieldsContext(c.ctx, key)
+}
+
+// TransitionsV2WithFieldsContext is like TransitionsV2WithFields but uses ctx to cancel in-flight requests.
+func (c *Client) TransitionsV2WithFieldsContext(ctx context.Context, key string) ([]*Transition, error) {
+	return c.transitions(ctx, key, apiVersion2, true)
+}
+
+func (c *Client) transitions(ctx context.Context, key, ver string, withFields bool) ([]*Transition, error) {
 	path := fmt.Sprintf("/issue/%s/transitions", key)
+	if withFields {
+		path += "?expand=transitions.fields"
+	}
 
 	var (
 		res *http.Response
@@ -64,9 +120,9 @@ func (c *Client) transitions(key, ver string) ([]*Transition, error) {
 
 	switch ver {
 	case apiVersion2:
-		res, err = c.GetV2(context.Background(), path, nil)
+		res, err = c.GetV2(ctx, path, nil)
 	default:
-		res, err = c.Get(context.Background(), path, nil)
+		res, err = c.Get(ctx, path, nil)
 	}
 
 	if err != nil {
@@ -90,6 +146,11 @@ func (c *Client) transitions(key, ver string) ([]*Transition, error) {
 
 // Transition moves issue from one state to another using POST /issue/{key}/transitions endpoint.
 func (c *Client) Transition(key string, data *TransitionRequest) (int, error) {
+	return c.TransitionContext(c.ctx, key, data)
+}
+
+// TransitionContext is like Transition but uses ctx to cancel in-flight requests.
+func (c *Client) TransitionContext(ctx context.Context, key string, data *TransitionRequest) (int, error) {
 	body, err := json.Marshal(&data)
 	if err != nil {
 		return 0, err
@@ -97,7 +158,7 @@ func (c *Client) Transition(key string, data *TransitionRequest) (int, error) {
 
 	path := fmt.Sprintf("/issue/%s/transitions", key)
 
-	res, err := c.PostV2(context.Background(), path, body, Header{
+	res, err := c.PostV2(ctx, path, body, Header{
 		"Accept":       "application/json",
 		"Content-Type": "application/json",
 	})
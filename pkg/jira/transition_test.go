@@ -1,6 +1,7 @@
 package jira
 
 import (
+	"encoding/json"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -104,3 +105,58 @@ func TestTransition(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, code, 204)
 }
+
+func TestTransitionsWithFields(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/issue/TEST/transitions", r.URL.Path)
+		assert.Equal(t, "transitions.fields", r.URL.Query().Get("expand"))
+
+		resp, err := os.ReadFile("./testdata/transitions-fields.json")
+		assert.NoError(t, err)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write(resp)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.TransitionsV2WithFields("TEST")
+	assert.NoError(t, err)
+	assert.Len(t, actual, 1)
+
+	tr := actual[0]
+	assert.Equal(t, &TransitionStatus{
+		ID:             "10001",
+		Name:           "Done",
+		StatusCategory: StatusCategory{Key: "done", Name: "Done"},
+	}, tr.To)
+
+	field := tr.Fields["resolution"]
+	assert.True(t, field.Required)
+	assert.Equal(t, "resolution", field.Schema.Type)
+	assert.Equal(t, []TransitionFieldValue{{ID: "1", Name: "Fixed"}, {ID: "2", Name: "Won't Fix"}}, field.AllowedValues)
+}
+
+func TestTransitionRequestExtraFields(t *testing.T) {
+	fields := TransitionRequestFields{
+		Resolution: &struct {
+			Name string `json:"name"`
+		}{Name: "Fixed"},
+		Extra: map[string]any{
+			"resolution":        map[string]string{"id": "2"},
+			"customfield_10010": map[string]string{"id": "10"},
+		},
+	}
+
+	b, err := json.Marshal(&TransitionRequest{
+		Fields:     &fields,
+		Transition: &TransitionRequestData{ID: "41", Name: "Resolve"},
+	})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"fields": {"resolution": {"name": "Fixed"}, "customfield_10010": {"id": "10"}},
+		"transition": {"id": "41", "name": "Resolve"}
+	}`, string(b))
+}
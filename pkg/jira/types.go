@@ -28,6 +28,7 @@ func (at AuthType) String() string {
 
 // Project holds project info.
 type Project struct {
+	ID   string `json:"id,omitempty"`
 	Key  string `json:"key"`
 	Name string `json:"name"`
 	Lead struct {
@@ -38,12 +39,17 @@ type Project struct {
 
 // ProjectVersion holds project version info.
 type ProjectVersion struct {
-	Archived    bool        `json:"archived"`
-	Description interface{} `json:"description"`
-	ID          string      `json:"id"`
-	Name        string      `json:"name"`
-	ProjectID   int         `json:"projectId"`
-	Released    bool        `json:"released"`
+	Archived        bool        `json:"archived"`
+	Description     interface{} `json:"description"`
+	ID              string      `json:"id"`
+	Name            string      `json:"name"`
+	ProjectID       int         `json:"projectId"`
+	Released        bool        `json:"released"`
+	ReleaseDate     string      `json:"releaseDate,omitempty"`
+	StartDate       string      `json:"startDate,omitempty"`
+	UserReleaseDate string      `json:"userReleaseDate,omitempty"`
+	UserStartDate   string      `json:"userStartDate,omitempty"`
+	Overdue         bool        `json:"overdue,omitempty"`
 }
 
 // Board holds board info.
@@ -90,9 +96,7 @@ type IssueFields struct {
 		IsWatching bool `json:"isWatching"`
 		WatchCount int  `json:"watchCount"`
 	} `json:"watches"`
-	Status struct {
-		Name string `json:"name"`
-	} `json:"status"`
+	Status     IssueStatus `json:"status"`
 	Components []struct {
 		Name string `json:"name"`
 	} `json:"components"`
@@ -123,8 +127,8 @@ type IssueFields struct {
 		OutwardIssue *Issue `json:"outwardIssue,omitempty"`
 	} `json:"issueLinks"`
 	Attachments []*Attachment `json:"attachment"`
-	Created     string         `json:"created"`
-	Updated     string         `json:"updated"`
+	Created     string        `json:"created"`
+	Updated     string        `json:"updated"`
 }
 
 // Field holds field info.
@@ -183,6 +187,48 @@ type Transition struct {
 	ID          json.Number `json:"id"`
 	Name        string      `json:"name"`
 	IsAvailable bool        `json:"isAvailable"`
+	// To is the status the issue is moved to. It is nil if the server doesn't return it.
+	To *TransitionStatus `json:"to,omitempty"`
+	// Fields of the transition screen keyed by field id, only set if requested.
+	Fields map[string]TransitionField `json:"fields,omitempty"`
+}
+
+// TransitionField is a field of a transition screen.
+type TransitionField struct {
+	Required        bool   `json:"required"`
+	Name            string `json:"name"`
+	HasDefaultValue bool   `json:"hasDefaultValue"`
+	Schema          struct {
+		Type  string `json:"type"`
+		Items string `json:"items,omitempty"`
+	} `json:"schema"`
+	AllowedValues []TransitionFieldValue `json:"allowedValues,omitempty"`
+}
+
+// TransitionFieldValue is an allowed value of a transition screen field.
+type TransitionFieldValue struct {
+	ID    string `json:"id"`
+	Name  string `json:"name,omitempty"`
+	Value string `json:"value,omitempty"`
+}
+
+// TransitionStatus is the target status of a transition.
+type TransitionStatus struct {
+	ID             string         `json:"id"`
+	Name           string         `json:"name"`
+	StatusCategory StatusCategory `json:"statusCategory"`
+}
+
+// IssueStatus is the status an issue is in.
+type IssueStatus struct {
+	Name           string         `json:"name"`
+	StatusCategory StatusCategory `json:"statusCategory"`
+}
+
+// StatusCategory groups statuses, eg: To Do, In Progress and Done.
+type StatusCategory struct {
+	Key  string `json:"key"`
+	Name string `json:"name"`
 }
 
 // User holds user info.
@@ -24,16 +24,26 @@ type UserSearchOptions struct {
 
 // UserSearch search for user details using v3 version of the GET /user/assignable/search endpoint.
 func (c *Client) UserSearch(opt *UserSearchOptions) ([]*User, error) {
-	return c.userSearch(opt, apiVersion3)
+	return c.UserSearchContext(c.ctx, opt)
+}
+
+// UserSearchContext is like UserSearch but uses ctx to cancel in-flight requests.
+func (c *Client) UserSearchContext(ctx context.Context, opt *UserSearchOptions) ([]*User, error) {
+	return c.userSearch(ctx, opt, apiVersion3)
 }
 
 // UserSearchV2 search for user details using v2 version of the GET /user/assignable/search endpoint.
 func (c *Client) UserSearchV2(opt *UserSearchOptions) ([]*User, error) {
+	return c.UserSearchV2Context(c.ctx, opt)
+}
+
+// UserSearchV2Context is like UserSearchV2 but uses ctx to cancel in-flight requests.
+func (c *Client) UserSearchV2Context(ctx context.Context, opt *UserSearchOptions) ([]*User, error) {
 	// Use query parameter for v2 (username param is deprecated)
-	return c.userSearch(opt, apiVersion2)
+	return c.userSearch(ctx, opt, apiVersion2)
 }
 
-func (c *Client) userSearch(opt *UserSearchOptions, ver string) ([]*User, error) {
+func (c *Client) userSearch(ctx context.Context, opt *UserSearchOptions, ver string) ([]*User, error) {
 	if opt == nil {
 		return nil, ErrInvalidSearchOption
 	}
@@ -70,9 +80,9 @@ func (c *Client) userSearch(opt *UserSearchOptions, ver string) ([]*User, error)
 
 	switch ver {
 	case apiVersion2:
-		res, err = c.GetV2(context.Background(), path, nil)
+		res, err = c.GetV2(ctx, path, nil)
 	default:
-		res, err = c.Get(context.Background(), path, nil)
+		res, err = c.Get(ctx, path, nil)
 	}
 
 	if err != nil {
@@ -0,0 +1,179 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"path"
+)
+
+// Webhook events.
+const (
+	WebhookIssueCreated   = "jira:issue_created"
+	WebhookIssueUpdated   = "jira:issue_updated"
+	WebhookIssueDeleted   = "jira:issue_deleted"
+	WebhookCommentCreated = "comment_created"
+	WebhookCommentUpdated = "comment_updated"
+	WebhookCommentDeleted = "comment_deleted"
+	WebhookSprintStarted  = "sprint_started"
+	WebhookSprintClosed   = "sprint_closed"
+
+	// WebhookJQLFilter is the filter key that limits issue related events to issues matching a JQL.
+	WebhookJQLFilter = "issue-related-events-section"
+)
+
+// WebhookEvents lists webhook events supported by the CLI.
+var WebhookEvents = []string{
+	WebhookIssueCreated, WebhookIssueUpdated, WebhookIssueDeleted,
+	WebhookCommentCreated, WebhookCommentUpdated, WebhookCommentDeleted,
+	WebhookSprintStarted, WebhookSprintClosed,
+}
+
+// Webhook is a webhook registered in jira.
+type Webhook struct {
+	Self        string            `json:"self,omitempty"`
+	Name        string            `json:"name"`
+	URL         string            `json:"url"`
+	Events      []string          `json:"events"`
+	Filters     map[string]string `json:"filters,omitempty"`
+	ExcludeBody bool              `json:"excludeBody"`
+	Enabled     bool              `json:"enabled"`
+	// Secret is used to sign payloads, it is never returned by jira.
+	Secret string `json:"secret,omitempty"`
+}
+
+// ID returns id of the webhook, ie: the last segment of its self link.
+func (w *Webhook) ID() string {
+	if w.Self == "" {
+		return ""
+	}
+	u, err := url.Parse(w.Self)
+	if err != nil {
+		return ""
+	}
+	return path.Base(u.Path)
+}
+
+// JQL returns the JQL issue related events are filtered with.
+func (w *Webhook) JQL() string {
+	return w.Filters[WebhookJQLFilter]
+}
+
+// WebhookEvent is a payload jira sends to webhooks. Only the fields
+// relevant to the event are set, eg: Sprint for sprint events.
+type WebhookEvent struct {
+	Timestamp      int64             `json:"timestamp"`
+	Event          string            `json:"webhookEvent"`
+	IssueEventType string            `json:"issue_event_type_name,omitempty"`
+	User           *User             `json:"user,omitempty"`
+	Issue          *Issue            `json:"issue,omitempty"`
+	Changelog      *WebhookChangelog `json:"changelog,omitempty"`
+	Comment        *Comment          `json:"comment,omitempty"`
+	Sprint         *Sprint           `json:"sprint,omitempty"`
+}
+
+// WebhookChangelog holds fields changed by an issue update.
+type WebhookChangelog struct {
+	ID    string        `json:"id"`
+	Items []HistoryItem `json:"items"`
+}
+
+// Changed returns the change of a field in the event if any.
+func (e *WebhookEvent) Changed(field string) (*HistoryItem, bool) {
+	if e.Changelog == nil {
+		return nil, false
+	}
+	for i, item := range e.Changelog.Items {
+		if item.Field == field {
+			return &e.Changelog.Items[i], true
+		}
+	}
+	return nil, false
+}
+
+// GetWebhooks fetches registered webhooks using GET /webhook endpoint.
+func (c *Client) GetWebhooks() ([]*Webhook, error) {
+	return c.GetWebhooksContext(c.ctx)
+}
+
+// GetWebhooksContext is like GetWebhooks but uses ctx to cancel in-flight requests.
+func (c *Client) GetWebhooksContext(ctx context.Context) ([]*Webhook, error) {
+	res, err := c.GetWH(ctx, "/webhook", Header{"Accept": "application/json"})
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out []*Webhook
+	if err := json.NewDecoder(res.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// CreateWebhook registers a webhook using POST /webhook endpoint.
+func (c *Client) CreateWebhook(wh *Webhook) (*Webhook, error) {
+	return c.CreateWebhookContext(c.ctx, wh)
+}
+
+// CreateWebhookContext is like CreateWebhook but uses ctx to cancel in-flight requests.
+func (c *Client) CreateWebhookContext(ctx context.Context, wh *Webhook) (*Webhook, error) {
+	body, err := json.Marshal(wh)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.PostWH(ctx, "/webhook", body, Header{
+		"Accept":       "application/json",
+		"Content-Type": "application/json",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusCreated && res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out Webhook
+	if err := json.NewDecoder(res.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+
+	return &out, nil
+}
+
+// DeleteWebhook removes a webhook using DELETE /webhook/{id} endpoint.
+func (c *Client) DeleteWebhook(id string) error {
+	return c.DeleteWebhookContext(c.ctx, id)
+}
+
+// DeleteWebhookContext is like DeleteWebhook but uses ctx to cancel in-flight requests.
+func (c *Client) DeleteWebhookContext(ctx context.Context, id string) error {
+	res, err := c.DeleteWH(ctx, "/webhook/"+url.PathEscape(id), nil)
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusNoContent && res.StatusCode != http.StatusOK {
+		return formatUnexpectedResponse(res)
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+package jira
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetWebhooks(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/webhooks/1.0/webhook", r.URL.Path)
+		assert.Equal(t, http.MethodGet, r.Method)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`[{
+			"self": "https://test.atlassian.net/rest/webhooks/1.0/webhook/7",
+			"name": "Local automation",
+			"url": "https://hooks.example.com/jira",
+			"events": ["jira:issue_updated", "comment_created"],
+			"filters": {"issue-related-events-section": "project = TEST"},
+			"excludeBody": false,
+			"enabled": true
+		}]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.GetWebhooks()
+	assert.NoError(t, err)
+	assert.Len(t, actual, 1)
+	assert.Equal(t, "7", actual[0].ID())
+	assert.Equal(t, "project = TEST", actual[0].JQL())
+	assert.Equal(t, []string{WebhookIssueUpdated, WebhookCommentCreated}, actual[0].Events)
+	assert.True(t, actual[0].Enabled)
+
+	unexpectedStatusCode = true
+
+	_, err = client.GetWebhooks()
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestCreateWebhook(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/webhooks/1.0/webhook", r.URL.Path)
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+
+		var actual map[string]any
+		assert.NoError(t, json.Unmarshal(body, &actual))
+		assert.Equal(t, map[string]any{
+			"name":        "Local automation",
+			"url":         "https://hooks.example.com/jira",
+			"events":      []any{"jira:issue_created"},
+			"filters":     map[string]any{"issue-related-events-section": "project = TEST"},
+			"excludeBody": false,
+			"enabled":     true,
+			"secret":      "s3cret",
+		}, actual)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(201)
+		_, _ = w.Write([]byte(`{"self": "https://test.atlassian.net/rest/webhooks/1.0/webhook/8", "name": "Local automation"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	wh := Webhook{
+		Name:    "Local automation",
+		URL:     "https://hooks.example.com/jira",
+		Events:  []string{WebhookIssueCreated},
+		Filters: map[string]string{WebhookJQLFilter: "project = TEST"},
+		Enabled: true,
+		Secret:  "s3cret",
+	}
+
+	actual, err := client.CreateWebhook(&wh)
+	assert.NoError(t, err)
+	assert.Equal(t, "8", actual.ID())
+
+	unexpectedStatusCode = true
+
+	_, err = client.CreateWebhook(&wh)
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestDeleteWebhook(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/webhooks/1.0/webhook/8", r.URL.Path)
+		assert.Equal(t, http.MethodDelete, r.Method)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(404)
+		} else {
+			w.WriteHeader(204)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	assert.NoError(t, client.DeleteWebhook("8"))
+
+	unexpectedStatusCode = true
+
+	assert.Error(t, &ErrUnexpectedResponse{}, client.DeleteWebhook("8"))
+}
+
+func TestWebhookEventChanged(t *testing.T) {
+	var ev WebhookEvent
+
+	assert.NoError(t, json.Unmarshal([]byte(`{
+		"webhookEvent": "jira:issue_updated",
+		"issue": {"key": "TEST-1", "fields": {"issuetype": {"name": "Bug"}, "status": {"name": "Done"}}},
+		"changelog": {"id": "1", "items": [{"field": "status", "fromString": "To Do", "toString": "Done"}]}
+	}`), &ev))
+
+	assert.Equal(t, "Bug", ev.Issue.Fields.IssueType.Name)
+
+	item, ok := ev.Changed("status")
+	assert.True(t, ok)
+	assert.Equal(t, "Done", item.ToString)
+
+	_, ok = ev.Changed("assignee")
+	assert.False(t, ok)
+
+	_, ok = (&WebhookEvent{}).Changed("status")
+	assert.False(t, ok)
+}
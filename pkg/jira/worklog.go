@@ -31,8 +31,13 @@ type WorklogList struct {
 
 // GetWorklogs retrieves all worklogs for an issue.
 func (c *Client) GetWorklogs(key string) ([]*Worklog, error) {
+	return c.GetWorklogsContext(c.ctx, key)
+}
+
+// GetWorklogsContext is like GetWorklogs but uses ctx to cancel in-flight requests.
+func (c *Client) GetWorklogsContext(ctx context.Context, key string) ([]*Worklog, error) {
 	path := fmt.Sprintf("/issue/%s/worklog", key)
-	res, err := c.GetV2(context.Background(), path, Header{
+	res, err := c.GetV2(ctx, path, Header{
 		"Accept": "application/json",
 	})
 	if err != nil {
@@ -58,6 +63,11 @@ func (c *Client) GetWorklogs(key string) ([]*Worklog, error) {
 
 // UpdateWorklog updates an existing worklog entry.
 func (c *Client) UpdateWorklog(key, worklogID, started, timeSpent, comment string) error {
+	return c.UpdateWorklogContext(c.ctx, key, worklogID, started, timeSpent, comment)
+}
+
+// UpdateWorklogContext is like UpdateWorklog but uses ctx to cancel in-flight requests.
+func (c *Client) UpdateWorklogContext(ctx context.Context, key, worklogID, started, timeSpent, comment string) error {
 	updateReq := struct {
 		Started   string `json:"started,omitempty"`
 		TimeSpent string `json:"timeSpent,omitempty"`
@@ -77,7 +87,7 @@ func (c *Client) UpdateWorklog(key, worklogID, started, timeSpent, comment strin
 	}
 
 	path := fmt.Sprintf("/issue/%s/worklog/%s", key, worklogID)
-	res, err := c.PutV2(context.Background(), path, bodyBytes, Header{
+	res, err := c.PutV2(ctx, path, bodyBytes, Header{
 		"Accept":       "application/json",
 		"Content-Type": "application/json",
 	})
@@ -98,8 +108,13 @@ func (c *Client) UpdateWorklog(key, worklogID, started, timeSpent, comment strin
 
 // DeleteWorklog deletes a worklog entry from an issue.
 func (c *Client) DeleteWorklog(key, worklogID string) error {
+	return c.DeleteWorklogContext(c.ctx, key, worklogID)
+}
+
+// DeleteWorklogContext is like DeleteWorklog but uses ctx to cancel in-flight requests.
+func (c *Client) DeleteWorklogContext(ctx context.Context, key, worklogID string) error {
 	path := fmt.Sprintf("/issue/%s/worklog/%s", key, worklogID)
-	res, err := c.DeleteV2(context.Background(), path, Header{
+	res, err := c.DeleteV2(ctx, path, Header{
 		"Accept": "application/json",
 	})
 	if err != nil {
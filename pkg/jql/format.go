@@ -0,0 +1,305 @@
+package jql
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// TokenKind is a kind of JQL token.
+type TokenKind int
+
+// Kinds of JQL tokens.
+const (
+	TokenWord TokenKind = iota
+	TokenString
+	TokenOperator
+	TokenLeftParen
+	TokenRightParen
+	TokenComma
+)
+
+const (
+	operatorChars = "=!<>~"
+	indentation   = "  "
+)
+
+// keywords are reserved words that are upper-cased when formatting.
+var keywords = map[string]bool{
+	"AND": true, "OR": true, "NOT": true, "IN": true, "IS": true, "EMPTY": true, "NULL": true,
+	"ORDER": true, "BY": true, "ASC": true, "DESC": true, "WAS": true, "CHANGED": true,
+}
+
+// predicates are keywords of WAS and CHANGED clauses.
+var predicates = map[string]bool{
+	"FROM": true, "TO": true, "BY": true, "ON": true, "BEFORE": true, "AFTER": true, "DURING": true,
+}
+
+// Token is a lexical token of a JQL query.
+type Token struct {
+	Kind  TokenKind
+	Value string
+	// Pos is the byte offset of the token in the query.
+	Pos int
+}
+
+// Is checks if the token is the given keyword.
+func (t Token) Is(keyword string) bool {
+	return t.Kind == TokenWord && strings.EqualFold(t.Value, keyword)
+}
+
+// IsKeyword checks if the token is a reserved JQL word.
+func (t Token) IsKeyword() bool {
+	return t.Kind == TokenWord && keywords[strings.ToUpper(t.Value)]
+}
+
+// Tokenize splits a JQL query into tokens.
+//
+// If the query ends with an unterminated string, the partial string is
+// returned as the last token along with an error.
+func Tokenize(q string) ([]Token, error) {
+	var tokens []Token
+
+	for i := 0; i < len(q); {
+		c := q[i]
+
+		switch {
+		case unicode.IsSpace(rune(c)):
+			i++
+		case c == '(':
+			tokens = append(tokens, Token{Kind: TokenLeftParen, Value: "(", Pos: i})
+			i++
+		case c == ')':
+			tokens = append(tokens, Token{Kind: TokenRightParen, Value: ")", Pos: i})
+			i++
+		case c == ',':
+			tokens = append(tokens, Token{Kind: TokenComma, Value: ",", Pos: i})
+			i++
+		case c == '"' || c == '\'':
+			j := i + 1
+			for ; j < len(q) && q[j] != c; j++ {
+				if q[j] == '\\' {
+					j++
+				}
+			}
+			if j >= len(q) {
+				tokens = append(tokens, Token{Kind: TokenString, Value: q[i:], Pos: i})
+				return tokens, fmt.Errorf("unterminated string at position %d", i+1)
+			}
+			tokens = append(tokens, Token{Kind: TokenString, Value: q[i : j+1], Pos: i})
+			i = j + 1
+		case strings.IndexByte(operatorChars, c) >= 0:
+			j := i
+			for j < len(q) && strings.IndexByte(operatorChars, q[j]) >= 0 {
+				j++
+			}
+			tokens = append(tokens, Token{Kind: TokenOperator, Value: q[i:j], Pos: i})
+			i = j
+		default:
+			j := i
+			for j < len(q) && !isDelimiter(q[j]) {
+				j++
+			}
+			tokens = append(tokens, Token{Kind: TokenWord, Value: q[i:j], Pos: i})
+			i = j
+		}
+	}
+
+	return tokens, nil
+}
+
+func isDelimiter(c byte) bool {
+	return unicode.IsSpace(rune(c)) || strings.IndexByte(`(),"'`+operatorChars, c) >= 0
+}
+
+// Format pretty-prints a JQL query.
+//
+// Keywords are upper-cased and each clause joined with AND or OR is put on its
+// own line. Groups of clauses in parentheses are indented.
+func Format(q string) (string, error) {
+	return format(q, false)
+}
+
+// Compact formats a JQL query in a single line.
+func Compact(q string) (string, error) {
+	return format(q, true)
+}
+
+type paren struct {
+	group, multiline bool
+}
+
+type formatter struct {
+	lines  []string
+	line   strings.Builder
+	indent int
+	prev   *Token
+}
+
+func (f *formatter) newline() {
+	if f.line.Len() > 0 {
+		f.lines = append(f.lines, strings.Repeat(indentation, f.indent)+f.line.String())
+	}
+	f.line.Reset()
+	f.prev = nil
+}
+
+func (f *formatter) write(t Token, text string) {
+	if f.prev != nil && needSpace(*f.prev, t) {
+		f.line.WriteByte(' ')
+	}
+	f.line.WriteString(text)
+	f.prev = &t
+}
+
+func needSpace(prev, cur Token) bool {
+	switch {
+	case cur.Kind == TokenRightParen || cur.Kind == TokenComma:
+		return false
+	case prev.Kind == TokenLeftParen:
+		return false
+	case cur.Kind == TokenLeftParen && prev.Kind == TokenWord && !prev.IsKeyword() && !predicates[strings.ToUpper(prev.Value)]:
+		// Function call, eg: currentUser().
+		return false
+	}
+	return true
+}
+
+func format(q string, compact bool) (string, error) {
+	tokens, err := Tokenize(q)
+	if err != nil {
+		return "", err
+	}
+
+	var (
+		f       formatter
+		parens  []paren
+		history bool
+	)
+
+	// breakable checks if clauses at the current depth go on separate lines.
+	breakable := func() bool {
+		if compact {
+			return false
+		}
+		return len(parens) == 0 || parens[len(parens)-1].multiline
+	}
+
+	for i, t := range tokens {
+		switch t.Kind {
+		case TokenLeftParen:
+			p := paren{group: isGroup(f.prev, parens)}
+			if p.group && !compact {
+				p.multiline = hasConnector(tokens[i+1:])
+			}
+			f.write(t, t.Value)
+			if p.multiline {
+				f.newline()
+				f.indent++
+			}
+			parens = append(parens, p)
+			history = false
+		case TokenRightParen:
+			if len(parens) == 0 {
+				return "", fmt.Errorf("unexpected ) at position %d", t.Pos+1)
+			}
+			p := parens[len(parens)-1]
+			parens = parens[:len(parens)-1]
+			if p.multiline {
+				f.newline()
+				f.indent--
+			}
+			f.write(t, t.Value)
+		case TokenWord:
+			upper := strings.ToUpper(t.Value)
+
+			switch {
+			case (upper == "AND" || upper == "OR") && (len(parens) == 0 || parens[len(parens)-1].group):
+				if breakable() {
+					f.newline()
+				}
+				f.write(t, upper)
+				history = false
+			case upper == "ORDER" && len(parens) == 0 && i+1 < len(tokens) && tokens[i+1].Is("BY"):
+				if !compact {
+					f.newline()
+				}
+				f.write(t, upper)
+				history = false
+			case keywords[upper]:
+				f.write(t, upper)
+				if upper == "WAS" || upper == "CHANGED" {
+					history = true
+				}
+			case history && predicates[upper]:
+				f.write(t, upper)
+			default:
+				f.write(t, t.Value)
+			}
+		default:
+			f.write(t, t.Value)
+		}
+	}
+	if len(parens) > 0 {
+		return "", fmt.Errorf("missing ) for ( at position %d", lastOpen(tokens)+1)
+	}
+	f.newline()
+
+	sep := "\n"
+	if compact {
+		sep = " "
+	}
+	return strings.Join(f.lines, sep), nil
+}
+
+// isGroup checks if a parenthesis following the prev token groups clauses
+// rather than enclosing a list of values or function arguments.
+func isGroup(prev *Token, parens []paren) bool {
+	if prev == nil {
+		return len(parens) == 0 || parens[len(parens)-1].group
+	}
+	switch {
+	case prev.Kind == TokenLeftParen:
+		return len(parens) > 0 && parens[len(parens)-1].group
+	case prev.Is("AND"), prev.Is("OR"), prev.Is("NOT"):
+		return true
+	}
+	return false
+}
+
+// hasConnector checks if the group starting at tokens has AND or OR at its own depth.
+func hasConnector(tokens []Token) bool {
+	depth := 0
+	for _, t := range tokens {
+		switch {
+		case t.Kind == TokenLeftParen:
+			depth++
+		case t.Kind == TokenRightParen:
+			if depth == 0 {
+				return false
+			}
+			depth--
+		case depth == 0 && (t.Is("AND") || t.Is("OR")):
+			return true
+		}
+	}
+	return false
+}
+
+func lastOpen(tokens []Token) int {
+	var open []int
+	for _, t := range tokens {
+		switch t.Kind {
+		case TokenLeftParen:
+			open = append(open, t.Pos)
+		case TokenRightParen:
+			if len(open) > 0 {
+				open = open[:len(open)-1]
+			}
+		}
+	}
+	if len(open) == 0 {
+		return 0
+	}
+	return open[len(open)-1]
+}
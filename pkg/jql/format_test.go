@@ -0,0 +1,85 @@
+package jql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenize(t *testing.T) {
+	tokens, err := Tokenize(`status IN ("In Progress", Done) AND assignee!=currentUser()`)
+	assert.NoError(t, err)
+
+	values := make([]string, 0, len(tokens))
+	for _, tk := range tokens {
+		values = append(values, tk.Value)
+	}
+	assert.Equal(t, []string{
+		"status", "IN", "(", `"In Progress"`, ",", "Done", ")", "AND", "assignee", "!=", "currentUser", "(", ")",
+	}, values)
+	assert.Equal(t, TokenOperator, tokens[9].Kind)
+	assert.Equal(t, 36, tokens[8].Pos)
+
+	tokens, err = Tokenize(`summary ~ "login fa`)
+	assert.Error(t, err)
+	assert.Equal(t, Token{Kind: TokenString, Value: `"login fa`, Pos: 10}, tokens[len(tokens)-1])
+}
+
+func TestFormat(t *testing.T) {
+	cases := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "single clause",
+			input:    `project=ABC`,
+			expected: `project = ABC`,
+		},
+		{
+			name:     "it upper-cases keywords and puts clauses on separate lines",
+			input:    `project = ABC and status not in (Done, "Won't Do") and assignee is not empty order by created desc, key`,
+			expected: "project = ABC\nAND status NOT IN (Done, \"Won't Do\")\nAND assignee IS NOT EMPTY\nORDER BY created DESC, key",
+		},
+		{
+			name:     "it indents groups",
+			input:    `project = ABC AND (assignee = currentUser() OR reporter in membersOf("devs")) AND NOT (type = Epic)`,
+			expected: "project = ABC\nAND (\n  assignee = currentUser()\n  OR reporter IN membersOf(\"devs\")\n)\nAND NOT (type = Epic)",
+		},
+		{
+			name:     "it upper-cases history predicates",
+			input:    `status was "In Progress" by jane during ("2024/01/01", now()) and status changed from Open to Done`,
+			expected: "status WAS \"In Progress\" BY jane DURING (\"2024/01/01\", now())\nAND status CHANGED FROM Open TO Done",
+		},
+		{
+			name:     "it keeps quoted keywords",
+			input:    `summary ~ "cli and tui" OR text ~ 'order by'`,
+			expected: "summary ~ \"cli and tui\"\nOR text ~ 'order by'",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := Format(tc.input)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, out)
+		})
+	}
+}
+
+func TestCompact(t *testing.T) {
+	out, err := Compact("project = ABC\nAND (\n  assignee = currentUser()\n  or reporter = currentUser()\n)\norder by created")
+	assert.NoError(t, err)
+	assert.Equal(t, "project = ABC AND (assignee = currentUser() OR reporter = currentUser()) ORDER BY created", out)
+}
+
+func TestFormatErrors(t *testing.T) {
+	_, err := Format(`project = ABC AND (status = Done`)
+	assert.EqualError(t, err, "missing ) for ( at position 19")
+
+	_, err = Format(`project = ABC)`)
+	assert.EqualError(t, err, "unexpected ) at position 14")
+
+	_, err = Format(`summary ~ "cli`)
+	assert.EqualError(t, err, "unterminated string at position 11")
+}
@@ -1,13 +1,22 @@
 package md
 
 import (
+	"regexp"
+
 	cf "github.com/kentaro-m/blackfriday-confluence"
 	bf "github.com/russross/blackfriday/v2"
 
 	"github.com/ankitpokhrel/jira-cli/pkg/md/jirawiki"
 )
 
+var (
+	// escapedMention matches user mentions escaped by the renderer, eg: `\[\~john\-doe\]`.
+	escapedMention = regexp.MustCompile(`\\\[\\~((?:\\[^\]]|[^\\\]])+)\\\]`)
+	escapedChar    = regexp.MustCompile(`\\(.)`)
+)
+
 // ToJiraMD translates CommonMark to Jira flavored markdown.
+// User mentions like `[~jdoe]` or `[~accountid:123]` are kept as is.
 func ToJiraMD(md string) string {
 	if md == "" {
 		return md
@@ -16,7 +25,12 @@ func ToJiraMD(md string) string {
 	renderer := &cf.Renderer{Flags: cf.IgnoreMacroEscaping}
 	r := bf.New(bf.WithRenderer(renderer), bf.WithExtensions(bf.CommonExtensions))
 
-	return string(renderer.Render(r.Parse([]byte(md))))
+	out := renderer.Render(r.Parse([]byte(md)))
+
+	return string(escapedMention.ReplaceAllFunc(out, func(m []byte) []byte {
+		user := escapedMention.FindSubmatch(m)[1]
+		return append(append([]byte("[~"), escapedChar.ReplaceAll(user, []byte("$1"))...), ']')
+	}))
 }
 
 // FromJiraMD translates Jira flavored markdown to CommonMark.
@@ -107,3 +107,43 @@ func main\(\) {
 
 	assert.Equal(t, expected, ToJiraMD(jfm))
 }
+
+func TestToJiraMDKeepsMentions(t *testing.T) {
+	cases := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "username",
+			input:    "[~jdoe] please review",
+			expected: "[~jdoe] please review",
+		},
+		{
+			name:     "username with special chars",
+			input:    "cc [~john.doe-2] and [~jane_doe]",
+			expected: "cc [~john.doe-2] and [~jane_doe]",
+		},
+		{
+			name:     "text between mentions",
+			input:    "[~jdoe] 1-2 [~jane]",
+			expected: "[~jdoe] 1\\-2 [~jane]",
+		},
+		{
+			name:     "account id",
+			input:    "**Ping** [~accountid:5b10a2844c20165700ede21g]",
+			expected: "*Ping* [~accountid:5b10a2844c20165700ede21g]\n\n",
+		},
+		{
+			name:     "not a mention",
+			input:    "[link](http://example.com) [x]",
+			expected: "[link|http://example.com] \\[x\\]\n\n",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, ToJiraMD(tc.input))
+		})
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
@@ -42,6 +44,23 @@ type CopyFunc func(row, column int, data interface{})
 // CopyKeyFunc is fired when a user press 'CTRL+K' character in the table cell.
 type CopyKeyFunc func(row, column int, data interface{})
 
+// WatchResult is the result of a watch poll.
+type WatchResult struct {
+	// Data replaces the table data. The table is not repainted if it is nil.
+	Data TableData
+	// Changed maps changed rows to changed columns. All cells of a changed row
+	// are highlighted, changed columns are highlighted further.
+	Changed map[int][]int
+	// Footer replaces the footer text if it is not empty.
+	Footer string
+	// Apply, if set, is called on the UI goroutine before the table is repainted.
+	// Use it to update state that is shared with other table callbacks.
+	Apply func()
+}
+
+// WatchFunc is fired periodically in watch mode.
+type WatchFunc func() (*WatchResult, error)
+
 // TableData is the data to be displayed in a table.
 type TableData [][]string
 
@@ -102,6 +121,9 @@ type Table struct {
 	refreshFunc  RefreshFunc
 	copyFunc     CopyFunc
 	copyKeyFunc  CopyKeyFunc
+	watchFunc    WatchFunc
+	watchEvery   time.Duration
+	stopWatch    func()
 }
 
 // TableOption is a functional option to wrap table properties.
@@ -214,6 +236,14 @@ func WithCopyKeyFunc(fn CopyKeyFunc) TableOption {
 	}
 }
 
+// WithWatchFunc sets a func that is triggered every interval to update the table.
+func WithWatchFunc(interval time.Duration, fn WatchFunc) TableOption {
+	return func(t *Table) {
+		t.watchEvery = interval
+		t.watchFunc = fn
+	}
+}
+
 // WithFixedColumns sets the number of columns that are locked (do not scroll right).
 func WithFixedColumns(cols uint) TableOption {
 	return func(t *Table) {
@@ -228,9 +258,84 @@ func (t *Table) Paint(data TableData) error {
 	}
 	t.data = data
 	t.render(data)
+
+	// Paint is called again after moving an issue, the watch is already running then.
+	if t.watchFunc != nil && t.stopWatch == nil {
+		stop := make(chan struct{})
+		t.stopWatch = sync.OnceFunc(func() { close(stop) })
+		defer t.stopWatch()
+
+		go t.watch(stop)
+	}
 	return t.screen.Paint(t.painter)
 }
 
+func (t *Table) watch(stop <-chan struct{}) {
+	ticker := time.NewTicker(t.watchEvery)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+
+		res, err := t.watchFunc()
+
+		select {
+		case <-stop:
+			return
+		default:
+		}
+
+		t.screen.QueueUpdateDraw(func() {
+			if err != nil {
+				t.footer.SetText(pad(fmt.Sprintf("%s  Error: %s", t.footerText, err), 1)).
+					SetTextColor(tcell.ColorRed)
+				return
+			}
+			if res.Footer != "" {
+				t.footerText = res.Footer
+			}
+			t.footer.SetText(pad(t.footerText, 1)).SetTextColor(tcell.ColorDefault)
+
+			if res.Apply != nil {
+				res.Apply()
+			}
+			if res.Data == nil {
+				return
+			}
+			r, c := t.view.GetSelection()
+
+			t.data = res.Data
+			t.view.Clear()
+			t.render(res.Data)
+			t.highlight(res.Changed)
+
+			if r >= len(res.Data) {
+				r = len(res.Data) - 1
+			}
+			t.view.Select(max(r, 1), c)
+		})
+	}
+}
+
+func (t *Table) highlight(changed map[int][]int) {
+	for r, cols := range changed {
+		for c := range t.view.GetColumnCount() {
+			if cell := t.view.GetCell(r, c); cell != nil {
+				cell.SetTextColor(tcell.ColorYellow)
+			}
+		}
+		for _, c := range cols {
+			if cell := t.view.GetCell(r, c); cell != nil {
+				cell.SetAttributes(tcell.AttrBold | tcell.AttrUnderline)
+			}
+		}
+	}
+}
+
 func (t *Table) render(data TableData) {
 	if t.selectedFunc != nil {
 		t.view.SetSelectedFunc(func(r, c int) {
@@ -276,6 +381,9 @@ func (t *Table) initTable() {
 				if t.refreshFunc == nil {
 					return ev
 				}
+				if t.stopWatch != nil {
+					t.stopWatch()
+				}
 				t.screen.Stop()
 				t.refreshFunc()
 			}